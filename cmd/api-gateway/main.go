@@ -16,6 +16,8 @@ import (
 	"github.com/tectix/mysticfunds/pkg/logger"
 	authpb "github.com/tectix/mysticfunds/proto/auth"
 	manapb "github.com/tectix/mysticfunds/proto/mana"
+	marketplacepb "github.com/tectix/mysticfunds/proto/marketplace"
+	realmpb "github.com/tectix/mysticfunds/proto/realm"
 	wizardpb "github.com/tectix/mysticfunds/proto/wizard"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -28,10 +30,12 @@ type contextKey string
 const userIDKey contextKey = "user_id"
 
 type Gateway struct {
-	authClient   authpb.AuthServiceClient
-	wizardClient wizardpb.WizardServiceClient
-	manaClient   manapb.ManaServiceClient
-	logger       logger.Logger
+	authClient        authpb.AuthServiceClient
+	wizardClient      wizardpb.WizardServiceClient
+	manaClient        manapb.ManaServiceClient
+	marketplaceClient marketplacepb.MarketplaceServiceClient
+	realmClient       realmpb.RealmServiceClient
+	logger            logger.Logger
 }
 
 type ErrorResponse struct {
@@ -68,11 +72,27 @@ func main() {
 	}
 	defer manaConn.Close()
 
+	marketplaceAddr := cfg.GetString("MARKETPLACE_SERVICE_ADDR", "localhost:50056")
+	marketplaceConn, err := grpc.Dial(marketplaceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Fatal("Failed to connect to marketplace service", "error", err, "address", marketplaceAddr)
+	}
+	defer marketplaceConn.Close()
+
+	realmAddr := cfg.GetString("REALM_SERVICE_ADDR", "localhost:50055")
+	realmConn, err := grpc.Dial(realmAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Fatal("Failed to connect to realm service", "error", err, "address", realmAddr)
+	}
+	defer realmConn.Close()
+
 	gateway := &Gateway{
-		authClient:   authpb.NewAuthServiceClient(authConn),
-		wizardClient: wizardpb.NewWizardServiceClient(wizardConn),
-		manaClient:   manapb.NewManaServiceClient(manaConn),
-		logger:       logger,
+		authClient:        authpb.NewAuthServiceClient(authConn),
+		wizardClient:      wizardpb.NewWizardServiceClient(wizardConn),
+		manaClient:        manapb.NewManaServiceClient(manaConn),
+		marketplaceClient: marketplacepb.NewMarketplaceServiceClient(marketplaceConn),
+		realmClient:       realmpb.NewRealmServiceClient(realmConn),
+		logger:            logger,
 	}
 
 	// Setup routes
@@ -94,7 +114,10 @@ func main() {
 	mux.HandleFunc("/api/mana/transfer", corsMiddleware(gateway.authMiddleware(gateway.handleManaTransfer)))
 	mux.HandleFunc("/api/mana/transactions/", corsMiddleware(gateway.authMiddleware(gateway.handleManaTransactions)))
 	mux.HandleFunc("/api/mana/investments", corsMiddleware(gateway.authMiddleware(gateway.handleInvestments)))
+	mux.HandleFunc("/api/mana/investments/", corsMiddleware(gateway.authMiddleware(gateway.handleInvestmentByID)))
 	mux.HandleFunc("/api/mana/investment-types", corsMiddleware(gateway.authMiddleware(gateway.handleInvestmentTypes)))
+	mux.HandleFunc("/api/mana/portfolio/", corsMiddleware(gateway.authMiddleware(gateway.handlePortfolioSummary)))
+	mux.HandleFunc("/api/mana/markets/", corsMiddleware(gateway.authMiddleware(gateway.handleMarketHistory)))
 
 	// Job routes
 	mux.HandleFunc("/api/jobs", corsMiddleware(gateway.authMiddleware(gateway.handleJobs)))
@@ -103,6 +126,10 @@ func main() {
 	mux.HandleFunc("/api/jobs/assignments", corsMiddleware(gateway.authMiddleware(gateway.handleJobAssignments)))
 	mux.HandleFunc("/api/jobs/assignments/", corsMiddleware(gateway.authMiddleware(gateway.handleJobAssignmentByID)))
 	mux.HandleFunc("/api/jobs/assignments/cancel", corsMiddleware(gateway.authMiddleware(gateway.handleJobAssignmentCancel)))
+	mux.HandleFunc("/api/jobs/party", corsMiddleware(gateway.authMiddleware(gateway.handleJobParty)))
+	mux.HandleFunc("/api/jobs/parties/", corsMiddleware(gateway.authMiddleware(gateway.handleJobPartyByID)))
+	mux.HandleFunc("/api/jobs/applications", corsMiddleware(gateway.authMiddleware(gateway.handleJobApplications)))
+	mux.HandleFunc("/api/jobs/applications/", corsMiddleware(gateway.authMiddleware(gateway.handleJobApplicationDecision)))
 
 	// Progress and activity routes
 	mux.HandleFunc("/api/activities", corsMiddleware(gateway.authMiddleware(gateway.handleActivities)))
@@ -110,6 +137,26 @@ func main() {
 
 	// Realm routes
 	mux.HandleFunc("/api/realms", corsMiddleware(gateway.authMiddleware(gateway.handleRealms)))
+	mux.HandleFunc("/api/realms/", corsMiddleware(gateway.authMiddleware(gateway.handleRealmByID)))
+	mux.HandleFunc("/api/properties", corsMiddleware(gateway.authMiddleware(gateway.handleProperties)))
+	mux.HandleFunc("/api/properties/", corsMiddleware(gateway.authMiddleware(gateway.handlePropertyByID)))
+	mux.HandleFunc("/api/properties/buy", corsMiddleware(gateway.authMiddleware(gateway.handleBuyProperty)))
+
+	// Marketplace routes
+	mux.HandleFunc("/api/marketplace/artifacts", corsMiddleware(gateway.authMiddleware(gateway.handleMarketplaceArtifacts)))
+	mux.HandleFunc("/api/marketplace/artifacts/purchase", corsMiddleware(gateway.authMiddleware(gateway.handlePurchaseArtifact)))
+	mux.HandleFunc("/api/marketplace/artifacts/equip", corsMiddleware(gateway.authMiddleware(gateway.handleEquipArtifact)))
+	mux.HandleFunc("/api/marketplace/artifacts/wizard/", corsMiddleware(gateway.authMiddleware(gateway.handleWizardArtifacts)))
+	mux.HandleFunc("/api/marketplace/scrolls", corsMiddleware(gateway.authMiddleware(gateway.handleMarketplaceScrolls)))
+	mux.HandleFunc("/api/marketplace/scrolls/purchase", corsMiddleware(gateway.authMiddleware(gateway.handlePurchaseScroll)))
+	mux.HandleFunc("/api/marketplace/scrolls/wizard/", corsMiddleware(gateway.authMiddleware(gateway.handleWizardScrolls)))
+	mux.HandleFunc("/api/marketplace/spells", corsMiddleware(gateway.authMiddleware(gateway.handleMarketplaceSpells)))
+	mux.HandleFunc("/api/marketplace/spells/teachers", corsMiddleware(gateway.authMiddleware(gateway.handleSpellTeachers)))
+	mux.HandleFunc("/api/marketplace/spells/learn", corsMiddleware(gateway.authMiddleware(gateway.handleLearnSpell)))
+	mux.HandleFunc("/api/marketplace/spells/teach", corsMiddleware(gateway.authMiddleware(gateway.handleOfferSpellTeaching)))
+	mux.HandleFunc("/api/marketplace/spells/wizard/", corsMiddleware(gateway.authMiddleware(gateway.handleWizardSpells)))
+	mux.HandleFunc("/api/marketplace/inventory/", corsMiddleware(gateway.authMiddleware(gateway.handleWizardInventory)))
+	mux.HandleFunc("/api/marketplace/transactions/", corsMiddleware(gateway.authMiddleware(gateway.handleMarketplaceTransactions)))
 
 	// Health check endpoint
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -172,7 +219,7 @@ func corsMiddleware(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Idempotency-Key")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -217,6 +264,79 @@ func (g *Gateway) authMiddleware(next http.HandlerFunc) http.HandlerFunc {
 	}
 }
 
+// authorizeWizard checks that the authenticated user owns the given wizard. It writes
+// the error response and returns false when the request should not proceed.
+func (g *Gateway) authorizeWizard(ctx context.Context, w http.ResponseWriter, r *http.Request, wizardID int64) bool {
+	if wizardID <= 0 {
+		http.Error(w, "Invalid wizard ID", http.StatusBadRequest)
+		return false
+	}
+
+	userID, ok := r.Context().Value(userIDKey).(int64)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return false
+	}
+
+	wizard, err := g.wizardClient.GetWizard(ctx, &wizardpb.GetWizardRequest{
+		Id: wizardID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			http.Error(w, "Wizard not found", http.StatusNotFound)
+			return false
+		}
+		g.logger.Error("Get wizard for authorization failed", "error", err)
+		http.Error(w, "Failed to verify wizard ownership", http.StatusInternalServerError)
+		return false
+	}
+
+	if wizard.UserId != userID {
+		http.Error(w, "You do not own this wizard", http.StatusForbidden)
+		return false
+	}
+
+	return true
+}
+
+// writeGRPCError maps a gRPC status to the matching HTTP status, falling back to
+// a 500 with the given message for internal or unknown errors.
+func writeGRPCError(w http.ResponseWriter, err error, fallback string) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			http.Error(w, st.Message(), http.StatusBadRequest)
+			return
+		case codes.NotFound:
+			http.Error(w, st.Message(), http.StatusNotFound)
+			return
+		case codes.AlreadyExists, codes.Aborted:
+			http.Error(w, st.Message(), http.StatusConflict)
+			return
+		case codes.FailedPrecondition:
+			http.Error(w, st.Message(), http.StatusUnprocessableEntity)
+			return
+		case codes.PermissionDenied:
+			http.Error(w, st.Message(), http.StatusForbidden)
+			return
+		case codes.Unavailable:
+			http.Error(w, fallback, http.StatusServiceUnavailable)
+			return
+		}
+	}
+
+	http.Error(w, fallback, http.StatusInternalServerError)
+}
+
+// idempotencyKey returns the Idempotency-Key header, falling back to a key sent in
+// the request body
+func idempotencyKey(r *http.Request, bodyKey string) string {
+	if key := strings.TrimSpace(r.Header.Get("Idempotency-Key")); key != "" {
+		return key
+	}
+	return bodyKey
+}
+
 func (g *Gateway) handleRegister(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -398,6 +518,10 @@ func (g *Gateway) handleWizards(w http.ResponseWriter, r *http.Request) {
 func (g *Gateway) handleWizardByID(w http.ResponseWriter, r *http.Request) {
 	// Extract wizard ID from URL path
 	path := strings.TrimPrefix(r.URL.Path, "/api/wizards/")
+	if strings.HasSuffix(path, "/progression") {
+		g.handleWizardProgression(w, r, strings.TrimSuffix(path, "/progression"))
+		return
+	}
 	wizardID, err := strconv.ParseInt(path, 10, 64)
 	if err != nil {
 		http.Error(w, "Invalid wizard ID", http.StatusBadRequest)
@@ -457,6 +581,36 @@ func (g *Gateway) handleWizardByID(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// handleWizardProgression returns a wizard's level, the experience to the next
+// level and the rewards of the levels ahead
+func (g *Gateway) handleWizardProgression(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	wizardID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid wizard ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := g.wizardClient.GetProgression(ctx, &wizardpb.GetProgressionRequest{
+		WizardId: wizardID,
+	})
+	if err != nil {
+		g.logger.Error("Get progression failed", "error", err)
+		writeGRPCError(w, err, "Failed to get progression")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
 func (g *Gateway) handleManaBalance(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -504,6 +658,7 @@ func (g *Gateway) handleManaTransfer(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Invalid JSON", http.StatusBadRequest)
 		return
 	}
+	req.IdempotencyKey = idempotencyKey(r, req.IdempotencyKey)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -511,7 +666,7 @@ func (g *Gateway) handleManaTransfer(w http.ResponseWriter, r *http.Request) {
 	resp, err := g.manaClient.TransferMana(ctx, &req)
 	if err != nil {
 		g.logger.Error("Transfer mana failed", "error", err)
-		http.Error(w, "Failed to transfer mana", http.StatusInternalServerError)
+		writeGRPCError(w, err, "Failed to transfer mana")
 		return
 	}
 
@@ -595,11 +750,12 @@ func (g *Gateway) handleInvestments(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, "Invalid JSON", http.StatusBadRequest)
 			return
 		}
+		req.IdempotencyKey = idempotencyKey(r, req.IdempotencyKey)
 
 		resp, err := g.manaClient.CreateInvestment(ctx, &req)
 		if err != nil {
 			g.logger.Error("Create investment failed", "error", err)
-			http.Error(w, "Failed to create investment", http.StatusInternalServerError)
+			writeGRPCError(w, err, "Failed to create investment")
 			return
 		}
 
@@ -611,6 +767,148 @@ func (g *Gateway) handleInvestments(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (g *Gateway) handleInvestmentByID(w http.ResponseWriter, r *http.Request) {
+	// Routes /api/mana/investments/{id}/{withdraw,rollover}
+	path := strings.TrimPrefix(r.URL.Path, "/api/mana/investments/")
+	idStr, action, _ := strings.Cut(path, "/")
+	investmentID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid investment ID", http.StatusBadRequest)
+		return
+	}
+	if action != "withdraw" && action != "rollover" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if action == "rollover" {
+		g.setInvestmentRollover(w, r, investmentID)
+		return
+	}
+
+	var req manapb.WithdrawInvestmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	req.InvestmentId = investmentID
+	req.IdempotencyKey = idempotencyKey(r, req.IdempotencyKey)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, req.WizardId) {
+		return
+	}
+
+	resp, err := g.manaClient.WithdrawInvestment(ctx, &req)
+	if err != nil {
+		g.logger.Error("Withdraw investment failed", "error", err)
+		writeGRPCError(w, err, "Failed to withdraw investment")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) setInvestmentRollover(w http.ResponseWriter, r *http.Request, investmentID int64) {
+	var req manapb.SetInvestmentRolloverRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	req.InvestmentId = investmentID
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, req.WizardId) {
+		return
+	}
+
+	resp, err := g.manaClient.SetInvestmentRollover(ctx, &req)
+	if err != nil {
+		g.logger.Error("Set investment rollover failed", "error", err)
+		writeGRPCError(w, err, "Failed to update investment rollover")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handlePortfolioSummary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Extract wizard ID from URL path
+	path := strings.TrimPrefix(r.URL.Path, "/api/mana/portfolio/")
+	wizardID, err := strconv.ParseInt(path, 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid wizard ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, wizardID) {
+		return
+	}
+
+	resp, err := g.manaClient.GetPortfolioSummary(ctx, &manapb.GetPortfolioSummaryRequest{
+		WizardId: wizardID,
+	})
+	if err != nil {
+		g.logger.Error("Get portfolio summary failed", "error", err)
+		writeGRPCError(w, err, "Failed to get portfolio summary")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handleMarketHistory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Extract investment type ID from URL path
+	path := strings.TrimPrefix(r.URL.Path, "/api/mana/markets/")
+	investmentTypeID, err := strconv.ParseInt(path, 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid investment type ID", http.StatusBadRequest)
+		return
+	}
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := g.manaClient.GetMarketHistory(ctx, &manapb.GetMarketHistoryRequest{
+		InvestmentTypeId: investmentTypeID,
+		Limit:            int32(limit),
+	})
+	if err != nil {
+		g.logger.Error("Get market history failed", "error", err)
+		writeGRPCError(w, err, "Failed to get market history")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
 func (g *Gateway) handleInvestmentTypes(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -687,6 +985,7 @@ func (g *Gateway) handleJobs(w http.ResponseWriter, r *http.Request) {
 		element := r.URL.Query().Get("element")
 		difficulty := r.URL.Query().Get("difficulty")
 		onlyActive := r.URL.Query().Get("only_active") == "true"
+		wizardID, _ := strconv.ParseInt(r.URL.Query().Get("wizard_id"), 10, 64)
 
 		if pageSize <= 0 {
 			pageSize = 10
@@ -702,10 +1001,11 @@ func (g *Gateway) handleJobs(w http.ResponseWriter, r *http.Request) {
 			Element:    element,
 			Difficulty: difficulty,
 			OnlyActive: onlyActive,
+			WizardId:   wizardID,
 		})
 		if err != nil {
 			g.logger.Error("List jobs failed", "error", err)
-			http.Error(w, "Failed to list jobs", http.StatusInternalServerError)
+			writeGRPCError(w, err, "Failed to list jobs")
 			return
 		}
 
@@ -719,10 +1019,15 @@ func (g *Gateway) handleJobs(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// A job posted by a wizard is paid for from their mana
+		if req.CreatedByWizardId != 0 && !g.authorizeWizard(ctx, w, r, req.CreatedByWizardId) {
+			return
+		}
+
 		resp, err := g.wizardClient.CreateJob(ctx, &req)
 		if err != nil {
 			g.logger.Error("Create job failed", "error", err)
-			http.Error(w, "Failed to create job", http.StatusInternalServerError)
+			writeGRPCError(w, err, "Failed to create job")
 			return
 		}
 
@@ -768,10 +1073,14 @@ func (g *Gateway) handleJobByID(w http.ResponseWriter, r *http.Request) {
 		}
 		req.Id = jobID
 
+		if !g.authorizeJobPoster(ctx, w, r, jobID, "Failed to update job") {
+			return
+		}
+
 		resp, err := g.wizardClient.UpdateJob(ctx, &req)
 		if err != nil {
 			g.logger.Error("Update job failed", "error", err)
-			http.Error(w, "Failed to update job", http.StatusInternalServerError)
+			writeGRPCError(w, err, "Failed to update job")
 			return
 		}
 
@@ -779,12 +1088,16 @@ func (g *Gateway) handleJobByID(w http.ResponseWriter, r *http.Request) {
 		_ = json.NewEncoder(w).Encode(resp)
 
 	case http.MethodDelete:
+		if !g.authorizeJobPoster(ctx, w, r, jobID, "Failed to delete job") {
+			return
+		}
+
 		resp, err := g.wizardClient.DeleteJob(ctx, &wizardpb.DeleteJobRequest{
 			Id: jobID,
 		})
 		if err != nil {
 			g.logger.Error("Delete job failed", "error", err)
-			http.Error(w, "Failed to delete job", http.StatusInternalServerError)
+			writeGRPCError(w, err, "Failed to delete job")
 			return
 		}
 
@@ -796,6 +1109,34 @@ func (g *Gateway) handleJobByID(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// authorizeJobPoster checks that the authenticated user owns the wizard who
+// posted the job, if a wizard posted it. It writes the error response and
+// returns false when they do not.
+func (g *Gateway) authorizeJobPoster(ctx context.Context, w http.ResponseWriter, r *http.Request, jobID int64, fallback string) bool {
+	job, err := g.wizardClient.GetJob(ctx, &wizardpb.GetJobRequest{Id: jobID})
+	if err != nil {
+		g.logger.Error("Get job failed", "error", err)
+		writeGRPCError(w, err, fallback)
+		return false
+	}
+	if job.Posting == nil {
+		return true
+	}
+	return g.authorizeWizard(ctx, w, r, job.Posting.PosterWizardId)
+}
+
+// authorizeAssignment checks that the authenticated user owns the wizard working
+// the assignment. It writes the error response and returns false when they do not.
+func (g *Gateway) authorizeAssignment(ctx context.Context, w http.ResponseWriter, r *http.Request, assignmentID int64, fallback string) bool {
+	assignment, err := g.wizardClient.GetJobAssignment(ctx, &wizardpb.GetJobAssignmentRequest{AssignmentId: assignmentID})
+	if err != nil {
+		g.logger.Error("Get job assignment failed", "error", err)
+		writeGRPCError(w, err, fallback)
+		return false
+	}
+	return g.authorizeWizard(ctx, w, r, assignment.WizardId)
+}
+
 func (g *Gateway) handleJobAssignment(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -822,6 +1163,201 @@ func (g *Gateway) handleJobAssignment(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
+// handleJobParty assigns a party of the user's wizards to a party job
+func (g *Gateway) handleJobParty(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req wizardpb.AssignPartyToJobRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, wizardID := range req.WizardIds {
+		if !g.authorizeWizard(ctx, w, r, wizardID) {
+			return
+		}
+	}
+
+	resp, err := g.wizardClient.AssignPartyToJob(ctx, &req)
+	if err != nil {
+		g.logger.Error("Assign party to job failed", "error", err)
+		writeGRPCError(w, err, "Failed to assign party to job")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handleJobPartyByID(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	partyID, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/api/jobs/parties/"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid party ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := g.wizardClient.GetJobParty(ctx, &wizardpb.GetJobPartyRequest{
+		PartyId: partyID,
+	})
+	if err != nil {
+		g.logger.Error("Get job party failed", "error", err)
+		writeGRPCError(w, err, "Failed to get job party")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handleJobApplications(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch r.Method {
+	case http.MethodGet:
+		wizardID, _ := strconv.ParseInt(r.URL.Query().Get("wizard_id"), 10, 64)
+		jobID, _ := strconv.ParseInt(r.URL.Query().Get("job_id"), 10, 64)
+
+		// A wizard sees their own applications, and a poster those to their job
+		switch {
+		case wizardID != 0:
+			if !g.authorizeWizard(ctx, w, r, wizardID) {
+				return
+			}
+		case jobID != 0:
+			job, err := g.wizardClient.GetJob(ctx, &wizardpb.GetJobRequest{Id: jobID})
+			if err != nil {
+				g.logger.Error("Get job failed", "error", err)
+				writeGRPCError(w, err, "Failed to list job applications")
+				return
+			}
+			if job.Posting == nil {
+				http.Error(w, "Job takes no applications", http.StatusNotFound)
+				return
+			}
+			if !g.authorizeWizard(ctx, w, r, job.Posting.PosterWizardId) {
+				return
+			}
+		default:
+			http.Error(w, "wizard_id or job_id is required", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := g.wizardClient.ListJobApplications(ctx, &wizardpb.ListJobApplicationsRequest{
+			JobId:    jobID,
+			WizardId: wizardID,
+			Status:   r.URL.Query().Get("status"),
+		})
+		if err != nil {
+			g.logger.Error("List job applications failed", "error", err)
+			writeGRPCError(w, err, "Failed to list job applications")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+
+	case http.MethodPost:
+		var req wizardpb.ApplyToJobRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+			return
+		}
+
+		if !g.authorizeWizard(ctx, w, r, req.WizardId) {
+			return
+		}
+
+		resp, err := g.wizardClient.ApplyToJob(ctx, &req)
+		if err != nil {
+			g.logger.Error("Apply to job failed", "error", err)
+			writeGRPCError(w, err, "Failed to apply to job")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleJobApplicationDecision accepts or rejects an application to a posted
+// job: POST /api/jobs/applications/{id}/accept or /reject
+func (g *Gateway) handleJobApplicationDecision(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/jobs/applications/"), "/")
+	if len(parts) != 2 {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	applicationID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid application ID", http.StatusBadRequest)
+		return
+	}
+
+	var body struct {
+		PosterWizardID int64 `json:"poster_wizard_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, body.PosterWizardID) {
+		return
+	}
+
+	var resp *wizardpb.JobApplication
+	switch parts[1] {
+	case "accept":
+		resp, err = g.wizardClient.AcceptJobApplication(ctx, &wizardpb.AcceptJobApplicationRequest{
+			ApplicationId:  applicationID,
+			PosterWizardId: body.PosterWizardID,
+		})
+	case "reject":
+		resp, err = g.wizardClient.RejectJobApplication(ctx, &wizardpb.RejectJobApplicationRequest{
+			ApplicationId:  applicationID,
+			PosterWizardId: body.PosterWizardID,
+		})
+	default:
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		g.logger.Error("Decide job application failed", "error", err, "decision", parts[1])
+		writeGRPCError(w, err, "Failed to "+parts[1]+" job application")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
 func (g *Gateway) handleJobAssignments(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -875,7 +1411,7 @@ func (g *Gateway) handleJobAssignmentByID(w http.ResponseWriter, r *http.Request
 
 	switch r.Method {
 	case http.MethodPut:
-		// Check if this is a complete or cancel action based on request body
+		// Check which action to take based on request body
 		var actionReq struct {
 			Action string `json:"action"`
 			Reason string `json:"reason,omitempty"`
@@ -885,6 +1421,10 @@ func (g *Gateway) handleJobAssignmentByID(w http.ResponseWriter, r *http.Request
 			return
 		}
 
+		if !g.authorizeAssignment(ctx, w, r, assignmentID, "Failed to update job assignment") {
+			return
+		}
+
 		if actionReq.Action == "complete" {
 			resp, err := g.wizardClient.CompleteJobAssignment(ctx, &wizardpb.CompleteJobAssignmentRequest{
 				AssignmentId: assignmentID,
@@ -912,8 +1452,34 @@ func (g *Gateway) handleJobAssignmentByID(w http.ResponseWriter, r *http.Request
 			w.Header().Set("Content-Type", "application/json")
 			_ = json.NewEncoder(w).Encode(resp)
 
+		} else if actionReq.Action == "pause" {
+			resp, err := g.wizardClient.PauseJobAssignment(ctx, &wizardpb.PauseJobAssignmentRequest{
+				AssignmentId: assignmentID,
+			})
+			if err != nil {
+				g.logger.Error("Pause job assignment failed", "error", err)
+				writeGRPCError(w, err, "Failed to pause job assignment")
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(resp)
+
+		} else if actionReq.Action == "resume" {
+			resp, err := g.wizardClient.ResumeJobAssignment(ctx, &wizardpb.ResumeJobAssignmentRequest{
+				AssignmentId: assignmentID,
+			})
+			if err != nil {
+				g.logger.Error("Resume job assignment failed", "error", err)
+				writeGRPCError(w, err, "Failed to resume job assignment")
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(resp)
+
 		} else {
-			http.Error(w, "Invalid action. Use 'complete' or 'cancel'", http.StatusBadRequest)
+			http.Error(w, "Invalid action. Use 'complete', 'cancel', 'pause' or 'resume'", http.StatusBadRequest)
 		}
 
 	default:
@@ -1038,6 +1604,10 @@ func (g *Gateway) handleJobAssignmentCancel(w http.ResponseWriter, r *http.Reque
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	if !g.authorizeAssignment(ctx, w, r, req.AssignmentId, "Failed to cancel job assignment") {
+		return
+	}
+
 	resp, err := g.wizardClient.CancelJobAssignment(ctx, &wizardpb.CancelJobAssignmentRequest{
 		AssignmentId: req.AssignmentId,
 		Reason:       req.Reason,
@@ -1051,23 +1621,3 @@ func (g *Gateway) handleJobAssignmentCancel(w http.ResponseWriter, r *http.Reque
 	w.Header().Set("Content-Type", "application/json")
 	_ = json.NewEncoder(w).Encode(resp)
 }
-
-func (g *Gateway) handleRealms(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	resp, err := g.wizardClient.GetRealms(ctx, &wizardpb.GetRealmsRequest{})
-	if err != nil {
-		g.logger.Error("Get realms failed", "error", err)
-		http.Error(w, "Failed to get realms", http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(resp)
-}
@@ -1,7 +1,17 @@
 package main
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+
+	"github.com/tectix/mysticfunds/pkg/logger"
+	wizardpb "github.com/tectix/mysticfunds/proto/wizard"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestMain(t *testing.T) {
@@ -34,3 +44,142 @@ func TestRouteValidation(t *testing.T) {
 		})
 	}
 }
+
+// stubWizardClient answers GetWizard and GetJobAssignment from fixed sets of
+// wizards and assignments, and records the assignment actions it is asked for
+type stubWizardClient struct {
+	wizardpb.WizardServiceClient
+	wizards     map[int64]*wizardpb.Wizard
+	assignments map[int64]*wizardpb.JobAssignment
+	actions     []string
+}
+
+func (c *stubWizardClient) GetJobAssignment(ctx context.Context, in *wizardpb.GetJobAssignmentRequest, opts ...grpc.CallOption) (*wizardpb.JobAssignment, error) {
+	assignment, ok := c.assignments[in.AssignmentId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Job assignment not found")
+	}
+	return assignment, nil
+}
+
+func (c *stubWizardClient) PauseJobAssignment(ctx context.Context, in *wizardpb.PauseJobAssignmentRequest, opts ...grpc.CallOption) (*wizardpb.JobAssignment, error) {
+	c.actions = append(c.actions, "pause")
+	return c.assignments[in.AssignmentId], nil
+}
+
+func (c *stubWizardClient) ResumeJobAssignment(ctx context.Context, in *wizardpb.ResumeJobAssignmentRequest, opts ...grpc.CallOption) (*wizardpb.JobAssignment, error) {
+	c.actions = append(c.actions, "resume")
+	return c.assignments[in.AssignmentId], nil
+}
+
+func (c *stubWizardClient) CancelJobAssignment(ctx context.Context, in *wizardpb.CancelJobAssignmentRequest, opts ...grpc.CallOption) (*wizardpb.JobAssignment, error) {
+	c.actions = append(c.actions, "cancel")
+	return c.assignments[in.AssignmentId], nil
+}
+
+func (c *stubWizardClient) GetWizard(ctx context.Context, in *wizardpb.GetWizardRequest, opts ...grpc.CallOption) (*wizardpb.Wizard, error) {
+	wizard, ok := c.wizards[in.Id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Wizard not found")
+	}
+	return wizard, nil
+}
+
+func TestAuthorizeWizard(t *testing.T) {
+	gateway := &Gateway{
+		wizardClient: &stubWizardClient{wizards: map[int64]*wizardpb.Wizard{
+			1: {Id: 1, UserId: 10},
+			2: {Id: 2, UserId: 20},
+		}},
+		logger: logger.NewLogger("debug"),
+	}
+
+	tests := []struct {
+		name       string
+		wizardID   int64
+		wantOK     bool
+		wantStatus int
+	}{
+		{"owned wizard", 1, true, http.StatusOK},
+		{"someone else's wizard", 2, false, http.StatusForbidden},
+		{"missing wizard", 3, false, http.StatusNotFound},
+		{"invalid wizard", 0, false, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/marketplace/inventory/1", nil)
+			req = req.WithContext(context.WithValue(req.Context(), userIDKey, int64(10)))
+			rec := httptest.NewRecorder()
+
+			ok := gateway.authorizeWizard(context.Background(), rec, req, tt.wizardID)
+			if ok != tt.wantOK {
+				t.Fatalf("authorizeWizard() = %v, want %v", ok, tt.wantOK)
+			}
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/api/mana/transfer", nil)
+	if key := idempotencyKey(r, "from-body"); key != "from-body" {
+		t.Errorf("expected body key without a header, got %q", key)
+	}
+
+	r.Header.Set("Idempotency-Key", " from-header ")
+	if key := idempotencyKey(r, "from-body"); key != "from-header" {
+		t.Errorf("expected header to take precedence, got %q", key)
+	}
+}
+
+func TestJobAssignmentActionsRequireOwnership(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		userID     int64
+		wantStatus int
+	}{
+		{"owner pauses", http.MethodPut, "/api/jobs/assignments/5", `{"action": "pause"}`, 10, http.StatusOK},
+		{"another user pauses", http.MethodPut, "/api/jobs/assignments/5", `{"action": "pause"}`, 20, http.StatusForbidden},
+		{"another user resumes", http.MethodPut, "/api/jobs/assignments/5", `{"action": "resume"}`, 20, http.StatusForbidden},
+		{"another user cancels", http.MethodPut, "/api/jobs/assignments/5", `{"action": "cancel"}`, 20, http.StatusForbidden},
+		{"another user cancels directly", http.MethodPost, "/api/jobs/assignments/cancel", `{"assignment_id": 5}`, 20, http.StatusForbidden},
+		{"missing assignment", http.MethodPut, "/api/jobs/assignments/6", `{"action": "pause"}`, 10, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &stubWizardClient{
+				wizards: map[int64]*wizardpb.Wizard{
+					1: {Id: 1, UserId: 10},
+				},
+				assignments: map[int64]*wizardpb.JobAssignment{
+					5: {Id: 5, WizardId: 1},
+				},
+			}
+			gateway := &Gateway{wizardClient: client, logger: logger.NewLogger("debug")}
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req = req.WithContext(context.WithValue(req.Context(), userIDKey, tt.userID))
+			rec := httptest.NewRecorder()
+
+			if tt.method == http.MethodPost {
+				gateway.handleJobAssignmentCancel(rec, req)
+			} else {
+				gateway.handleJobAssignmentByID(rec, req)
+			}
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK && len(client.actions) > 0 {
+				t.Errorf("unauthorized request still ran %v", client.actions)
+			}
+		})
+	}
+}
@@ -0,0 +1,520 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	marketplacepb "github.com/tectix/mysticfunds/proto/marketplace"
+)
+
+// Marketplace browsing
+
+func (g *Gateway) handleMarketplaceArtifacts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	realmID, _ := strconv.ParseInt(query.Get("realm_id"), 10, 64)
+	maxPowerLevel, _ := strconv.Atoi(query.Get("max_power_level"))
+	maxManaCost, _ := strconv.ParseInt(query.Get("max_mana_cost"), 10, 64)
+	pageSize, pageNumber := pageParams(r)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var resp *marketplacepb.GetArtifactsResponse
+	var err error
+	if realmID > 0 {
+		resp, err = g.marketplaceClient.GetArtifactsByRealm(ctx, &marketplacepb.GetArtifactsByRealmRequest{
+			RealmId:    realmID,
+			PageSize:   pageSize,
+			PageNumber: pageNumber,
+		})
+	} else {
+		resp, err = g.marketplaceClient.GetArtifacts(ctx, &marketplacepb.GetArtifactsRequest{
+			Rarity:        query.Get("rarity"),
+			ArtifactType:  query.Get("artifact_type"),
+			MaxPowerLevel: int32(maxPowerLevel),
+			MaxManaCost:   maxManaCost,
+			PageSize:      pageSize,
+			PageNumber:    pageNumber,
+		})
+	}
+	if err != nil {
+		g.logger.Error("Get artifacts failed", "error", err)
+		writeGRPCError(w, err, "Failed to get artifacts")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handleMarketplaceScrolls(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	maxSkillLevel, _ := strconv.Atoi(query.Get("max_skill_level"))
+	maxManaCost, _ := strconv.ParseInt(query.Get("max_mana_cost"), 10, 64)
+	pageSize, pageNumber := pageParams(r)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := g.marketplaceClient.GetScrolls(ctx, &marketplacepb.GetScrollsRequest{
+		SkillType:     query.Get("skill_type"),
+		Rarity:        query.Get("rarity"),
+		MaxSkillLevel: int32(maxSkillLevel),
+		MaxManaCost:   maxManaCost,
+		PageSize:      pageSize,
+		PageNumber:    pageNumber,
+	})
+	if err != nil {
+		g.logger.Error("Get scrolls failed", "error", err)
+		writeGRPCError(w, err, "Failed to get scrolls")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handleMarketplaceSpells(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	maxPowerLevel, _ := strconv.Atoi(query.Get("max_power_level"))
+	maxManaCost, _ := strconv.ParseInt(query.Get("max_mana_cost"), 10, 64)
+	pageSize, pageNumber := pageParams(r)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := g.marketplaceClient.GetSpells(ctx, &marketplacepb.GetSpellsRequest{
+		SpellSchool:   query.Get("spell_school"),
+		Element:       query.Get("element"),
+		Rarity:        query.Get("rarity"),
+		MaxPowerLevel: int32(maxPowerLevel),
+		MaxManaCost:   maxManaCost,
+		PageSize:      pageSize,
+		PageNumber:    pageNumber,
+	})
+	if err != nil {
+		g.logger.Error("Get spells failed", "error", err)
+		writeGRPCError(w, err, "Failed to get spells")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handleSpellTeachers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	spellID, err := strconv.ParseInt(r.URL.Query().Get("spell_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid spell ID", http.StatusBadRequest)
+		return
+	}
+	maxPrice, _ := strconv.ParseInt(r.URL.Query().Get("max_price"), 10, 64)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := g.marketplaceClient.GetAvailableTeachers(ctx, &marketplacepb.GetAvailableTeachersRequest{
+		SpellId:  spellID,
+		MaxPrice: maxPrice,
+	})
+	if err != nil {
+		g.logger.Error("Get spell teachers failed", "error", err)
+		writeGRPCError(w, err, "Failed to get spell teachers")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// Marketplace actions
+
+func (g *Gateway) handlePurchaseArtifact(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req marketplacepb.PurchaseArtifactRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	req.IdempotencyKey = idempotencyKey(r, req.IdempotencyKey)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, req.WizardId) {
+		return
+	}
+
+	resp, err := g.marketplaceClient.PurchaseArtifact(ctx, &req)
+	if err != nil {
+		g.logger.Error("Purchase artifact failed", "error", err)
+		writeGRPCError(w, err, "Failed to purchase artifact")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handleEquipArtifact(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req marketplacepb.EquipArtifactRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, req.WizardId) {
+		return
+	}
+
+	resp, err := g.marketplaceClient.EquipArtifact(ctx, &req)
+	if err != nil {
+		g.logger.Error("Equip artifact failed", "error", err)
+		writeGRPCError(w, err, "Failed to equip artifact")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handlePurchaseScroll(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req marketplacepb.PurchaseScrollRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	req.IdempotencyKey = idempotencyKey(r, req.IdempotencyKey)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, req.WizardId) {
+		return
+	}
+
+	resp, err := g.marketplaceClient.PurchaseScroll(ctx, &req)
+	if err != nil {
+		g.logger.Error("Purchase scroll failed", "error", err)
+		writeGRPCError(w, err, "Failed to purchase scroll")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handleLearnSpell(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req marketplacepb.LearnSpellRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	req.IdempotencyKey = idempotencyKey(r, req.IdempotencyKey)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The student is the acting wizard; the teacher is paid
+	if !g.authorizeWizard(ctx, w, r, req.StudentWizardId) {
+		return
+	}
+
+	resp, err := g.marketplaceClient.LearnSpellFromWizard(ctx, &req)
+	if err != nil {
+		g.logger.Error("Learn spell failed", "error", err)
+		writeGRPCError(w, err, "Failed to learn spell")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handleOfferSpellTeaching(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req marketplacepb.OfferSpellTeachingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, req.WizardId) {
+		return
+	}
+
+	resp, err := g.marketplaceClient.OfferSpellTeaching(ctx, &req)
+	if err != nil {
+		g.logger.Error("Offer spell teaching failed", "error", err)
+		writeGRPCError(w, err, "Failed to offer spell teaching")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// Wizard inventory and history
+
+func (g *Gateway) handleWizardArtifacts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	wizardID, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/api/marketplace/artifacts/wizard/"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid wizard ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, wizardID) {
+		return
+	}
+
+	resp, err := g.marketplaceClient.GetWizardArtifacts(ctx, &marketplacepb.GetWizardArtifactsRequest{
+		WizardId: wizardID,
+	})
+	if err != nil {
+		g.logger.Error("Get wizard artifacts failed", "error", err)
+		writeGRPCError(w, err, "Failed to get wizard artifacts")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handleWizardScrolls(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	wizardID, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/api/marketplace/scrolls/wizard/"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid wizard ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, wizardID) {
+		return
+	}
+
+	resp, err := g.marketplaceClient.GetWizardScrolls(ctx, &marketplacepb.GetWizardScrollsRequest{
+		WizardId: wizardID,
+	})
+	if err != nil {
+		g.logger.Error("Get wizard scrolls failed", "error", err)
+		writeGRPCError(w, err, "Failed to get wizard scrolls")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handleWizardSpells(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	wizardID, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/api/marketplace/spells/wizard/"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid wizard ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, wizardID) {
+		return
+	}
+
+	resp, err := g.marketplaceClient.GetWizardSpells(ctx, &marketplacepb.GetWizardSpellsRequest{
+		WizardId: wizardID,
+	})
+	if err != nil {
+		g.logger.Error("Get wizard spells failed", "error", err)
+		writeGRPCError(w, err, "Failed to get wizard spells")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// handleWizardInventory returns a wizard's artifacts, scrolls and spells in one response
+func (g *Gateway) handleWizardInventory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	wizardID, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/api/marketplace/inventory/"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid wizard ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, wizardID) {
+		return
+	}
+
+	artifacts, err := g.marketplaceClient.GetWizardArtifacts(ctx, &marketplacepb.GetWizardArtifactsRequest{
+		WizardId: wizardID,
+	})
+	if err != nil {
+		g.logger.Error("Get wizard artifacts failed", "error", err)
+		writeGRPCError(w, err, "Failed to get inventory")
+		return
+	}
+
+	scrolls, err := g.marketplaceClient.GetWizardScrolls(ctx, &marketplacepb.GetWizardScrollsRequest{
+		WizardId: wizardID,
+	})
+	if err != nil {
+		g.logger.Error("Get wizard scrolls failed", "error", err)
+		writeGRPCError(w, err, "Failed to get inventory")
+		return
+	}
+
+	spells, err := g.marketplaceClient.GetWizardSpells(ctx, &marketplacepb.GetWizardSpellsRequest{
+		WizardId: wizardID,
+	})
+	if err != nil {
+		g.logger.Error("Get wizard spells failed", "error", err)
+		writeGRPCError(w, err, "Failed to get inventory")
+		return
+	}
+
+	resp := struct {
+		WizardID  int64                           `json:"wizard_id"`
+		Artifacts []*marketplacepb.WizardArtifact `json:"artifacts"`
+		Scrolls   []*marketplacepb.WizardScroll   `json:"scrolls"`
+		Spells    []*marketplacepb.WizardSpell    `json:"spells"`
+	}{
+		WizardID:  wizardID,
+		Artifacts: artifacts.Artifacts,
+		Scrolls:   scrolls.Scrolls,
+		Spells:    spells.Spells,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handleMarketplaceTransactions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	wizardID, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/api/marketplace/transactions/"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid wizard ID", http.StatusBadRequest)
+		return
+	}
+	pageSize, pageNumber := pageParams(r)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, wizardID) {
+		return
+	}
+
+	resp, err := g.marketplaceClient.GetMarketplaceTransactions(ctx, &marketplacepb.GetMarketplaceTransactionsRequest{
+		WizardId:        wizardID,
+		TransactionType: r.URL.Query().Get("transaction_type"),
+		PageSize:        pageSize,
+		PageNumber:      pageNumber,
+	})
+	if err != nil {
+		g.logger.Error("Get marketplace transactions failed", "error", err)
+		writeGRPCError(w, err, "Failed to get marketplace transactions")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// pageParams reads page_size and page_number from the query string with the gateway defaults
+func pageParams(r *http.Request) (int32, int32) {
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+	pageNumber, _ := strconv.Atoi(r.URL.Query().Get("page_number"))
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	if pageNumber <= 0 {
+		pageNumber = 1
+	}
+
+	return int32(pageSize), int32(pageNumber)
+}
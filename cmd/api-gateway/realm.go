@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	realmpb "github.com/tectix/mysticfunds/proto/realm"
+)
+
+// Realms and properties are created, edited and deleted only through the realm
+// service's gRPC API. Through the gateway, wizards can read them, list what they
+// own and buy what is for sale.
+func (g *Gateway) handleRealms(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch r.Method {
+	case http.MethodGet:
+		// Zero values fall back to the realm service's defaults
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+		pageNumber, _ := strconv.Atoi(r.URL.Query().Get("page_number"))
+
+		resp, err := g.realmClient.ListRealms(ctx, &realmpb.ListRealmsRequest{
+			PageSize:   int32(pageSize),
+			PageNumber: int32(pageNumber),
+		})
+		if err != nil {
+			g.logger.Error("Get realms failed", "error", err)
+			writeGRPCError(w, err, "Failed to get realms")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (g *Gateway) handleRealmByID(w http.ResponseWriter, r *http.Request) {
+	// Extract realm ID from URL path
+	path := strings.TrimPrefix(r.URL.Path, "/api/realms/")
+	realmID, err := strconv.ParseInt(path, 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid realm ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch r.Method {
+	case http.MethodGet:
+		resp, err := g.realmClient.GetRealm(ctx, &realmpb.GetRealmRequest{
+			Id: realmID,
+		})
+		if err != nil {
+			g.logger.Error("Get realm failed", "error", err)
+			writeGRPCError(w, err, "Failed to get realm")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (g *Gateway) handleProperties(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch r.Method {
+	case http.MethodGet:
+		realmID, _ := strconv.ParseInt(r.URL.Query().Get("realm_id"), 10, 64)
+		ownerID, _ := strconv.ParseInt(r.URL.Query().Get("owner_id"), 10, 64)
+		pageSize, pageNumber := pageParams(r)
+
+		resp, err := g.realmClient.ListProperties(ctx, &realmpb.ListPropertiesRequest{
+			RealmId:    realmID,
+			OwnerId:    ownerID,
+			PageSize:   pageSize,
+			PageNumber: pageNumber,
+		})
+		if err != nil {
+			g.logger.Error("List properties failed", "error", err)
+			writeGRPCError(w, err, "Failed to list properties")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (g *Gateway) handlePropertyByID(w http.ResponseWriter, r *http.Request) {
+	// Routes /api/properties/{id} and /api/properties/{id}/listing
+	path := strings.TrimPrefix(r.URL.Path, "/api/properties/")
+	idStr, action, _ := strings.Cut(path, "/")
+	propertyID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid property ID", http.StatusBadRequest)
+		return
+	}
+	if action == "listing" {
+		g.setPropertyListing(w, r, propertyID)
+		return
+	}
+	if action != "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	switch r.Method {
+	case http.MethodGet:
+		resp, err := g.realmClient.GetProperty(ctx, &realmpb.GetPropertyRequest{
+			Id: propertyID,
+		})
+		if err != nil {
+			g.logger.Error("Get property failed", "error", err)
+			writeGRPCError(w, err, "Failed to get property")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (g *Gateway) setPropertyListing(w http.ResponseWriter, r *http.Request, propertyID int64) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req realmpb.SetPropertyListingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	req.PropertyId = propertyID
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, req.OwnerId) {
+		return
+	}
+
+	resp, err := g.realmClient.SetPropertyListing(ctx, &req)
+	if err != nil {
+		g.logger.Error("Set property listing failed", "error", err)
+		writeGRPCError(w, err, "Failed to update property listing")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (g *Gateway) handleBuyProperty(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req realmpb.BuyPropertyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	req.IdempotencyKey = idempotencyKey(r, req.IdempotencyKey)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !g.authorizeWizard(ctx, w, r, req.BuyerId) {
+		return
+	}
+
+	resp, err := g.realmClient.BuyProperty(ctx, &req)
+	if err != nil {
+		g.logger.Error("Buy property failed", "error", err)
+		writeGRPCError(w, err, "Failed to buy property")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
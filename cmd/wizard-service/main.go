@@ -53,4 +53,5 @@ func main() {
 
 	log.Info("Shutting down Wizard Service")
 	grpcServer.GracefulStop()
+	wizardService.StopWorkers()
 }
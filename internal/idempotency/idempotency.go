@@ -0,0 +1,226 @@
+// Package idempotency lets clients retry mana-moving RPCs without applying them twice.
+//
+// The first request made with a key claims it and its response is stored. Later
+// requests that reuse the key with the same payload get the stored response back
+// instead of running again; reusing a key with a different payload is rejected.
+//
+// Requests applied in one local transaction use DoTx, which claims the key and
+// stores the response in that transaction, so a key is never left claimed without
+// a response. Do is for requests that call other services and cannot share a
+// transaction; if it fails to store the response after the request was applied,
+// the key stays claimed and retries get Aborted until it expires. Such failures are
+// logged with the scope and key. Once the request is confirmed applied, an operator
+// can release the key early with
+//
+//	UPDATE idempotency_keys SET expires_at = NOW()
+//	WHERE scope = '<scope>' AND idempotency_key = '<key>' AND response IS NULL
+//
+// after which a retry runs the request again, so only do this for requests whose
+// downstream calls are idempotent themselves.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"time"
+
+	"github.com/tectix/mysticfunds/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultTTL is how long a key is remembered after it is first used
+const DefaultTTL = 24 * time.Hour
+
+// Store persists idempotency keys in the idempotency_keys table
+type Store struct {
+	db     *sql.DB
+	logger logger.Logger
+	ttl    time.Duration
+}
+
+func NewStore(db *sql.DB, logger logger.Logger) *Store {
+	return &Store{db: db, logger: logger, ttl: DefaultTTL}
+}
+
+// querier is satisfied by both *sql.DB and *sql.Tx
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Do runs fn once per scope and key and replays its response for retries. An empty
+// key runs fn directly. If fn fails the key is released so the request can be
+// retried. scope is normally the RPC name, so keys never collide across methods.
+func Do[T proto.Message](ctx context.Context, s *Store, scope, key string, req proto.Message, fn func() (T, error)) (T, error) {
+	var zero T
+	if key == "" {
+		return fn()
+	}
+
+	hash, err := requestHash(req)
+	if err != nil {
+		return zero, status.Error(codes.InvalidArgument, "Failed to hash request")
+	}
+
+	claimed, err := s.claim(ctx, s.db, scope, key, hash)
+	if err != nil {
+		return zero, status.Error(codes.Internal, "Failed to record idempotency key")
+	}
+	if !claimed {
+		return replay[T](ctx, s.db, scope, key, hash)
+	}
+
+	resp, err := fn()
+	if err != nil {
+		// Nothing was applied, so let a retry run the request again
+		_, _ = s.db.ExecContext(context.WithoutCancel(ctx),
+			"DELETE FROM idempotency_keys WHERE scope = $1 AND idempotency_key = $2 AND response IS NULL",
+			scope, key)
+		return resp, err
+	}
+
+	// The request has been applied; if storing the response fails the key stays
+	// claimed, so retries are refused rather than applied again
+	if err := s.store(context.WithoutCancel(ctx), s.db, scope, key, resp); err != nil {
+		s.logger.Error("Failed to store idempotent response; key stays claimed until it expires",
+			"scope", scope, "idempotency_key", key, "error", err)
+	}
+
+	return resp, nil
+}
+
+// DoTx is Do for requests applied in a single transaction. It begins tx, claims the
+// key and stores fn's response in it, and commits only if fn succeeds, so the
+// response is recorded exactly when the request is applied. A retry made while the
+// first request is running waits for it to commit or roll back. fn must not commit
+// or roll back tx. An empty key still runs fn in a transaction.
+func DoTx[T proto.Message](ctx context.Context, s *Store, scope, key string, req proto.Message, fn func(tx *sql.Tx) (T, error)) (T, error) {
+	var zero T
+	var hash string
+	if key != "" {
+		var err error
+		hash, err = requestHash(req)
+		if err != nil {
+			return zero, status.Error(codes.InvalidArgument, "Failed to hash request")
+		}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return zero, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	if key != "" {
+		claimed, err := s.claim(ctx, tx, scope, key, hash)
+		if err != nil {
+			return zero, status.Error(codes.Internal, "Failed to record idempotency key")
+		}
+		if !claimed {
+			return replay[T](ctx, tx, scope, key, hash)
+		}
+	}
+
+	resp, err := fn(tx)
+	if err != nil {
+		return resp, err
+	}
+
+	if key != "" {
+		if err := s.store(ctx, tx, scope, key, resp); err != nil {
+			s.logger.Error("Failed to store idempotent response", "scope", scope, "error", err)
+			return zero, status.Error(codes.Internal, "Failed to store idempotent response")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return zero, status.Error(codes.Internal, "Failed to commit transaction")
+	}
+
+	return resp, nil
+}
+
+// replay returns the response stored for a key claimed by an earlier request
+func replay[T proto.Message](ctx context.Context, q querier, scope, key, hash string) (T, error) {
+	var zero T
+	var storedHash string
+	var response []byte
+	err := q.QueryRowContext(ctx,
+		"SELECT request_hash, response FROM idempotency_keys WHERE scope = $1 AND idempotency_key = $2",
+		scope, key).Scan(&storedHash, &response)
+	if err == sql.ErrNoRows {
+		// The first request failed and released the key after our claim attempt
+		return zero, status.Error(codes.Aborted, "Request with this idempotency key is in progress")
+	}
+	if err != nil {
+		return zero, status.Error(codes.Internal, "Failed to look up idempotency key")
+	}
+
+	if storedHash != hash {
+		return zero, status.Error(codes.FailedPrecondition, "Idempotency key was already used with a different request")
+	}
+	if response == nil {
+		return zero, status.Error(codes.Aborted, "Request with this idempotency key is in progress")
+	}
+
+	resp := zero.ProtoReflect().New().Interface().(T)
+	if err := proto.Unmarshal(response, resp); err != nil {
+		return zero, status.Error(codes.Internal, "Failed to replay stored response")
+	}
+	return resp, nil
+}
+
+// store records the response to the request that claimed the key
+func (s *Store) store(ctx context.Context, q querier, scope, key string, resp proto.Message) error {
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.ExecContext(ctx,
+		"UPDATE idempotency_keys SET response = $3, completed_at = NOW() WHERE scope = $1 AND idempotency_key = $2",
+		scope, key, data)
+	return err
+}
+
+// claim records the key for this request. It reports false if the key is already
+// held by an earlier request that has not expired.
+func (s *Store) claim(ctx context.Context, q querier, scope, key, hash string) (bool, error) {
+	result, err := q.ExecContext(ctx,
+		`INSERT INTO idempotency_keys (scope, idempotency_key, request_hash, expires_at)
+		 VALUES ($1, $2, $3, $4)
+		 ON CONFLICT (scope, idempotency_key) DO UPDATE
+		 SET request_hash = EXCLUDED.request_hash, response = NULL, completed_at = NULL,
+		     created_at = NOW(), expires_at = EXCLUDED.expires_at
+		 WHERE idempotency_keys.expires_at < NOW()`,
+		scope, key, hash, time.Now().Add(s.ttl))
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
+func requestHash(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
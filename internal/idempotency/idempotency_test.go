@@ -0,0 +1,251 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tectix/mysticfunds/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func setupTest(t *testing.T) (sqlmock.Sqlmock, *Store) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database connection: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return mock, NewStore(db, logger.NewLogger("error"))
+}
+
+func TestDoWithoutKey(t *testing.T) {
+	mock, store := setupTest(t)
+
+	calls := 0
+	resp, err := Do(context.Background(), store, "test.Transfer", "", wrapperspb.String("req"),
+		func() (*wrapperspb.StringValue, error) {
+			calls++
+			return wrapperspb.String("done"), nil
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "done", resp.Value)
+	assert.Equal(t, 1, calls)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDoStoresResponse(t *testing.T) {
+	mock, store := setupTest(t)
+
+	stored, _ := proto.Marshal(wrapperspb.String("done"))
+	mock.ExpectExec("INSERT INTO idempotency_keys").
+		WithArgs("test.Transfer", "key-1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE idempotency_keys SET response = \\$3").
+		WithArgs("test.Transfer", "key-1", stored).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	resp, err := Do(context.Background(), store, "test.Transfer", "key-1", wrapperspb.String("req"),
+		func() (*wrapperspb.StringValue, error) {
+			return wrapperspb.String("done"), nil
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "done", resp.Value)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDoReplaysResponse(t *testing.T) {
+	mock, store := setupTest(t)
+
+	hash, _ := requestHash(wrapperspb.String("req"))
+	stored, _ := proto.Marshal(wrapperspb.String("done"))
+	mock.ExpectExec("INSERT INTO idempotency_keys").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT request_hash, response FROM idempotency_keys").
+		WithArgs("test.Transfer", "key-1").
+		WillReturnRows(sqlmock.NewRows([]string{"request_hash", "response"}).AddRow(hash, stored))
+
+	resp, err := Do(context.Background(), store, "test.Transfer", "key-1", wrapperspb.String("req"),
+		func() (*wrapperspb.StringValue, error) {
+			t.Fatal("request must not run again")
+			return nil, nil
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "done", resp.Value)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDoRejectsDifferentPayload(t *testing.T) {
+	mock, store := setupTest(t)
+
+	hash, _ := requestHash(wrapperspb.String("original"))
+	mock.ExpectExec("INSERT INTO idempotency_keys").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT request_hash, response FROM idempotency_keys").
+		WillReturnRows(sqlmock.NewRows([]string{"request_hash", "response"}).AddRow(hash, nil))
+
+	_, err := Do(context.Background(), store, "test.Transfer", "key-1", wrapperspb.String("changed"),
+		func() (*wrapperspb.StringValue, error) {
+			t.Fatal("request must not run")
+			return nil, nil
+		})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDoRejectsConcurrentRequest(t *testing.T) {
+	mock, store := setupTest(t)
+
+	hash, _ := requestHash(wrapperspb.String("req"))
+	mock.ExpectExec("INSERT INTO idempotency_keys").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT request_hash, response FROM idempotency_keys").
+		WillReturnRows(sqlmock.NewRows([]string{"request_hash", "response"}).AddRow(hash, nil))
+
+	_, err := Do(context.Background(), store, "test.Transfer", "key-1", wrapperspb.String("req"),
+		func() (*wrapperspb.StringValue, error) {
+			t.Fatal("request must not run")
+			return nil, nil
+		})
+
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDoReleasesKeyOnError(t *testing.T) {
+	mock, store := setupTest(t)
+
+	mock.ExpectExec("INSERT INTO idempotency_keys").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM idempotency_keys").
+		WithArgs("test.Transfer", "key-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	failure := errors.New("insufficient mana")
+	_, err := Do(context.Background(), store, "test.Transfer", "key-1", wrapperspb.String("req"),
+		func() (*wrapperspb.StringValue, error) {
+			return nil, failure
+		})
+
+	assert.ErrorIs(t, err, failure)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDoKeepsResponseWhenStoreFails(t *testing.T) {
+	mock, store := setupTest(t)
+
+	mock.ExpectExec("INSERT INTO idempotency_keys").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE idempotency_keys SET response = \\$3").
+		WillReturnError(errors.New("connection reset"))
+
+	resp, err := Do(context.Background(), store, "test.Transfer", "key-1", wrapperspb.String("req"),
+		func() (*wrapperspb.StringValue, error) {
+			return wrapperspb.String("done"), nil
+		})
+
+	// The request was applied, so its response is returned even though it was not stored
+	assert.NoError(t, err)
+	assert.Equal(t, "done", resp.Value)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDoTxStoresResponseInTransaction(t *testing.T) {
+	mock, store := setupTest(t)
+
+	stored, _ := proto.Marshal(wrapperspb.String("done"))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO idempotency_keys").
+		WithArgs("test.Transfer", "key-1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE wizards").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE idempotency_keys SET response = \\$3").
+		WithArgs("test.Transfer", "key-1", stored).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	resp, err := DoTx(context.Background(), store, "test.Transfer", "key-1", wrapperspb.String("req"),
+		func(tx *sql.Tx) (*wrapperspb.StringValue, error) {
+			if _, err := tx.Exec("UPDATE wizards SET mana_balance = 0"); err != nil {
+				return nil, err
+			}
+			return wrapperspb.String("done"), nil
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "done", resp.Value)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDoTxRollsBackWhenStoreFails(t *testing.T) {
+	mock, store := setupTest(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO idempotency_keys").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE idempotency_keys SET response = \\$3").
+		WillReturnError(errors.New("connection reset"))
+	mock.ExpectRollback()
+
+	_, err := DoTx(context.Background(), store, "test.Transfer", "key-1", wrapperspb.String("req"),
+		func(tx *sql.Tx) (*wrapperspb.StringValue, error) {
+			return wrapperspb.String("done"), nil
+		})
+
+	// Neither the request nor the claim is committed, so a retry runs it again
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDoTxRollsBackOnError(t *testing.T) {
+	mock, store := setupTest(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO idempotency_keys").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectRollback()
+
+	failure := errors.New("insufficient mana")
+	_, err := DoTx(context.Background(), store, "test.Transfer", "key-1", wrapperspb.String("req"),
+		func(tx *sql.Tx) (*wrapperspb.StringValue, error) {
+			return nil, failure
+		})
+
+	assert.ErrorIs(t, err, failure)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDoTxReplaysResponse(t *testing.T) {
+	mock, store := setupTest(t)
+
+	hash, _ := requestHash(wrapperspb.String("req"))
+	stored, _ := proto.Marshal(wrapperspb.String("done"))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO idempotency_keys").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT request_hash, response FROM idempotency_keys").
+		WithArgs("test.Transfer", "key-1").
+		WillReturnRows(sqlmock.NewRows([]string{"request_hash", "response"}).AddRow(hash, stored))
+	mock.ExpectRollback()
+
+	resp, err := DoTx(context.Background(), store, "test.Transfer", "key-1", wrapperspb.String("req"),
+		func(tx *sql.Tx) (*wrapperspb.StringValue, error) {
+			t.Fatal("request must not run again")
+			return nil, nil
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "done", resp.Value)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,259 @@
+// Package leader elects one replica of a service to run its background workers.
+//
+// Replicas campaign for a Postgres session-level advisory lock, one per election
+// name. The replica holding it runs the workers and records itself in the
+// worker_leaders table with a heartbeat. The lock belongs to the leader's database
+// session, so when the leader dies or loses its connection Postgres releases it,
+// and the next replica to campaign takes over.
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tectix/mysticfunds/pkg/config"
+	"github.com/tectix/mysticfunds/pkg/logger"
+	"github.com/tectix/mysticfunds/pkg/sim"
+)
+
+// DefaultInterval is how often replicas campaign and the leader heartbeats
+const DefaultInterval = 5 * time.Second
+
+// Worker is a background worker that only runs on the leader. Start may be called
+// again after Stop when leadership is lost and regained.
+type Worker interface {
+	Start()
+	Stop()
+}
+
+// Status is what a replica knows about an election
+type Status struct {
+	Election string
+	Instance string
+	Leading  bool
+	// Leader is the instance that last won the election, empty if none has
+	Leader      string
+	ElectedAt   time.Time
+	HeartbeatAt time.Time
+}
+
+// Elector campaigns for leadership of one election and runs the workers while it
+// holds it
+type Elector struct {
+	db       *sql.DB
+	log      logger.Logger
+	clock    sim.Clock
+	name     string
+	instance string
+	key      int64
+	interval time.Duration
+	workers  []Worker
+	done     chan struct{}
+	mutex    sync.Mutex
+	conn     *sql.Conn
+}
+
+// NewElector creates an elector for the named election. instance identifies this
+// replica in the status.
+func NewElector(db *sql.DB, log logger.Logger, clock sim.Clock, name, instance string, workers ...Worker) *Elector {
+	return &Elector{
+		db:       db,
+		log:      log,
+		clock:    clock,
+		name:     name,
+		instance: instance,
+		key:      lockKey(name),
+		interval: DefaultInterval,
+		workers:  workers,
+		done:     make(chan struct{}),
+	}
+}
+
+// InstanceID names this replica: INSTANCE_ID if set, otherwise the host name and
+// process ID
+func InstanceID(cfg *config.Config) string {
+	if id := cfg.GetString("INSTANCE_ID", ""); id != "" {
+		return id
+	}
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+// lockKey derives the advisory lock key of an election from its name
+func lockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return int64(h.Sum64())
+}
+
+// Start begins campaigning
+func (e *Elector) Start() {
+	e.log.Info("Starting leader election", "election", e.name, "instance", e.instance)
+	go e.run()
+}
+
+// Stop stops campaigning and, if this replica is leading, stops the workers and
+// releases the lock so another replica can take over straight away
+func (e *Elector) Stop() {
+	close(e.done)
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.resign(context.Background(), "shutting down")
+}
+
+func (e *Elector) run() {
+	ticker := e.clock.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), e.interval)
+		e.campaign(ctx)
+		cancel()
+
+		select {
+		case <-ticker.C():
+		case <-e.done:
+			return
+		}
+	}
+}
+
+// campaign tries to take the lock, or heartbeats if this replica already holds it
+func (e *Elector) campaign(ctx context.Context) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	select {
+	case <-e.done:
+		return
+	default:
+	}
+
+	if e.conn != nil {
+		e.heartbeat(ctx)
+		return
+	}
+
+	conn, err := e.db.Conn(ctx)
+	if err != nil {
+		e.log.Error("Failed to get a connection for leader election", "election", e.name, "error", err)
+		return
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", e.key).Scan(&acquired); err != nil {
+		e.log.Error("Failed to campaign for leadership", "election", e.name, "error", err)
+		discard(conn)
+		return
+	}
+	if !acquired {
+		conn.Close()
+		return
+	}
+
+	now := e.clock.Now()
+	_, err = conn.ExecContext(ctx,
+		`INSERT INTO worker_leaders (name, instance, elected_at, heartbeat_at)
+		 VALUES ($1, $2, $3, $3)
+		 ON CONFLICT (name) DO UPDATE SET instance = EXCLUDED.instance,
+		 elected_at = EXCLUDED.elected_at, heartbeat_at = EXCLUDED.heartbeat_at`,
+		e.name, e.instance, now)
+	if err != nil {
+		e.log.Error("Failed to record leadership", "election", e.name, "error", err)
+		release(ctx, conn, e.key)
+		return
+	}
+
+	e.conn = conn
+	e.log.Info("Elected leader", "election", e.name, "instance", e.instance)
+	for _, w := range e.workers {
+		w.Start()
+	}
+}
+
+// heartbeat proves the leader's session is still alive. If it is not, the lock
+// has gone with it and another replica may already be leading.
+func (e *Elector) heartbeat(ctx context.Context) {
+	result, err := e.conn.ExecContext(ctx,
+		"UPDATE worker_leaders SET heartbeat_at = $1 WHERE name = $2 AND instance = $3",
+		e.clock.Now(), e.name, e.instance)
+	if err == nil {
+		var rows int64
+		if rows, err = result.RowsAffected(); err == nil && rows == 0 {
+			err = fmt.Errorf("leadership recorded for another instance")
+		}
+	}
+	if err != nil {
+		e.log.Error("Lost leadership", "election", e.name, "instance", e.instance, "error", err)
+		e.stopWorkers()
+		discard(e.conn)
+		e.conn = nil
+	}
+}
+
+// resign stops the workers and releases the lock if this replica holds it
+func (e *Elector) resign(ctx context.Context, reason string) {
+	if e.conn == nil {
+		return
+	}
+
+	e.log.Info("Resigning leadership", "election", e.name, "instance", e.instance, "reason", reason)
+	e.stopWorkers()
+	release(ctx, e.conn, e.key)
+	e.conn = nil
+}
+
+func (e *Elector) stopWorkers() {
+	for i := len(e.workers) - 1; i >= 0; i-- {
+		e.workers[i].Stop()
+	}
+}
+
+// release unlocks the lock and returns the connection to the pool. A connection
+// that cannot be unlocked is closed instead, which releases the lock too.
+func release(ctx context.Context, conn *sql.Conn, key int64) {
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", key); err != nil {
+		discard(conn)
+		return
+	}
+	conn.Close()
+}
+
+// discard closes the connection's session rather than returning it to the pool,
+// so no lock it may hold outlives it
+func discard(conn *sql.Conn) {
+	_ = conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+	conn.Close()
+}
+
+// Leading reports whether this replica currently holds the lock
+func (e *Elector) Leading() bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.conn != nil
+}
+
+// Status reports whether this replica is leading and which instance last won the
+// election. A heartbeat more than a few intervals old means the leader has died
+// and no replica has taken over yet.
+func (e *Elector) Status(ctx context.Context) (Status, error) {
+	status := Status{Election: e.name, Instance: e.instance, Leading: e.Leading()}
+
+	err := e.db.QueryRowContext(ctx,
+		"SELECT instance, elected_at, heartbeat_at FROM worker_leaders WHERE name = $1",
+		e.name).Scan(&status.Leader, &status.ElectedAt, &status.HeartbeatAt)
+	if err != nil && err != sql.ErrNoRows {
+		return Status{}, fmt.Errorf("get leader: %w", err)
+	}
+	return status, nil
+}
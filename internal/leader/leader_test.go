@@ -0,0 +1,164 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tectix/mysticfunds/pkg/logger"
+	"github.com/tectix/mysticfunds/pkg/sim"
+)
+
+var testEpoch = time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+// countingWorker records how often it was started and stopped
+type countingWorker struct {
+	starts, stops int
+}
+
+func (w *countingWorker) Start() { w.starts++ }
+func (w *countingWorker) Stop()  { w.stops++ }
+
+func setupTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *Elector, *countingWorker) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+
+	worker := &countingWorker{}
+	elector := NewElector(db, logger.NewLogger("info"), sim.NewFakeClock(testEpoch), "test-workers", "replica-a", worker)
+	return db, mock, elector, worker
+}
+
+func expectElected(mock sqlmock.Sqlmock, key int64) {
+	mock.ExpectQuery("SELECT pg_try_advisory_lock\\(\\$1\\)").
+		WithArgs(key).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+	mock.ExpectExec("INSERT INTO worker_leaders").
+		WithArgs("test-workers", "replica-a", testEpoch).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestCampaignElectsLeader(t *testing.T) {
+	db, mock, elector, worker := setupTest(t)
+	defer db.Close()
+
+	expectElected(mock, elector.key)
+	elector.campaign(context.Background())
+
+	assert.True(t, elector.Leading())
+	assert.Equal(t, 1, worker.starts)
+
+	// While leading it only heartbeats
+	mock.ExpectExec("UPDATE worker_leaders SET heartbeat_at = \\$1").
+		WithArgs(testEpoch, "test-workers", "replica-a").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	elector.campaign(context.Background())
+
+	assert.True(t, elector.Leading())
+	assert.Equal(t, 1, worker.starts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCampaignFollowsWhenLockIsHeld(t *testing.T) {
+	db, mock, elector, worker := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT pg_try_advisory_lock\\(\\$1\\)").
+		WithArgs(elector.key).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(false))
+	elector.campaign(context.Background())
+
+	assert.False(t, elector.Leading())
+	assert.Equal(t, 0, worker.starts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLostSessionStopsWorkers(t *testing.T) {
+	db, mock, elector, worker := setupTest(t)
+	defer db.Close()
+
+	expectElected(mock, elector.key)
+	elector.campaign(context.Background())
+
+	// The session died, and the lock with it
+	mock.ExpectExec("UPDATE worker_leaders SET heartbeat_at = \\$1").
+		WillReturnError(errors.New("connection reset by peer"))
+	elector.campaign(context.Background())
+
+	assert.False(t, elector.Leading())
+	assert.Equal(t, 1, worker.stops)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHeartbeatDemotesWhenAnotherInstanceLeads(t *testing.T) {
+	db, mock, elector, worker := setupTest(t)
+	defer db.Close()
+
+	expectElected(mock, elector.key)
+	elector.campaign(context.Background())
+
+	mock.ExpectExec("UPDATE worker_leaders SET heartbeat_at = \\$1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	elector.campaign(context.Background())
+
+	assert.False(t, elector.Leading())
+	assert.Equal(t, 1, worker.stops)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStopReleasesLock(t *testing.T) {
+	db, mock, elector, worker := setupTest(t)
+	defer db.Close()
+
+	expectElected(mock, elector.key)
+	elector.campaign(context.Background())
+
+	mock.ExpectExec("SELECT pg_advisory_unlock\\(\\$1\\)").
+		WithArgs(elector.key).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	elector.Stop()
+
+	assert.False(t, elector.Leading())
+	assert.Equal(t, 1, worker.stops)
+
+	// A stopped elector no longer campaigns
+	elector.campaign(context.Background())
+	assert.Equal(t, 1, worker.starts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStatus(t *testing.T) {
+	db, mock, elector, _ := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT instance, elected_at, heartbeat_at FROM worker_leaders").
+		WithArgs("test-workers").
+		WillReturnRows(sqlmock.NewRows([]string{"instance", "elected_at", "heartbeat_at"}).
+			AddRow("replica-b", testEpoch, testEpoch.Add(5*time.Second)))
+
+	status, err := elector.Status(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, status.Leading)
+	assert.Equal(t, "replica-a", status.Instance)
+	assert.Equal(t, "replica-b", status.Leader)
+	assert.Equal(t, testEpoch.Add(5*time.Second), status.HeartbeatAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStatusBeforeAnyElection(t *testing.T) {
+	db, mock, elector, _ := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT instance, elected_at, heartbeat_at FROM worker_leaders").
+		WillReturnRows(sqlmock.NewRows([]string{"instance", "elected_at", "heartbeat_at"}))
+
+	status, err := elector.Status(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, status.Leader)
+}
@@ -0,0 +1,264 @@
+// Package ledger records mana movements as balanced double-entry journal entries.
+//
+// Every change to a wizard's mana is an entry whose postings sum to zero. Wizard
+// accounts mirror wizards.mana_balance, which is kept as a cached balance and is
+// checked against the ledger by a deferred trigger when each transaction commits.
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// Account types
+const (
+	AccountWizard     = "wizard"
+	AccountSystem     = "system"
+	AccountEscrow     = "escrow"
+	AccountInvestment = "investment"
+)
+
+var (
+	ErrUnbalanced       = errors.New("ledger entry postings do not sum to zero")
+	ErrInvalidAccount   = errors.New("invalid ledger account")
+	ErrWizardNotFound   = errors.New("wizard not found")
+	ErrDuplicate        = errors.New("ledger entry reference already recorded")
+	ErrInsufficientMana = errors.New("wizard mana balance cannot go below zero")
+)
+
+// Account identifies a ledger account. The system account has no wizard.
+type Account struct {
+	Type     string
+	WizardID int64
+}
+
+func Wizard(wizardID int64) Account     { return Account{Type: AccountWizard, WizardID: wizardID} }
+func System() Account                   { return Account{Type: AccountSystem} }
+func Escrow(wizardID int64) Account     { return Account{Type: AccountEscrow, WizardID: wizardID} }
+func Investment(wizardID int64) Account { return Account{Type: AccountInvestment, WizardID: wizardID} }
+
+// Validate checks the account type and that only the system account has no wizard
+func (a Account) Validate() error {
+	switch a.Type {
+	case AccountSystem:
+		if a.WizardID != 0 {
+			return fmt.Errorf("%w: system account cannot belong to a wizard", ErrInvalidAccount)
+		}
+	case AccountWizard, AccountEscrow, AccountInvestment:
+		if a.WizardID <= 0 {
+			return fmt.Errorf("%w: %s account requires a wizard", ErrInvalidAccount, a.Type)
+		}
+	default:
+		return fmt.Errorf("%w: unknown account type %q", ErrInvalidAccount, a.Type)
+	}
+
+	return nil
+}
+
+// Posting credits (positive) or debits (negative) an account
+type Posting struct {
+	Account Account
+	Amount  int64
+}
+
+// Entry is a journal entry. Its postings must sum to zero. A non-empty
+// Reference is unique across the ledger, so an entry can be posted at most once.
+type Entry struct {
+	Type        string
+	Description string
+	Reference   string
+	Postings    []Posting
+}
+
+// Transfer builds an entry that moves amount from one account to another
+func Transfer(entryType, description string, from, to Account, amount int64) Entry {
+	return Entry{
+		Type:        entryType,
+		Description: description,
+		Postings: []Posting{
+			{Account: from, Amount: -amount},
+			{Account: to, Amount: amount},
+		},
+	}
+}
+
+// Amount returns the net amount posted to account by the entry
+func (e Entry) Amount(account Account) int64 {
+	var total int64
+	for _, posting := range e.Postings {
+		if posting.Account == account {
+			total += posting.Amount
+		}
+	}
+	return total
+}
+
+// Validate checks that the entry is balanced and every posting is well formed
+func (e Entry) Validate() error {
+	if e.Type == "" {
+		return errors.New("ledger entry requires a type")
+	}
+
+	if len(e.Postings) < 2 {
+		return fmt.Errorf("%w: an entry needs at least two postings", ErrUnbalanced)
+	}
+
+	var sum int64
+	for _, posting := range e.Postings {
+		if err := posting.Account.Validate(); err != nil {
+			return err
+		}
+		if posting.Amount == 0 {
+			return errors.New("ledger postings cannot be zero")
+		}
+		sum += posting.Amount
+	}
+
+	if sum != 0 {
+		return ErrUnbalanced
+	}
+
+	return nil
+}
+
+// Posted is the result of recording an entry
+type Posted struct {
+	EntryID int64
+	// Balances holds the new mana balance of every wizard account the entry touched
+	Balances map[int64]int64
+}
+
+// Post records e within tx and applies its wizard postings to wizards.mana_balance.
+// Callers are responsible for locking wizard rows and checking for sufficient funds;
+// a posting that would still take a balance below zero fails with ErrInsufficientMana.
+func Post(ctx context.Context, tx *sql.Tx, e Entry) (*Posted, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+
+	var reference sql.NullString
+	if e.Reference != "" {
+		reference = sql.NullString{String: e.Reference, Valid: true}
+	}
+
+	posted := &Posted{Balances: make(map[int64]int64)}
+	err := tx.QueryRowContext(ctx,
+		"INSERT INTO ledger_entries (entry_type, description, reference) VALUES ($1, $2, $3) RETURNING id",
+		e.Type, e.Description, reference).Scan(&posted.EntryID)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return nil, ErrDuplicate
+		}
+		return nil, fmt.Errorf("insert ledger entry: %w", err)
+	}
+
+	for _, posting := range e.Postings {
+		accountID, err := accountID(ctx, tx, posting.Account)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO ledger_postings (entry_id, account_id, amount) VALUES ($1, $2, $3)",
+			posted.EntryID, accountID, posting.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("insert ledger posting: %w", err)
+		}
+
+		if posting.Account.Type != AccountWizard {
+			continue
+		}
+
+		var balance int64
+		err = tx.QueryRowContext(ctx,
+			"UPDATE wizards SET mana_balance = mana_balance + $1 WHERE id = $2 RETURNING mana_balance",
+			posting.Amount, posting.Account.WizardID).Scan(&balance)
+		if err == sql.ErrNoRows {
+			return nil, ErrWizardNotFound
+		}
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23514" {
+			return nil, ErrInsufficientMana
+		}
+		if err != nil {
+			return nil, fmt.Errorf("update wizard balance: %w", err)
+		}
+		posted.Balances[posting.Account.WizardID] = balance
+	}
+
+	return posted, nil
+}
+
+// Recorded reports whether an entry with the given reference has been posted
+func Recorded(ctx context.Context, tx *sql.Tx, reference string) (bool, error) {
+	var exists bool
+	err := tx.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM ledger_entries WHERE reference = $1)",
+		reference).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("look up ledger reference: %w", err)
+	}
+
+	return exists, nil
+}
+
+// accountID returns the id of account, opening it on first use
+func accountID(ctx context.Context, tx *sql.Tx, account Account) (int64, error) {
+	var wizardID sql.NullInt64
+	if account.WizardID > 0 {
+		wizardID = sql.NullInt64{Int64: account.WizardID, Valid: true}
+	}
+
+	var id int64
+	err := tx.QueryRowContext(ctx,
+		`INSERT INTO ledger_accounts (account_type, wizard_id) VALUES ($1, $2)
+		 ON CONFLICT (account_type, COALESCE(wizard_id, 0)) DO UPDATE SET account_type = EXCLUDED.account_type
+		 RETURNING id`,
+		account.Type, wizardID).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("open ledger account: %w", err)
+	}
+
+	return id, nil
+}
+
+// Mismatch is a wizard whose cached balance disagrees with the ledger
+type Mismatch struct {
+	WizardID      int64
+	CachedBalance int64
+	LedgerBalance int64
+}
+
+// Verify compares every wizard's cached mana balance with its ledger balance
+func Verify(ctx context.Context, db *sql.DB) ([]Mismatch, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT w.id, w.mana_balance, COALESCE(l.balance, 0)
+		 FROM wizards w
+		 LEFT JOIN (
+		     SELECT a.wizard_id, SUM(p.amount) AS balance
+		     FROM ledger_postings p
+		     JOIN ledger_accounts a ON p.account_id = a.id
+		     WHERE a.account_type = 'wizard'
+		     GROUP BY a.wizard_id
+		 ) l ON l.wizard_id = w.id
+		 WHERE w.mana_balance <> COALESCE(l.balance, 0)
+		 ORDER BY w.id`)
+	if err != nil {
+		return nil, fmt.Errorf("verify ledger: %w", err)
+	}
+	defer rows.Close()
+
+	var mismatches []Mismatch
+	for rows.Next() {
+		var m Mismatch
+		if err := rows.Scan(&m.WizardID, &m.CachedBalance, &m.LedgerBalance); err != nil {
+			return nil, fmt.Errorf("scan ledger mismatch: %w", err)
+		}
+		mismatches = append(mismatches, m)
+	}
+
+	return mismatches, rows.Err()
+}
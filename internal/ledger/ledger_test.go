@@ -0,0 +1,174 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntryValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		entry   Entry
+		wantErr error
+	}{
+		{
+			name:  "balanced transfer",
+			entry: Transfer("transfer", "", Wizard(1), Wizard(2), 100),
+		},
+		{
+			name: "three way split",
+			entry: Entry{Type: "investment_return", Postings: []Posting{
+				{Account: Investment(1), Amount: -1000},
+				{Account: System(), Amount: -150},
+				{Account: Wizard(1), Amount: 1150},
+			}},
+		},
+		{
+			name: "unbalanced",
+			entry: Entry{Type: "mana_update", Postings: []Posting{
+				{Account: Wizard(1), Amount: 100},
+				{Account: System(), Amount: -90},
+			}},
+			wantErr: ErrUnbalanced,
+		},
+		{
+			name: "single posting",
+			entry: Entry{Type: "mana_update", Postings: []Posting{
+				{Account: Wizard(1), Amount: 0},
+			}},
+			wantErr: ErrUnbalanced,
+		},
+		{
+			name:    "wizard account without wizard",
+			entry:   Transfer("transfer", "", Wizard(0), System(), 10),
+			wantErr: ErrInvalidAccount,
+		},
+		{
+			name:    "unknown account type",
+			entry:   Transfer("transfer", "", Account{Type: "treasury"}, System(), 10),
+			wantErr: ErrInvalidAccount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.entry.Validate()
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEntryAmount(t *testing.T) {
+	entry := Transfer("transfer", "", Wizard(1), Wizard(2), 250)
+
+	assert.Equal(t, int64(-250), entry.Amount(Wizard(1)))
+	assert.Equal(t, int64(250), entry.Amount(Wizard(2)))
+	assert.Equal(t, int64(0), entry.Amount(System()))
+}
+
+func TestPost(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database connection: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO ledger_entries").
+		WithArgs("job_reward", "Job assignment 5", nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(42))
+	mock.ExpectQuery("INSERT INTO ledger_accounts").
+		WithArgs("system", nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec("INSERT INTO ledger_postings").
+		WithArgs(42, 1, -300).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("INSERT INTO ledger_accounts").
+		WithArgs("wizard", 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(9))
+	mock.ExpectExec("INSERT INTO ledger_postings").
+		WithArgs(42, 9, 300).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectQuery("UPDATE wizards SET mana_balance = mana_balance \\+ \\$1").
+		WithArgs(300, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(1300))
+	mock.ExpectCommit()
+
+	tx, err := db.Begin()
+	assert.NoError(t, err)
+
+	posted, err := Post(context.Background(), tx,
+		Transfer("job_reward", "Job assignment 5", System(), Wizard(7), 300))
+	assert.NoError(t, err)
+	assert.NoError(t, tx.Commit())
+
+	assert.Equal(t, int64(42), posted.EntryID)
+	assert.Equal(t, map[int64]int64{7: 1300}, posted.Balances)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostDuplicateReference(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database connection: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO ledger_entries").
+		WithArgs("mana_update", "Investment return", "investment:3:return").
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	tx, err := db.Begin()
+	assert.NoError(t, err)
+
+	entry := Transfer("mana_update", "Investment return", System(), Wizard(7), 300)
+	entry.Reference = "investment:3:return"
+	_, err = Post(context.Background(), tx, entry)
+	assert.ErrorIs(t, err, ErrDuplicate)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostRejectsUnbalancedEntry(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database connection: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	tx, err := db.Begin()
+	assert.NoError(t, err)
+
+	_, err = Post(context.Background(), tx, Entry{Type: "mana_update", Postings: []Posting{
+		{Account: Wizard(1), Amount: 100},
+		{Account: System(), Amount: -1},
+	}})
+	assert.ErrorIs(t, err, ErrUnbalanced)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestVerify(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database connection: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT w.id, w.mana_balance, COALESCE\\(l.balance, 0\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "mana_balance", "balance"}).AddRow(3, 500, 450))
+
+	mismatches, err := Verify(context.Background(), db)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Mismatch{{WizardID: 3, CachedBalance: 500, LedgerBalance: 450}}, mismatches)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,31 @@
+// Package ledgertest sets up sqlmock expectations for ledger.Post
+package ledgertest
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/tectix/mysticfunds/internal/ledger"
+)
+
+// ExpectPost expects entry to be posted. balances gives the balance each wizard
+// account is left with after its posting.
+func ExpectPost(mock sqlmock.Sqlmock, entry ledger.Entry, balances map[int64]int64) {
+	mock.ExpectQuery("INSERT INTO ledger_entries").
+		WithArgs(entry.Type, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	for i, posting := range entry.Postings {
+		mock.ExpectQuery("INSERT INTO ledger_accounts").
+			WithArgs(posting.Account.Type, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(i + 1))
+		mock.ExpectExec("INSERT INTO ledger_postings").
+			WithArgs(1, i+1, posting.Amount).
+			WillReturnResult(sqlmock.NewResult(int64(i+1), 1))
+
+		if posting.Account.Type == ledger.AccountWizard {
+			mock.ExpectQuery("UPDATE wizards SET mana_balance = mana_balance \\+ \\$1").
+				WithArgs(posting.Amount, posting.Account.WizardID).
+				WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(balances[posting.Account.WizardID]))
+		}
+	}
+}
@@ -0,0 +1,435 @@
+package mana
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	pb "github.com/tectix/mysticfunds/proto/mana"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxInvestmentDurationHours caps an investment type's term at one year
+	maxInvestmentDurationHours = 24 * 365
+	// maxBaseReturnRate keeps base rates sane; the floor is minReturnRate
+	maxBaseReturnRate = 100.0
+)
+
+// Audit log actions
+const (
+	investmentTypeCreated     = "created"
+	investmentTypeUpdated     = "updated"
+	investmentTypeActivated   = "activated"
+	investmentTypeDeactivated = "deactivated"
+)
+
+const investmentTypeColumns = `id, name, description, min_amount, max_amount, duration_hours, base_return_rate,
+	risk_level, early_exit_penalties, current_version, is_active`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// rowQueryer is satisfied by both *sql.DB and *sql.Tx
+type rowQueryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// openTerms are the terms a new investment in a type is opened under
+type openTerms struct {
+	versionId     int64
+	minAmount     int64
+	maxAmount     int64 // 0 means no limit
+	durationHours int32
+}
+
+func (t *openTerms) allows(amount int64) bool {
+	return amount >= t.minAmount && (t.maxAmount == 0 || amount <= t.maxAmount)
+}
+
+// currentTerms returns the current version of an active investment type, or
+// sql.ErrNoRows if there is no such type
+func currentTerms(ctx context.Context, q rowQueryer, investmentTypeId int64) (*openTerms, error) {
+	var terms openTerms
+	err := q.QueryRowContext(ctx,
+		`SELECT v.id, v.min_amount, COALESCE(v.max_amount, 0), v.duration_hours
+		 FROM investment_types t
+		 JOIN investment_type_versions v ON v.investment_type_id = t.id AND v.version = t.current_version
+		 WHERE t.id = $1 AND t.is_active = true`,
+		investmentTypeId).Scan(&terms.versionId, &terms.minAmount, &terms.maxAmount, &terms.durationHours)
+	if err != nil {
+		return nil, err
+	}
+	return &terms, nil
+}
+
+// scanInvestmentType reads a row selected with investmentTypeColumns
+func scanInvestmentType(row rowScanner) (*pb.InvestmentType, error) {
+	var it pb.InvestmentType
+	var description sql.NullString
+	var maxAmount sql.NullInt64
+	var penaltiesJSON []byte
+
+	if err := row.Scan(
+		&it.Id,
+		&it.Name,
+		&description,
+		&it.MinAmount,
+		&maxAmount,
+		&it.DurationHours,
+		&it.BaseReturnRate,
+		&it.RiskLevel,
+		&penaltiesJSON,
+		&it.Version,
+		&it.IsActive,
+	); err != nil {
+		return nil, err
+	}
+
+	penalties, err := parsePenaltySchedule(penaltiesJSON)
+	if err != nil {
+		return nil, err
+	}
+	it.EarlyExitPenalties = penalties.toProto()
+	it.Description = description.String
+	it.MaxAmount = maxAmount.Int64
+
+	return &it, nil
+}
+
+// validateTerms checks terms from an admin request and returns their penalty
+// schedule encoded for storage
+func validateTerms(terms *pb.InvestmentTypeTerms) ([]byte, error) {
+	if terms == nil {
+		return nil, status.Error(codes.InvalidArgument, "Investment type terms are required")
+	}
+	if strings.TrimSpace(terms.Name) == "" || len(terms.Name) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "Investment type name must be 1-100 characters")
+	}
+	if terms.MinAmount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Minimum amount must be positive")
+	}
+	if terms.MaxAmount < 0 || (terms.MaxAmount > 0 && terms.MaxAmount < terms.MinAmount) {
+		return nil, status.Error(codes.InvalidArgument, "Maximum amount must be 0 (no limit) or at least the minimum amount")
+	}
+	if terms.DurationHours < 1 || terms.DurationHours > maxInvestmentDurationHours {
+		return nil, status.Errorf(codes.InvalidArgument, "Duration must be between 1 and %d hours", maxInvestmentDurationHours)
+	}
+	if terms.BaseReturnRate < minReturnRate || terms.BaseReturnRate > maxBaseReturnRate {
+		return nil, status.Errorf(codes.InvalidArgument, "Base return rate must be between %.0f and %.0f", minReturnRate, maxBaseReturnRate)
+	}
+	if terms.RiskLevel < 1 || terms.RiskLevel > 5 {
+		return nil, status.Error(codes.InvalidArgument, "Risk level must be between 1 and 5")
+	}
+
+	schedule := make(penaltySchedule, 0, len(terms.EarlyExitPenalties))
+	for _, penalty := range terms.EarlyExitPenalties {
+		schedule = append(schedule, penaltyTier{
+			AfterPercent:   penalty.AfterPercent,
+			PenaltyPercent: penalty.PenaltyPercent,
+		})
+	}
+	if err := schedule.validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid early exit penalty: %v", err)
+	}
+	sort.SliceStable(schedule, func(i, j int) bool { return schedule[i].AfterPercent < schedule[j].AfterPercent })
+
+	penalties, err := json.Marshal(schedule)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to encode early exit penalties: %v", err)
+	}
+	return penalties, nil
+}
+
+func validateChangedBy(changedBy string) error {
+	if strings.TrimSpace(changedBy) == "" {
+		return status.Error(codes.InvalidArgument, "changed_by is required")
+	}
+	return nil
+}
+
+func nullableMaxAmount(maxAmount int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: maxAmount, Valid: maxAmount > 0}
+}
+
+func isUniqueViolation(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == "23505"
+}
+
+// CreateInvestmentType adds an active investment type at version 1
+func (s *ManaServiceImpl) CreateInvestmentType(ctx context.Context, req *pb.CreateInvestmentTypeRequest) (*pb.InvestmentType, error) {
+	if err := validateChangedBy(req.ChangedBy); err != nil {
+		return nil, err
+	}
+	penalties, err := validateTerms(req.Terms)
+	if err != nil {
+		return nil, err
+	}
+	terms := req.Terms
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var id int64
+	err = tx.QueryRowContext(ctx,
+		`INSERT INTO investment_types
+		(name, description, min_amount, max_amount, duration_hours, base_return_rate, risk_level,
+		 early_exit_penalties, current_version, is_active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, 1, true)
+		RETURNING id`,
+		terms.Name, terms.Description, terms.MinAmount, nullableMaxAmount(terms.MaxAmount),
+		terms.DurationHours, terms.BaseReturnRate, terms.RiskLevel, string(penalties)).Scan(&id)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "Investment type %q already exists", terms.Name)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to create investment type: %v", err)
+	}
+
+	if err := recordTypeVersion(ctx, tx, id, 1, terms, penalties); err != nil {
+		return nil, err
+	}
+	if err := recordTypeChange(ctx, tx, id, 1, investmentTypeCreated, req.ChangedBy, req.Reason); err != nil {
+		return nil, err
+	}
+	if err := s.scheduler.openMarket(ctx, tx, id); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to open investment type market: %v", err)
+	}
+
+	return s.commitInvestmentType(ctx, tx, id)
+}
+
+// UpdateInvestmentType replaces a type's terms with a new version. Investments
+// already opened keep the version they point at; only new ones get these terms.
+func (s *ManaServiceImpl) UpdateInvestmentType(ctx context.Context, req *pb.UpdateInvestmentTypeRequest) (*pb.InvestmentType, error) {
+	if err := validateChangedBy(req.ChangedBy); err != nil {
+		return nil, err
+	}
+	penalties, err := validateTerms(req.Terms)
+	if err != nil {
+		return nil, err
+	}
+	terms := req.Terms
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var currentVersion int32
+	var isActive bool
+	if err := lockInvestmentType(ctx, tx, req.InvestmentTypeId, &currentVersion, &isActive); err != nil {
+		return nil, err
+	}
+	version := currentVersion + 1
+
+	if err := recordTypeVersion(ctx, tx, req.InvestmentTypeId, version, terms, penalties); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE investment_types
+		SET name = $1, description = $2, min_amount = $3, max_amount = $4, duration_hours = $5,
+		    base_return_rate = $6, risk_level = $7, early_exit_penalties = $8, current_version = $9,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $10`,
+		terms.Name, terms.Description, terms.MinAmount, nullableMaxAmount(terms.MaxAmount),
+		terms.DurationHours, terms.BaseReturnRate, terms.RiskLevel, string(penalties), version,
+		req.InvestmentTypeId)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "Investment type %q already exists", terms.Name)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to update investment type: %v", err)
+	}
+
+	if err := recordTypeChange(ctx, tx, req.InvestmentTypeId, version, investmentTypeUpdated, req.ChangedBy, req.Reason); err != nil {
+		return nil, err
+	}
+
+	return s.commitInvestmentType(ctx, tx, req.InvestmentTypeId)
+}
+
+// ActivateInvestmentType lets new investments be opened in a type again
+func (s *ManaServiceImpl) ActivateInvestmentType(ctx context.Context, req *pb.SetInvestmentTypeActiveRequest) (*pb.InvestmentType, error) {
+	return s.setInvestmentTypeActive(ctx, req, true)
+}
+
+// DeactivateInvestmentType stops new investments in a type. Existing investments
+// run to completion as usual.
+func (s *ManaServiceImpl) DeactivateInvestmentType(ctx context.Context, req *pb.SetInvestmentTypeActiveRequest) (*pb.InvestmentType, error) {
+	return s.setInvestmentTypeActive(ctx, req, false)
+}
+
+func (s *ManaServiceImpl) setInvestmentTypeActive(ctx context.Context, req *pb.SetInvestmentTypeActiveRequest, active bool) (*pb.InvestmentType, error) {
+	if err := validateChangedBy(req.ChangedBy); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var version int32
+	var isActive bool
+	if err := lockInvestmentType(ctx, tx, req.InvestmentTypeId, &version, &isActive); err != nil {
+		return nil, err
+	}
+
+	// Already in the requested state: nothing changes, so nothing is logged
+	if isActive != active {
+		_, err = tx.ExecContext(ctx,
+			"UPDATE investment_types SET is_active = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2",
+			active, req.InvestmentTypeId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to update investment type: %v", err)
+		}
+
+		action := investmentTypeDeactivated
+		if active {
+			action = investmentTypeActivated
+		}
+		if err := recordTypeChange(ctx, tx, req.InvestmentTypeId, version, action, req.ChangedBy, req.Reason); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.commitInvestmentType(ctx, tx, req.InvestmentTypeId)
+}
+
+// GetInvestmentTypeHistory lists every change to a type, oldest first, with the
+// terms in effect after each change
+func (s *ManaServiceImpl) GetInvestmentTypeHistory(ctx context.Context, req *pb.GetInvestmentTypeHistoryRequest) (*pb.GetInvestmentTypeHistoryResponse, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT a.id, a.version, a.action, a.changed_by, a.reason, a.created_at,
+		        v.name, v.description, v.min_amount, v.max_amount, v.duration_hours,
+		        v.base_return_rate, v.risk_level, v.early_exit_penalties
+		 FROM investment_type_audit_log a
+		 JOIN investment_type_versions v ON v.investment_type_id = a.investment_type_id AND v.version = a.version
+		 WHERE a.investment_type_id = $1
+		 ORDER BY a.id`,
+		req.InvestmentTypeId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch investment type history: %v", err)
+	}
+	defer rows.Close()
+
+	var changes []*pb.InvestmentTypeChange
+	for rows.Next() {
+		change := &pb.InvestmentTypeChange{Terms: &pb.InvestmentTypeTerms{}}
+		var reason, description sql.NullString
+		var maxAmount sql.NullInt64
+		var createdAt time.Time
+		var penaltiesJSON []byte
+
+		if err := rows.Scan(
+			&change.Id,
+			&change.Version,
+			&change.Action,
+			&change.ChangedBy,
+			&reason,
+			&createdAt,
+			&change.Terms.Name,
+			&description,
+			&change.Terms.MinAmount,
+			&maxAmount,
+			&change.Terms.DurationHours,
+			&change.Terms.BaseReturnRate,
+			&change.Terms.RiskLevel,
+			&penaltiesJSON,
+		); err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to scan investment type change: %v", err)
+		}
+
+		penalties, err := parsePenaltySchedule(penaltiesJSON)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to read early exit penalties: %v", err)
+		}
+		change.Terms.EarlyExitPenalties = penalties.toProto()
+		change.Terms.Description = description.String
+		change.Terms.MaxAmount = maxAmount.Int64
+		change.Reason = reason.String
+		change.CreatedAt = timestamppb.New(createdAt)
+
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch investment type history: %v", err)
+	}
+
+	if len(changes) == 0 {
+		return nil, status.Error(codes.NotFound, "Investment type not found")
+	}
+
+	return &pb.GetInvestmentTypeHistoryResponse{Changes: changes}, nil
+}
+
+// lockInvestmentType locks a type's row so concurrent admin changes get
+// consecutive versions
+func lockInvestmentType(ctx context.Context, tx *sql.Tx, id int64, version *int32, isActive *bool) error {
+	err := tx.QueryRowContext(ctx,
+		"SELECT current_version, is_active FROM investment_types WHERE id = $1 FOR UPDATE",
+		id).Scan(version, isActive)
+	if err == sql.ErrNoRows {
+		return status.Error(codes.NotFound, "Investment type not found")
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to fetch investment type: %v", err)
+	}
+	return nil
+}
+
+func recordTypeVersion(ctx context.Context, tx *sql.Tx, id int64, version int32, terms *pb.InvestmentTypeTerms, penalties []byte) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO investment_type_versions
+		(investment_type_id, version, name, description, min_amount, max_amount, duration_hours,
+		 base_return_rate, risk_level, early_exit_penalties)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		id, version, terms.Name, terms.Description, terms.MinAmount, nullableMaxAmount(terms.MaxAmount),
+		terms.DurationHours, terms.BaseReturnRate, terms.RiskLevel, string(penalties))
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to record investment type version: %v", err)
+	}
+	return nil
+}
+
+func recordTypeChange(ctx context.Context, tx *sql.Tx, id int64, version int32, action, changedBy, reason string) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO investment_type_audit_log (investment_type_id, version, action, changed_by, reason)
+		VALUES ($1, $2, $3, $4, $5)`,
+		id, version, action, changedBy, reason)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to record investment type change: %v", err)
+	}
+	return nil
+}
+
+// commitInvestmentType reads back the type as the transaction left it and commits
+func (s *ManaServiceImpl) commitInvestmentType(ctx context.Context, tx *sql.Tx, id int64) (*pb.InvestmentType, error) {
+	it, err := scanInvestmentType(tx.QueryRowContext(ctx,
+		"SELECT "+investmentTypeColumns+" FROM investment_types WHERE id = $1", id))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch investment type: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to commit transaction: %v", err)
+	}
+
+	s.log.Info("Investment type changed", "investment_type_id", id, "version", it.Version, "is_active", it.IsActive)
+	return it, nil
+}
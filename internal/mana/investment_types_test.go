@@ -0,0 +1,288 @@
+package mana
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tectix/mysticfunds/proto/mana"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func validTerms() *pb.InvestmentTypeTerms {
+	return &pb.InvestmentTypeTerms{
+		Name:           "Moonwell Bond",
+		Description:    "Steady returns drawn from the moonwell",
+		MinAmount:      100,
+		MaxAmount:      5000,
+		DurationHours:  48,
+		BaseReturnRate: 6.5,
+		RiskLevel:      2,
+		EarlyExitPenalties: []*pb.EarlyExitPenalty{
+			{AfterPercent: 50, PenaltyPercent: 5},
+			{AfterPercent: 0, PenaltyPercent: 10},
+		},
+	}
+}
+
+func investmentTypeRow(version int32, active bool) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "name", "description", "min_amount", "max_amount", "duration_hours", "base_return_rate",
+		"risk_level", "early_exit_penalties", "current_version", "is_active",
+	}).AddRow(3, "Moonwell Bond", "Steady returns drawn from the moonwell", 100, 5000, 48, 6.5,
+		2, []byte(`[{"after_percent":0,"penalty_percent":10},{"after_percent":50,"penalty_percent":5}]`),
+		version, active)
+}
+
+func TestValidateTerms(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*pb.InvestmentTypeTerms)
+		valid  bool
+	}{
+		{"valid", func(*pb.InvestmentTypeTerms) {}, true},
+		{"no maximum", func(terms *pb.InvestmentTypeTerms) { terms.MaxAmount = 0 }, true},
+		{"maximum equals minimum", func(terms *pb.InvestmentTypeTerms) { terms.MaxAmount = 100 }, true},
+		{"blank name", func(terms *pb.InvestmentTypeTerms) { terms.Name = "  " }, false},
+		{"zero minimum", func(terms *pb.InvestmentTypeTerms) { terms.MinAmount = 0 }, false},
+		{"maximum below minimum", func(terms *pb.InvestmentTypeTerms) { terms.MaxAmount = 99 }, false},
+		{"zero duration", func(terms *pb.InvestmentTypeTerms) { terms.DurationHours = 0 }, false},
+		{"duration over a year", func(terms *pb.InvestmentTypeTerms) { terms.DurationHours = 24*365 + 1 }, false},
+		{"risk too low", func(terms *pb.InvestmentTypeTerms) { terms.RiskLevel = 0 }, false},
+		{"risk too high", func(terms *pb.InvestmentTypeTerms) { terms.RiskLevel = 6 }, false},
+		{"return rate too high", func(terms *pb.InvestmentTypeTerms) { terms.BaseReturnRate = 150 }, false},
+		{"return rate below floor", func(terms *pb.InvestmentTypeTerms) { terms.BaseReturnRate = -95 }, false},
+		{"full penalty", func(terms *pb.InvestmentTypeTerms) {
+			terms.EarlyExitPenalties[0].PenaltyPercent = 100
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			terms := validTerms()
+			tt.modify(terms)
+
+			_, err := validateTerms(terms)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestValidateTermsSortsPenalties(t *testing.T) {
+	penalties, err := validateTerms(validTerms())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"after_percent":0,"penalty_percent":10},{"after_percent":50,"penalty_percent":5}]`,
+		string(penalties))
+}
+
+func TestCreateInvestmentType(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	setup.mock.ExpectBegin()
+	setup.mock.ExpectQuery("INSERT INTO investment_types").
+		WithArgs("Moonwell Bond", sqlmock.AnyArg(), int64(100), sqlmock.AnyArg(), int32(48), 6.5, int32(2), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	setup.mock.ExpectExec("INSERT INTO investment_type_versions").
+		WithArgs(int64(3), int32(1), "Moonwell Bond", sqlmock.AnyArg(), int64(100), sqlmock.AnyArg(),
+			int32(48), 6.5, int32(2), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	setup.mock.ExpectExec("INSERT INTO investment_type_audit_log").
+		WithArgs(int64(3), int32(1), investmentTypeCreated, "ops@mysticfunds", "New bond").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	setup.mock.ExpectExec("INSERT INTO investment_type_markets").
+		WithArgs(int64(3), openingMarketPrice, regimeCalm, sqlmock.AnyArg(), testEpoch).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	setup.mock.ExpectExec("INSERT INTO market_prices").
+		WithArgs(int64(3), testEpoch, openingMarketPrice, regimeCalm).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	setup.mock.ExpectQuery("SELECT (.+) FROM investment_types WHERE id = \\$1").
+		WithArgs(int64(3)).
+		WillReturnRows(investmentTypeRow(1, true))
+	setup.mock.ExpectCommit()
+
+	it, err := setup.service.CreateInvestmentType(setup.ctx, &pb.CreateInvestmentTypeRequest{
+		Terms:     validTerms(),
+		ChangedBy: "ops@mysticfunds",
+		Reason:    "New bond",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), it.Id)
+	assert.Equal(t, int32(1), it.Version)
+	assert.True(t, it.IsActive)
+	assert.Len(t, it.EarlyExitPenalties, 2)
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+}
+
+func TestCreateInvestmentTypeDuplicateName(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	setup.mock.ExpectBegin()
+	setup.mock.ExpectQuery("INSERT INTO investment_types").
+		WillReturnError(&pq.Error{Code: "23505"})
+	setup.mock.ExpectRollback()
+
+	_, err := setup.service.CreateInvestmentType(setup.ctx, &pb.CreateInvestmentTypeRequest{
+		Terms:     validTerms(),
+		ChangedBy: "ops@mysticfunds",
+	})
+
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+}
+
+func TestCreateInvestmentTypeRequiresChangedBy(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	_, err := setup.service.CreateInvestmentType(setup.ctx, &pb.CreateInvestmentTypeRequest{
+		Terms: validTerms(),
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateInvestmentTypeAddsVersion(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	setup.mock.ExpectBegin()
+	setup.mock.ExpectQuery("SELECT current_version, is_active FROM investment_types WHERE id = \\$1 FOR UPDATE").
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"current_version", "is_active"}).AddRow(2, true))
+	setup.mock.ExpectExec("INSERT INTO investment_type_versions").
+		WithArgs(int64(3), int32(3), "Moonwell Bond", sqlmock.AnyArg(), int64(100), sqlmock.AnyArg(),
+			int32(48), 6.5, int32(2), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	setup.mock.ExpectExec("UPDATE investment_types SET name = \\$1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	setup.mock.ExpectExec("INSERT INTO investment_type_audit_log").
+		WithArgs(int64(3), int32(3), investmentTypeUpdated, "ops@mysticfunds", "Rate cut").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	setup.mock.ExpectQuery("SELECT (.+) FROM investment_types WHERE id = \\$1").
+		WithArgs(int64(3)).
+		WillReturnRows(investmentTypeRow(3, true))
+	setup.mock.ExpectCommit()
+
+	it, err := setup.service.UpdateInvestmentType(setup.ctx, &pb.UpdateInvestmentTypeRequest{
+		InvestmentTypeId: 3,
+		Terms:            validTerms(),
+		ChangedBy:        "ops@mysticfunds",
+		Reason:           "Rate cut",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), it.Version)
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+}
+
+func TestUpdateInvestmentTypeNotFound(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	setup.mock.ExpectBegin()
+	setup.mock.ExpectQuery("SELECT current_version, is_active FROM investment_types").
+		WithArgs(int64(99)).
+		WillReturnRows(sqlmock.NewRows([]string{"current_version", "is_active"}))
+	setup.mock.ExpectRollback()
+
+	_, err := setup.service.UpdateInvestmentType(setup.ctx, &pb.UpdateInvestmentTypeRequest{
+		InvestmentTypeId: 99,
+		Terms:            validTerms(),
+		ChangedBy:        "ops@mysticfunds",
+	})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+}
+
+func TestDeactivateInvestmentType(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	setup.mock.ExpectBegin()
+	setup.mock.ExpectQuery("SELECT current_version, is_active FROM investment_types").
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"current_version", "is_active"}).AddRow(2, true))
+	setup.mock.ExpectExec("UPDATE investment_types SET is_active = \\$1").
+		WithArgs(false, int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	setup.mock.ExpectExec("INSERT INTO investment_type_audit_log").
+		WithArgs(int64(3), int32(2), investmentTypeDeactivated, "ops@mysticfunds", "Sunset").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	setup.mock.ExpectQuery("SELECT (.+) FROM investment_types WHERE id = \\$1").
+		WillReturnRows(investmentTypeRow(2, false))
+	setup.mock.ExpectCommit()
+
+	it, err := setup.service.DeactivateInvestmentType(setup.ctx, &pb.SetInvestmentTypeActiveRequest{
+		InvestmentTypeId: 3,
+		ChangedBy:        "ops@mysticfunds",
+		Reason:           "Sunset",
+	})
+
+	assert.NoError(t, err)
+	assert.False(t, it.IsActive)
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+}
+
+func TestActivateActiveInvestmentTypeIsNoOp(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	// No update and no audit entry when the state does not change
+	setup.mock.ExpectBegin()
+	setup.mock.ExpectQuery("SELECT current_version, is_active FROM investment_types").
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"current_version", "is_active"}).AddRow(2, true))
+	setup.mock.ExpectQuery("SELECT (.+) FROM investment_types WHERE id = \\$1").
+		WillReturnRows(investmentTypeRow(2, true))
+	setup.mock.ExpectCommit()
+
+	it, err := setup.service.ActivateInvestmentType(setup.ctx, &pb.SetInvestmentTypeActiveRequest{
+		InvestmentTypeId: 3,
+		ChangedBy:        "ops@mysticfunds",
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, it.IsActive)
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+}
+
+func TestGetInvestmentTypeHistory(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	columns := []string{
+		"id", "version", "action", "changed_by", "reason", "created_at",
+		"name", "description", "min_amount", "max_amount", "duration_hours",
+		"base_return_rate", "risk_level", "early_exit_penalties",
+	}
+	setup.mock.ExpectQuery("SELECT (.+) FROM investment_type_audit_log a").
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, 1, "created", "migration", "Seeded investment type", testEpoch,
+				"Moonwell Bond", nil, 100, nil, 48, 8.0, 2, []byte(`[]`)).
+			AddRow(2, 2, "updated", "ops@mysticfunds", nil, testEpoch.Add(time.Hour),
+				"Moonwell Bond", nil, 100, 5000, 48, 6.5, 2, []byte(`[]`)))
+
+	resp, err := setup.service.GetInvestmentTypeHistory(setup.ctx, &pb.GetInvestmentTypeHistoryRequest{
+		InvestmentTypeId: 3,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Changes, 2)
+	assert.Equal(t, 8.0, resp.Changes[0].Terms.BaseReturnRate)
+	assert.Equal(t, int64(0), resp.Changes[0].Terms.MaxAmount)
+	assert.Equal(t, "updated", resp.Changes[1].Action)
+	assert.Equal(t, 6.5, resp.Changes[1].Terms.BaseReturnRate)
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,292 @@
+package mana
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"time"
+
+	"github.com/tectix/mysticfunds/pkg/sim"
+	pb "github.com/tectix/mysticfunds/proto/mana"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Market regimes
+const (
+	regimeCalm = "calm"
+	regimeBull = "bull"
+	regimeBear = "bear"
+)
+
+// marketRegime scales a market's drift and volatility
+type marketRegime struct {
+	drift      float64
+	volatility float64
+}
+
+var marketRegimes = map[string]marketRegime{
+	regimeCalm: {drift: 1, volatility: 1},
+	regimeBull: {drift: 2, volatility: 1.5},
+	regimeBear: {drift: -1, volatility: 2},
+}
+
+var regimeOrder = []string{regimeCalm, regimeBull, regimeBear}
+
+const (
+	// regimeSwitchChance is the chance per tick that a market draws a new regime
+	regimeSwitchChance = 0.02
+	// openingMarketPrice is where a new market starts
+	openingMarketPrice = 100.0
+	// minMarketPrice keeps a crashed market above zero so it can recover
+	minMarketPrice = 0.01
+	// defaultMarketHistory and maxMarketHistory bound GetMarketHistory
+	defaultMarketHistory = 168
+	maxMarketHistory     = 1000
+)
+
+var errMarketBehind = errors.New("mystic market has not reached the investment's maturity yet")
+
+// marketState is a market row together with the terms of its investment type
+type marketState struct {
+	investmentTypeId int64
+	price            float64
+	regime           string
+	seed             int64
+	steps            int64
+	lastTickAt       time.Time
+	baseReturnRate   float64
+	durationHours    int32
+	riskLevel        int32
+}
+
+// step advances the market by one tick. In a calm regime the price drifts by the
+// type's base return rate over one term, with the spread of returns of the type's
+// risk level; bull and bear regimes scale both.
+func (m *marketState) step(tick time.Duration) {
+	m.steps++
+	rng := sim.NewRNG(stepSeed(m.seed, m.steps))
+
+	if rng.Float64() < regimeSwitchChance {
+		m.regime = regimeOrder[rng.Int63n(int64(len(regimeOrder)))]
+	}
+	regime := marketRegimes[m.regime]
+
+	ticksPerTerm := math.Max(1, float64(time.Duration(m.durationHours)*time.Hour)/float64(tick))
+	drift := math.Log1p(m.baseReturnRate/100) / ticksPerTerm * regime.drift
+	// A uniform spread of ±v over a term has a standard deviation of v/√3
+	volatility := returnRateVariance(m.riskLevel) / 100 / math.Sqrt(3) / math.Sqrt(ticksPerTerm) * regime.volatility
+
+	m.price *= math.Exp(drift - volatility*volatility/2 + volatility*normal(rng))
+	if m.price < minMarketPrice {
+		m.price = minMarketPrice
+	}
+	m.lastTickAt = m.lastTickAt.Add(tick)
+}
+
+// stepSeed derives the seed of a market's nth step from the market's seed
+func stepSeed(seed, step int64) int64 {
+	var buf [16]byte
+	binary.LittleEndian.PutUint64(buf[:8], uint64(seed))
+	binary.LittleEndian.PutUint64(buf[8:], uint64(step))
+
+	h := fnv.New64a()
+	h.Write(buf[:])
+	return int64(h.Sum64())
+}
+
+// normal draws from the standard normal distribution
+func normal(rng sim.RNG) float64 {
+	u1 := 1 - rng.Float64() // in (0, 1], so the log is finite
+	u2 := rng.Float64()
+	return math.Sqrt(-2*math.Log(u1)) * math.Cos(2*math.Pi*u2)
+}
+
+// advanceMarkets steps every market up to the current tick. Each market's row is
+// locked while it is advanced, and markets another replica is advancing are
+// skipped.
+func (s *InvestmentScheduler) advanceMarkets(ctx context.Context) error {
+	now := s.clock.Now()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT m.investment_type_id, m.price, m.regime, m.seed, m.steps, m.last_tick_at,
+			t.base_return_rate, t.duration_hours, t.risk_level
+		FROM investment_type_markets m
+		JOIN investment_types t ON t.id = m.investment_type_id
+		WHERE m.last_tick_at <= $1
+		FOR UPDATE OF m SKIP LOCKED`,
+		now.Add(-s.marketTick))
+	if err != nil {
+		return fmt.Errorf("load markets: %w", err)
+	}
+
+	var markets []*marketState
+	for rows.Next() {
+		var m marketState
+		if err := rows.Scan(&m.investmentTypeId, &m.price, &m.regime, &m.seed, &m.steps, &m.lastTickAt,
+			&m.baseReturnRate, &m.durationHours, &m.riskLevel); err != nil {
+			rows.Close()
+			return fmt.Errorf("scan market: %w", err)
+		}
+		markets = append(markets, &m)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("load markets: %w", err)
+	}
+
+	for _, m := range markets {
+		for !m.lastTickAt.Add(s.marketTick).After(now) {
+			m.step(s.marketTick)
+			_, err := tx.ExecContext(ctx,
+				`INSERT INTO market_prices (investment_type_id, tick_at, price, regime)
+				 VALUES ($1, $2, $3, $4)
+				 ON CONFLICT (investment_type_id, tick_at) DO NOTHING`,
+				m.investmentTypeId, m.lastTickAt, m.price, m.regime)
+			if err != nil {
+				return fmt.Errorf("record market price: %w", err)
+			}
+		}
+
+		_, err := tx.ExecContext(ctx,
+			`UPDATE investment_type_markets SET price = $1, regime = $2, steps = $3, last_tick_at = $4
+			 WHERE investment_type_id = $5`,
+			m.price, m.regime, m.steps, m.lastTickAt, m.investmentTypeId)
+		if err != nil {
+			return fmt.Errorf("update market: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// marketPriceAt returns the price of the last tick at or before t, or the first
+// tick if the market opened after t
+func marketPriceAt(ctx context.Context, q rowQueryer, investmentTypeId int64, t time.Time) (float64, time.Time, error) {
+	var price float64
+	var tickAt time.Time
+	err := q.QueryRowContext(ctx,
+		`SELECT price, tick_at FROM market_prices
+		 WHERE investment_type_id = $1 AND tick_at <= $2
+		 ORDER BY tick_at DESC LIMIT 1`,
+		investmentTypeId, t).Scan(&price, &tickAt)
+	if err == sql.ErrNoRows {
+		err = q.QueryRowContext(ctx,
+			`SELECT price, tick_at FROM market_prices
+			 WHERE investment_type_id = $1
+			 ORDER BY tick_at LIMIT 1`,
+			investmentTypeId).Scan(&price, &tickAt)
+	}
+	return price, tickAt, err
+}
+
+// marketReturnRate is the market's move over an investment's term, from the tick
+// it started in to the tick it matured in. Investments that matured before their
+// market opened earn their base return rate.
+func (s *InvestmentScheduler) marketReturnRate(ctx context.Context, q rowQueryer, investment *dueInvestment) (float64, error) {
+	endPrice, endTick, err := marketPriceAt(ctx, q, investment.investmentTypeId, investment.endTime)
+	if err != nil {
+		return 0, fmt.Errorf("get price at maturity: %w", err)
+	}
+	if endTick.After(investment.endTime) {
+		return investment.baseReturnRate, nil
+	}
+	if !endTick.Add(s.marketTick).After(investment.endTime) {
+		return 0, errMarketBehind
+	}
+
+	startPrice, _, err := marketPriceAt(ctx, q, investment.investmentTypeId, investment.startTime)
+	if err != nil {
+		return 0, fmt.Errorf("get price at start: %w", err)
+	}
+
+	rate := (endPrice/startPrice - 1) * 100
+	if rate < minReturnRate {
+		rate = minReturnRate
+	}
+	return rate, nil
+}
+
+// openMarket starts the market of a new investment type at the current tick
+func (s *InvestmentScheduler) openMarket(ctx context.Context, tx *sql.Tx, investmentTypeId int64) error {
+	tickAt := s.clock.Now().Truncate(s.marketTick)
+
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO investment_type_markets (investment_type_id, price, regime, seed, last_tick_at)
+		 VALUES ($1, $2, $3, $4, $5)`,
+		investmentTypeId, openingMarketPrice, regimeCalm, s.rng.Int63n(math.MaxInt64), tickAt)
+	if err != nil {
+		return fmt.Errorf("open market: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO market_prices (investment_type_id, tick_at, price, regime)
+		 VALUES ($1, $2, $3, $4)`,
+		investmentTypeId, tickAt, openingMarketPrice, regimeCalm)
+	if err != nil {
+		return fmt.Errorf("record opening price: %w", err)
+	}
+	return nil
+}
+
+// GetMarketHistory returns the latest prices of an investment type's market,
+// oldest first
+func (s *ManaServiceImpl) GetMarketHistory(ctx context.Context, req *pb.GetMarketHistoryRequest) (*pb.GetMarketHistoryResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultMarketHistory
+	}
+	if limit > maxMarketHistory {
+		limit = maxMarketHistory
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT tick_at, price, regime FROM market_prices
+		 WHERE investment_type_id = $1
+		 ORDER BY tick_at DESC LIMIT $2`,
+		req.InvestmentTypeId, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch market history: %v", err)
+	}
+	defer rows.Close()
+
+	var points []*pb.MarketPrice
+	for rows.Next() {
+		var point pb.MarketPrice
+		var tickAt time.Time
+		if err := rows.Scan(&tickAt, &point.Price, &point.Regime); err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to scan market price: %v", err)
+		}
+		point.TickAt = timestamppb.New(tickAt)
+		points = append(points, &point)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch market history: %v", err)
+	}
+
+	if len(points) == 0 {
+		return nil, status.Error(codes.NotFound, "Market not found")
+	}
+
+	// Newest first from the query, so the limit keeps the latest prices
+	for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+		points[i], points[j] = points[j], points[i]
+	}
+
+	return &pb.GetMarketHistoryResponse{
+		InvestmentTypeId: req.InvestmentTypeId,
+		Regime:           points[len(points)-1].Regime,
+		Prices:           points,
+	}, nil
+}
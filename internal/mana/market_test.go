@@ -0,0 +1,199 @@
+package mana
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tectix/mysticfunds/proto/mana"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testMarket(seed int64) *marketState {
+	return &marketState{
+		investmentTypeId: 1,
+		price:            openingMarketPrice,
+		regime:           regimeCalm,
+		seed:             seed,
+		lastTickAt:       testStartTime,
+		baseReturnRate:   5,
+		durationHours:    24,
+		riskLevel:        2,
+	}
+}
+
+func TestMarketStepIsReproducible(t *testing.T) {
+	a, b := testMarket(42), testMarket(42)
+	for i := 0; i < 100; i++ {
+		a.step(time.Hour)
+		b.step(time.Hour)
+		assert.Equal(t, a.price, b.price)
+		assert.Equal(t, a.regime, b.regime)
+	}
+	assert.Equal(t, int64(100), a.steps)
+	assert.Equal(t, testStartTime.Add(100*time.Hour), a.lastTickAt)
+
+	other := testMarket(43)
+	for i := 0; i < 100; i++ {
+		other.step(time.Hour)
+	}
+	assert.NotEqual(t, a.price, other.price)
+}
+
+func TestMarketStepStaysAboveMinimum(t *testing.T) {
+	m := testMarket(7)
+	m.riskLevel = 5
+	m.regime = regimeBear
+	for i := 0; i < 10000; i++ {
+		m.step(time.Hour)
+		assert.GreaterOrEqual(t, m.price, minMarketPrice)
+	}
+}
+
+func TestAdvanceMarkets(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	scheduler := newTestScheduler(db, &MockWizardServiceClient{})
+
+	// Three hours behind, so three ticks are recorded
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery("SELECT (.+) FROM investment_type_markets m").
+		WithArgs(testEpoch.Add(-time.Hour)).
+		WillReturnRows(sqlmock.NewRows([]string{"investment_type_id", "price", "regime", "seed", "steps",
+			"last_tick_at", "base_return_rate", "duration_hours", "risk_level"}).
+			AddRow(1, 100.0, regimeCalm, 42, 0, testEpoch.Add(-3*time.Hour), 5.0, 24, 2))
+	for i := 2; i >= 0; i-- {
+		sqlMock.ExpectExec("INSERT INTO market_prices").
+			WithArgs(int64(1), testEpoch.Add(-time.Duration(i)*time.Hour), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	sqlMock.ExpectExec("UPDATE investment_type_markets").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(3), testEpoch, int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	assert.NoError(t, scheduler.advanceMarkets(context.Background()))
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestMarketReturnRate(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	scheduler := newTestScheduler(db, &MockWizardServiceClient{})
+	investment := &dueInvestment{investmentTypeId: 1, startTime: testStartTime, endTime: testEndTime, baseReturnRate: 5}
+
+	// Every investment over the same term reads the same two prices
+	for i := 0; i < 2; i++ {
+		expectMarketReturn(sqlMock, 12.5)
+		rate, err := scheduler.marketReturnRate(context.Background(), db, investment)
+		assert.NoError(t, err)
+		assert.InDelta(t, 12.5, rate, 1e-9)
+	}
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestMarketReturnRateFlooredAtMinimum(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	scheduler := newTestScheduler(db, &MockWizardServiceClient{})
+	investment := &dueInvestment{investmentTypeId: 1, startTime: testStartTime, endTime: testEndTime}
+
+	expectMarketReturn(sqlMock, -99)
+	rate, err := scheduler.marketReturnRate(context.Background(), db, investment)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(minReturnRate), rate)
+}
+
+func TestMarketReturnRateWhenMarketIsBehind(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	scheduler := newTestScheduler(db, &MockWizardServiceClient{})
+	investment := &dueInvestment{investmentTypeId: 1, startTime: testStartTime, endTime: testEndTime}
+
+	// The last tick is two hours before maturity
+	sqlMock.ExpectQuery("SELECT price, tick_at FROM market_prices").
+		WithArgs(int64(1), testEndTime).
+		WillReturnRows(sqlmock.NewRows([]string{"price", "tick_at"}).AddRow(110.0, testEndTime.Add(-2*time.Hour)))
+
+	_, err = scheduler.marketReturnRate(context.Background(), db, investment)
+	assert.ErrorIs(t, err, errMarketBehind)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestMarketReturnRateBeforeMarketOpened(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	scheduler := newTestScheduler(db, &MockWizardServiceClient{})
+	investment := &dueInvestment{investmentTypeId: 1, startTime: testStartTime, endTime: testEndTime, baseReturnRate: 5}
+
+	// No tick at or before maturity, so the first tick is after it
+	sqlMock.ExpectQuery("SELECT price, tick_at FROM market_prices").
+		WithArgs(int64(1), testEndTime).
+		WillReturnRows(sqlmock.NewRows([]string{"price", "tick_at"}))
+	sqlMock.ExpectQuery("SELECT price, tick_at FROM market_prices").
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"price", "tick_at"}).AddRow(100.0, testEndTime.Add(time.Hour)))
+
+	rate, err := scheduler.marketReturnRate(context.Background(), db, investment)
+	assert.NoError(t, err)
+	assert.Equal(t, 5.0, rate)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestGetMarketHistory(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	setup.mock.ExpectQuery("SELECT tick_at, price, regime FROM market_prices").
+		WithArgs(int64(1), int32(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"tick_at", "price", "regime"}).
+			AddRow(testEpoch, 104.0, regimeBull).
+			AddRow(testEpoch.Add(-time.Hour), 101.0, regimeCalm))
+
+	resp, err := setup.service.GetMarketHistory(setup.ctx, &pb.GetMarketHistoryRequest{
+		InvestmentTypeId: 1,
+		Limit:            2,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, regimeBull, resp.Regime)
+	assert.Len(t, resp.Prices, 2)
+	assert.Equal(t, 101.0, resp.Prices[0].Price)
+	assert.Equal(t, 104.0, resp.Prices[1].Price)
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+}
+
+func TestGetMarketHistoryNotFound(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	setup.mock.ExpectQuery("SELECT tick_at, price, regime FROM market_prices").
+		WithArgs(int64(9), int32(defaultMarketHistory)).
+		WillReturnRows(sqlmock.NewRows([]string{"tick_at", "price", "regime"}))
+
+	_, err := setup.service.GetMarketHistory(setup.ctx, &pb.GetMarketHistoryRequest{InvestmentTypeId: 9})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -28,6 +28,11 @@ func (m *MockWizardServiceClient) TransferMana(ctx context.Context, req *wizardp
 	return args.Get(0).(*wizardpb.TransferManaResponse), args.Error(1)
 }
 
+func (m *MockWizardServiceClient) GetRewardModifiers(ctx context.Context, req *wizardpb.GetRewardModifiersRequest, opts ...grpc.CallOption) (*wizardpb.GetRewardModifiersResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*wizardpb.GetRewardModifiersResponse), args.Error(1)
+}
+
 // Add all other required methods to satisfy the interface (with minimal implementations)
 func (m *MockWizardServiceClient) CreateWizard(ctx context.Context, req *wizardpb.CreateWizardRequest, opts ...grpc.CallOption) (*wizardpb.Wizard, error) {
 	return nil, nil
@@ -85,6 +90,10 @@ func (m *MockWizardServiceClient) GetJobAssignments(ctx context.Context, req *wi
 	return nil, nil
 }
 
+func (m *MockWizardServiceClient) GetJobAssignment(ctx context.Context, req *wizardpb.GetJobAssignmentRequest, opts ...grpc.CallOption) (*wizardpb.JobAssignment, error) {
+	return nil, nil
+}
+
 func (m *MockWizardServiceClient) CompleteJobAssignment(ctx context.Context, req *wizardpb.CompleteJobAssignmentRequest, opts ...grpc.CallOption) (*wizardpb.JobAssignment, error) {
 	return nil, nil
 }
@@ -93,6 +102,58 @@ func (m *MockWizardServiceClient) CancelJobAssignment(ctx context.Context, req *
 	return nil, nil
 }
 
+func (m *MockWizardServiceClient) PauseJobAssignment(ctx context.Context, req *wizardpb.PauseJobAssignmentRequest, opts ...grpc.CallOption) (*wizardpb.JobAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) ResumeJobAssignment(ctx context.Context, req *wizardpb.ResumeJobAssignmentRequest, opts ...grpc.CallOption) (*wizardpb.JobAssignment, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) AssignPartyToJob(ctx context.Context, req *wizardpb.AssignPartyToJobRequest, opts ...grpc.CallOption) (*wizardpb.JobParty, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) GetJobParty(ctx context.Context, req *wizardpb.GetJobPartyRequest, opts ...grpc.CallOption) (*wizardpb.JobParty, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) ApplyToJob(ctx context.Context, req *wizardpb.ApplyToJobRequest, opts ...grpc.CallOption) (*wizardpb.JobApplication, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) ListJobApplications(ctx context.Context, req *wizardpb.ListJobApplicationsRequest, opts ...grpc.CallOption) (*wizardpb.ListJobApplicationsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) AcceptJobApplication(ctx context.Context, req *wizardpb.AcceptJobApplicationRequest, opts ...grpc.CallOption) (*wizardpb.JobApplication, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) RejectJobApplication(ctx context.Context, req *wizardpb.RejectJobApplicationRequest, opts ...grpc.CallOption) (*wizardpb.JobApplication, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) CreateJobTemplate(ctx context.Context, req *wizardpb.CreateJobTemplateRequest, opts ...grpc.CallOption) (*wizardpb.JobTemplate, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) GetJobTemplate(ctx context.Context, req *wizardpb.GetJobTemplateRequest, opts ...grpc.CallOption) (*wizardpb.JobTemplate, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) ListJobTemplates(ctx context.Context, req *wizardpb.ListJobTemplatesRequest, opts ...grpc.CallOption) (*wizardpb.ListJobTemplatesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) UpdateJobTemplate(ctx context.Context, req *wizardpb.UpdateJobTemplateRequest, opts ...grpc.CallOption) (*wizardpb.JobTemplate, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) DeleteJobTemplate(ctx context.Context, req *wizardpb.DeleteJobTemplateRequest, opts ...grpc.CallOption) (*wizardpb.DeleteJobTemplateResponse, error) {
+	return nil, nil
+}
+
 func (m *MockWizardServiceClient) UpdateJobProgress(ctx context.Context, req *wizardpb.UpdateJobProgressRequest, opts ...grpc.CallOption) (*wizardpb.JobProgress, error) {
 	return nil, nil
 }
@@ -108,3 +169,19 @@ func (m *MockWizardServiceClient) GetActivities(ctx context.Context, req *wizard
 func (m *MockWizardServiceClient) GetRealms(ctx context.Context, req *wizardpb.GetRealmsRequest, opts ...grpc.CallOption) (*wizardpb.GetRealmsResponse, error) {
 	return nil, nil
 }
+
+func (m *MockWizardServiceClient) GetProgression(ctx context.Context, req *wizardpb.GetProgressionRequest, opts ...grpc.CallOption) (*wizardpb.Progression, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) GetLedgerEntries(ctx context.Context, req *wizardpb.GetLedgerEntriesRequest, opts ...grpc.CallOption) (*wizardpb.GetLedgerEntriesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) VerifyLedger(ctx context.Context, req *wizardpb.VerifyLedgerRequest, opts ...grpc.CallOption) (*wizardpb.VerifyLedgerResponse, error) {
+	return nil, nil
+}
+
+func (m *MockWizardServiceClient) GetLeaderStatus(ctx context.Context, req *wizardpb.GetLeaderStatusRequest, opts ...grpc.CallOption) (*wizardpb.LeaderStatus, error) {
+	return nil, nil
+}
@@ -0,0 +1,149 @@
+package mana
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"sort"
+	"time"
+
+	pb "github.com/tectix/mysticfunds/proto/mana"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// portfolioPosition is one funded investment as the portfolio summary sees it
+type portfolioPosition struct {
+	status         string
+	amount         int64
+	returnedAmount sql.NullInt64
+	returnRate     sql.NullFloat64
+	startTime      time.Time
+	endTime        time.Time
+	baseReturnRate float64
+	riskLevel      int32
+}
+
+// GetPortfolioSummary reports a wizard's investment performance. Investments whose
+// return is fixed (settling, withdrawing, completed or withdrawn) count as closed;
+// active ones are open and are valued from their type's base return rate. Pending
+// investments and ones that failed are left out.
+func (s *ManaServiceImpl) GetPortfolioSummary(ctx context.Context, req *pb.GetPortfolioSummaryRequest) (*pb.GetPortfolioSummaryResponse, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT i.status, i.amount, i.returned_amount, i.actual_return_rate, i.start_time, i.end_time,
+		        t.base_return_rate, t.risk_level
+		 FROM wizard_investments i
+		 JOIN investment_type_versions t ON i.investment_type_version_id = t.id
+		 WHERE i.wizard_id = $1 AND i.status NOT IN ('pending', 'failed')`,
+		req.WizardId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch investments: %v", err)
+	}
+	defer rows.Close()
+
+	var positions []portfolioPosition
+	for rows.Next() {
+		var p portfolioPosition
+		if err := rows.Scan(
+			&p.status,
+			&p.amount,
+			&p.returnedAmount,
+			&p.returnRate,
+			&p.startTime,
+			&p.endTime,
+			&p.baseReturnRate,
+			&p.riskLevel,
+		); err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to scan investment: %v", err)
+		}
+		positions = append(positions, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch investments: %v", err)
+	}
+
+	return summarizePortfolio(req.WizardId, positions, s.scheduler.clock.Now()), nil
+}
+
+// summarizePortfolio computes the summary at now. The projection treats each open
+// investment's return rate as uniform over its base rate plus or minus the risk
+// level's variance, independently of the others, and leaves out realm boosts.
+func summarizePortfolio(wizardID int64, positions []portfolioPosition, now time.Time) *pb.GetPortfolioSummaryResponse {
+	summary := &pb.GetPortfolioSummaryResponse{
+		WizardId:   wizardID,
+		Projection: &pb.PortfolioProjection{},
+	}
+
+	type riskTotals struct {
+		count     int32
+		rateTotal float64
+	}
+	byRisk := make(map[int32]*riskTotals)
+
+	var unrealized, expectedProfit, profitVariance, worstCase, bestCase float64
+	for _, p := range positions {
+		summary.TotalInvested += p.amount
+		principal := float64(p.amount)
+
+		if p.status == "active" {
+			summary.OpenCount++
+			summary.OpenPrincipal += p.amount
+
+			profit := principal * p.baseReturnRate / 100
+			expectedProfit += profit
+			unrealized += profit * elapsedPercent(p.startTime, p.endTime, now) / 100
+
+			// A uniform spread of ±v has a standard deviation of v/√3
+			variance := returnRateVariance(p.riskLevel)
+			spread := principal * variance / 100
+			profitVariance += spread * spread / 3
+			worstCase += principal * math.Max(p.baseReturnRate-variance, minReturnRate) / 100
+			bestCase += principal * (p.baseReturnRate + variance) / 100
+			continue
+		}
+
+		if !p.returnedAmount.Valid {
+			continue
+		}
+		summary.ClosedCount++
+
+		pnl := p.returnedAmount.Int64 - p.amount
+		summary.RealizedPnl += pnl
+		switch {
+		case pnl > 0:
+			summary.Wins++
+		case pnl < 0:
+			summary.Losses++
+		}
+
+		if p.returnRate.Valid {
+			totals, ok := byRisk[p.riskLevel]
+			if !ok {
+				totals = &riskTotals{}
+				byRisk[p.riskLevel] = totals
+			}
+			totals.count++
+			totals.rateTotal += p.returnRate.Float64
+		}
+	}
+
+	summary.UnrealizedPnl = int64(math.Round(unrealized))
+	summary.Projection.ExpectedProfit = int64(math.Round(expectedProfit))
+	summary.Projection.ExpectedValue = summary.OpenPrincipal + summary.Projection.ExpectedProfit
+	summary.Projection.ProfitStdDev = math.Sqrt(profitVariance)
+	summary.Projection.WorstCaseProfit = int64(math.Round(worstCase))
+	summary.Projection.BestCaseProfit = int64(math.Round(bestCase))
+
+	for level, totals := range byRisk {
+		summary.RiskLevels = append(summary.RiskLevels, &pb.RiskLevelSummary{
+			RiskLevel:         level,
+			ClosedCount:       totals.count,
+			AverageReturnRate: totals.rateTotal / float64(totals.count),
+		})
+	}
+	sort.Slice(summary.RiskLevels, func(i, j int) bool {
+		return summary.RiskLevels[i].RiskLevel < summary.RiskLevels[j].RiskLevel
+	})
+
+	return summary
+}
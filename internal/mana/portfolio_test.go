@@ -0,0 +1,98 @@
+package mana
+
+import (
+	"database/sql"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tectix/mysticfunds/proto/mana"
+)
+
+func closedPosition(status string, amount, returned int64, rate float64, riskLevel int32) portfolioPosition {
+	return portfolioPosition{
+		status:         status,
+		amount:         amount,
+		returnedAmount: sql.NullInt64{Int64: returned, Valid: true},
+		returnRate:     sql.NullFloat64{Float64: rate, Valid: true},
+		startTime:      testEpoch.Add(-48 * time.Hour),
+		endTime:        testEpoch.Add(-24 * time.Hour),
+		riskLevel:      riskLevel,
+	}
+}
+
+func TestSummarizePortfolio(t *testing.T) {
+	positions := []portfolioPosition{
+		// Half way through, so half of the expected 100 profit has accrued
+		{status: "active", amount: 1000, startTime: testEpoch.Add(-12 * time.Hour), endTime: testEpoch.Add(12 * time.Hour),
+			baseReturnRate: 10, riskLevel: 2},
+		{status: "active", amount: 500, startTime: testEpoch, endTime: testEpoch.Add(24 * time.Hour),
+			baseReturnRate: 5, riskLevel: 1},
+		closedPosition("completed", 2000, 2300, 15, 3),
+		closedPosition("completed", 1000, 920, -8, 3),
+		closedPosition("withdrawn", 1000, 950, -5, 1),
+		closedPosition("settling", 400, 440, 10, 1),
+	}
+
+	summary := summarizePortfolio(1, positions, testEpoch)
+
+	assert.Equal(t, int64(5900), summary.TotalInvested)
+	assert.Equal(t, int64(1500), summary.OpenPrincipal)
+	assert.Equal(t, int32(2), summary.OpenCount)
+	assert.Equal(t, int32(4), summary.ClosedCount)
+	assert.Equal(t, int64(210), summary.RealizedPnl)
+	assert.Equal(t, int64(50), summary.UnrealizedPnl)
+	assert.Equal(t, int32(2), summary.Wins)
+	assert.Equal(t, int32(2), summary.Losses)
+
+	assert.Equal(t, []*pb.RiskLevelSummary{
+		{RiskLevel: 1, ClosedCount: 2, AverageReturnRate: 2.5},
+		{RiskLevel: 3, ClosedCount: 2, AverageReturnRate: 3.5},
+	}, summary.RiskLevels)
+
+	// Risk 2 spreads ±4 points over 1000 and risk 1 spreads ±2 points over 500
+	assert.Equal(t, int64(125), summary.Projection.ExpectedProfit)
+	assert.Equal(t, int64(1625), summary.Projection.ExpectedValue)
+	assert.InDelta(t, math.Sqrt((40*40+10*10)/3.0), summary.Projection.ProfitStdDev, 1e-9)
+	assert.Equal(t, int64(75), summary.Projection.WorstCaseProfit)
+	assert.Equal(t, int64(175), summary.Projection.BestCaseProfit)
+}
+
+func TestSummarizePortfolioFloorsWorstCase(t *testing.T) {
+	positions := []portfolioPosition{
+		{status: "active", amount: 1000, startTime: testEpoch, endTime: testEpoch.Add(time.Hour),
+			baseReturnRate: -85, riskLevel: 5},
+	}
+
+	summary := summarizePortfolio(1, positions, testEpoch)
+
+	assert.Equal(t, int64(-900), summary.Projection.WorstCaseProfit)
+	assert.Equal(t, int64(-750), summary.Projection.BestCaseProfit)
+}
+
+func TestGetPortfolioSummary(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	setup.mock.ExpectQuery("SELECT (.+) FROM wizard_investments i").
+		WithArgs(setup.testWizard1).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"status", "amount", "returned_amount", "actual_return_rate", "start_time", "end_time",
+			"base_return_rate", "risk_level",
+		}).
+			AddRow("completed", 1000, 1100, 10.0, testEpoch.Add(-48*time.Hour), testEpoch.Add(-24*time.Hour), 8.0, 2).
+			AddRow("active", 2000, nil, nil, testEpoch.Add(-6*time.Hour), testEpoch.Add(18*time.Hour), 8.0, 2))
+
+	summary, err := setup.service.GetPortfolioSummary(setup.ctx, &pb.GetPortfolioSummaryRequest{
+		WizardId: setup.testWizard1,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3000), summary.TotalInvested)
+	assert.Equal(t, int64(100), summary.RealizedPnl)
+	assert.Equal(t, int64(40), summary.UnrealizedPnl)
+	assert.Equal(t, int64(2160), summary.Projection.ExpectedValue)
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,178 @@
+package mana
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/tectix/mysticfunds/internal/rewards"
+	pb "github.com/tectix/mysticfunds/proto/mana"
+	wizardpb "github.com/tectix/mysticfunds/proto/wizard"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Rollover modes
+const (
+	// rolloverCompound reinvests the principal plus the return
+	rolloverCompound = "compound"
+	// rolloverPrincipal reinvests the principal and credits the profit
+	rolloverPrincipal = "principal"
+)
+
+// maxRollovers caps how many times an investment can be rolled over
+const maxRollovers = 12
+
+// validateRollover returns the rollover mode and number of rollovers a new
+// investment starts with. The count is kept even when auto-rollover is off, so
+// turning it on later has rollovers to use.
+func validateRollover(req *pb.CreateInvestmentRequest) (string, int32, error) {
+	mode := req.RolloverMode
+	switch mode {
+	case "":
+		mode = rolloverCompound
+	case rolloverCompound, rolloverPrincipal:
+	default:
+		return "", 0, status.Errorf(codes.InvalidArgument, "Unknown rollover mode %q", req.RolloverMode)
+	}
+
+	rollovers := req.MaxRollovers
+	if rollovers == 0 {
+		rollovers = maxRollovers
+	}
+	if rollovers < 0 || rollovers > maxRollovers {
+		return "", 0, status.Errorf(codes.InvalidArgument, "Max rollovers must be between 1 and %d", maxRollovers)
+	}
+
+	return mode, rollovers, nil
+}
+
+// reinvestedAmount is the principal of the investment a matured one rolls over
+// into. A loss is never topped up from the wizard's balance, and the amount is
+// capped at the type's maximum, with the rest credited to the wizard.
+func reinvestedAmount(mode string, principal, returnedAmount int64, terms *openTerms) int64 {
+	amount := returnedAmount
+	if mode == rolloverPrincipal && principal < returnedAmount {
+		amount = principal
+	}
+	if terms.maxAmount > 0 && amount > terms.maxAmount {
+		amount = terms.maxAmount
+	}
+	return amount
+}
+
+// rollOver opens the investment that a matured one rolls over into and journals
+// the saga that settles both, within the transaction that holds the matured
+// investment's row. It returns a nil saga if the investment cannot be rolled
+// over, because its type has been deactivated or the amount is below the type's
+// minimum, in which case the return is credited as usual.
+func (s *InvestmentScheduler) rollOver(ctx context.Context, tx *sql.Tx, investment *dueInvestment,
+	returnedAmount int64, appliedModifiers []rewards.Applied) (*investmentSaga, error) {
+	terms, err := currentTerms(ctx, tx, investment.investmentTypeId)
+	if err == sql.ErrNoRows {
+		s.log.Info("Investment type is closed, crediting instead of rolling over", "investmentId", investment.id)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get investment terms: %w", err)
+	}
+
+	amount := reinvestedAmount(investment.rolloverMode, investment.amount, returnedAmount, terms)
+	if amount < terms.minAmount {
+		s.log.Info("Return is below the type's minimum, crediting instead of rolling over",
+			"investmentId", investment.id, "amount", amount)
+		return nil, nil
+	}
+
+	// The new investment stays pending until the saga has moved its principal
+	startTime := s.clock.Now()
+	endTime := startTime.Add(time.Duration(terms.durationHours) * time.Hour)
+	var rolledId int64
+	err = tx.QueryRowContext(ctx,
+		`INSERT INTO wizard_investments
+		(wizard_id, investment_type_id, investment_type_version_id, amount, start_time, end_time, status,
+		 auto_rollover, rollover_mode, rollovers_remaining, rolled_over_from)
+		VALUES ($1, $2, $3, $4, $5, $6, 'pending', true, $7, $8, $9)
+		RETURNING id`,
+		investment.wizardId, investment.investmentTypeId, terms.versionId, amount, startTime, endTime,
+		investment.rolloverMode, investment.rolloversRemaining-1, investment.id).Scan(&rolledId)
+	if err != nil {
+		return nil, fmt.Errorf("open rolled over investment: %w", err)
+	}
+
+	return s.sagas.begin(ctx, tx, investment.id, sagaRolloverInvestment,
+		rolloverRequest(investment.wizardId, investment.id, investment.amount, returnedAmount, amount, appliedModifiers), nil)
+}
+
+// rolloverRequest credits what is not reinvested and moves the difference between
+// the old and new principal through the wizard's investment account. It reuses
+// the reference of a plain return, so an investment is paid out at most once
+// either way.
+func rolloverRequest(wizardID, investmentID, principal, returnedAmount, reinvested int64,
+	appliedModifiers []rewards.Applied) *wizardpb.UpdateManaBalanceRequest {
+	var postings []*wizardpb.LedgerPosting
+	if change := reinvested - principal; change != 0 {
+		postings = append(postings, &wizardpb.LedgerPosting{AccountType: "investment", Amount: change})
+	}
+	if profit := returnedAmount - principal; profit != 0 {
+		postings = append(postings, &wizardpb.LedgerPosting{AccountType: "system", Amount: -profit})
+	}
+
+	return &wizardpb.UpdateManaBalanceRequest{
+		WizardId:        wizardID,
+		Amount:          returnedAmount - reinvested,
+		Reason:          "Investment rollover",
+		Modifiers:       rewards.AppliedToProto(appliedModifiers),
+		CounterPostings: postings,
+		Reference:       fmt.Sprintf("investment:%d:return", investmentID),
+	}
+}
+
+// SetInvestmentRollover turns auto-rollover on or off for an investment that has
+// not started settling. The scheduler reads the setting when it claims the
+// investment, under the same row lock.
+func (s *ManaServiceImpl) SetInvestmentRollover(ctx context.Context, req *pb.SetInvestmentRolloverRequest) (*pb.SetInvestmentRolloverResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var wizardId int64
+	var investmentStatus string
+	var rolloversRemaining int32
+	err = tx.QueryRowContext(ctx,
+		"SELECT wizard_id, status, rollovers_remaining FROM wizard_investments WHERE id = $1 FOR UPDATE",
+		req.InvestmentId).Scan(&wizardId, &investmentStatus, &rolloversRemaining)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "Investment not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch investment: %v", err)
+	}
+
+	if wizardId != req.WizardId {
+		return nil, status.Errorf(codes.PermissionDenied, "Investment belongs to another wizard")
+	}
+	if investmentStatus != "pending" && investmentStatus != "active" {
+		return nil, status.Errorf(codes.FailedPrecondition, "Investment is %s and can no longer be changed", investmentStatus)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE wizard_investments SET auto_rollover = $1, updated_at = NOW() WHERE id = $2",
+		req.AutoRollover, req.InvestmentId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update investment: %v", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to commit transaction: %v", err)
+	}
+
+	return &pb.SetInvestmentRolloverResponse{
+		InvestmentId:       req.InvestmentId,
+		AutoRollover:       req.AutoRollover,
+		RolloversRemaining: rolloversRemaining,
+	}, nil
+}
@@ -0,0 +1,235 @@
+package mana
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	pb "github.com/tectix/mysticfunds/proto/mana"
+	wizardpb "github.com/tectix/mysticfunds/proto/wizard"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReinvestedAmount(t *testing.T) {
+	uncapped := &openTerms{minAmount: 100}
+	capped := &openTerms{minAmount: 100, maxAmount: 1100}
+
+	tests := []struct {
+		name     string
+		mode     string
+		returned int64
+		terms    *openTerms
+		want     int64
+	}{
+		{"compound profit", rolloverCompound, 1200, uncapped, 1200},
+		{"compound loss", rolloverCompound, 900, uncapped, 900},
+		{"principal profit", rolloverPrincipal, 1200, uncapped, 1000},
+		{"principal loss is not topped up", rolloverPrincipal, 900, uncapped, 900},
+		{"capped at the type maximum", rolloverCompound, 1200, capped, 1100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, reinvestedAmount(tt.mode, 1000, tt.returned, tt.terms))
+		})
+	}
+}
+
+func TestRolloverRequestBalances(t *testing.T) {
+	for _, reinvested := range []int64{1200, 1000, 1100, 900} {
+		req := rolloverRequest(1, 5, 1000, 1200, reinvested, nil)
+
+		assert.Equal(t, 1200-reinvested, req.Amount)
+		assert.Equal(t, -req.Amount, sumPostings(req.CounterPostings))
+		assert.Equal(t, "investment:5:return", req.Reference)
+	}
+}
+
+func TestValidateRollover(t *testing.T) {
+	mode, rollovers, err := validateRollover(&pb.CreateInvestmentRequest{AutoRollover: true})
+	assert.NoError(t, err)
+	assert.Equal(t, rolloverCompound, mode)
+	assert.Equal(t, int32(maxRollovers), rollovers)
+
+	_, _, err = validateRollover(&pb.CreateInvestmentRequest{RolloverMode: "forever"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, _, err = validateRollover(&pb.CreateInvestmentRequest{MaxRollovers: maxRollovers + 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// expectRolloverClaimed sets up investment 1 of wizard 1 as the next due investment,
+// with auto-rollover on and a market that rose 20%
+func expectRolloverClaimed(sqlMock sqlmock.Sqlmock, mode string, rolloversRemaining int32) {
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery("SELECT (.+) FROM wizard_investments (.+) FOR UPDATE OF i SKIP LOCKED").
+		WithArgs(testEpoch).
+		WillReturnRows(sqlmock.NewRows(dueInvestmentColumns).
+			AddRow(1, 1, 1, 1000, 0, testStartTime, testEndTime, 5.0, 2, true, mode, rolloversRemaining))
+	expectMarketReturn(sqlMock, 20)
+	sqlMock.ExpectExec("UPDATE wizard_investments").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+}
+
+func expectCurrentTerms(sqlMock sqlmock.Sqlmock, rows *sqlmock.Rows) {
+	sqlMock.ExpectQuery("SELECT (.+) FROM investment_types t JOIN investment_type_versions v").
+		WithArgs(int64(1)).
+		WillReturnRows(rows)
+}
+
+func TestProcessNextRollsOverInvestment(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	wizardMock := &MockWizardServiceClient{}
+	scheduler := newTestScheduler(db, wizardMock)
+
+	// The whole 1200 returned is reinvested
+	expectRolloverClaimed(sqlMock, rolloverCompound, 3)
+	expectCurrentTerms(sqlMock, sqlmock.NewRows([]string{"id", "min_amount", "max_amount", "duration_hours"}).
+		AddRow(7, 100, 0, 24))
+	sqlMock.ExpectQuery("INSERT INTO wizard_investments").
+		WithArgs(int64(1), int64(1), int64(7), sqlmock.AnyArg(), testEpoch, testEpoch.Add(24*time.Hour),
+			rolloverCompound, int32(2), int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	expectSagaBegin(sqlMock, sagaRolloverInvestment, 1)
+	sqlMock.ExpectCommit()
+
+	wizardMock.On("GetRewardModifiers", mock.Anything, mock.Anything).
+		Return(&wizardpb.GetRewardModifiersResponse{}, nil)
+	wizardMock.On("UpdateManaBalance", mock.Anything,
+		mock.MatchedBy(func(req *wizardpb.UpdateManaBalanceRequest) bool {
+			return req.Amount == 0 && len(req.CounterPostings) == 2 &&
+				sumPostings(req.CounterPostings) == 0 &&
+				req.Reference == "investment:1:return"
+		})).Return(&wizardpb.UpdateManaBalanceResponse{Success: true}, nil)
+
+	// The matured investment is rolled over and the new one becomes active
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec("INSERT INTO investment_saga_steps").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	sqlMock.ExpectExec("UPDATE investment_sagas SET state = \\$1").
+		WithArgs(sagaCompleted, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec("UPDATE wizard_investments SET status = \\$1").
+		WithArgs("rolled_over", int64(1), "settling", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec("UPDATE wizard_investments SET status = \\$1,(.+)WHERE rolled_over_from = \\$2").
+		WithArgs("active", int64(1), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	claimed, err := scheduler.processNext(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+	wizardMock.AssertExpectations(t)
+}
+
+func TestProcessNextCreditsWhenTypeIsClosed(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	wizardMock := &MockWizardServiceClient{}
+	scheduler := newTestScheduler(db, wizardMock)
+
+	// A deactivated type takes no new investments, so the return is credited
+	expectRolloverClaimed(sqlMock, rolloverPrincipal, 3)
+	expectCurrentTerms(sqlMock, sqlmock.NewRows([]string{"id", "min_amount", "max_amount", "duration_hours"}))
+	expectSagaBegin(sqlMock, sagaSettleInvestment, 1)
+	sqlMock.ExpectCommit()
+
+	wizardMock.On("GetRewardModifiers", mock.Anything, mock.Anything).
+		Return(&wizardpb.GetRewardModifiersResponse{}, nil)
+	wizardMock.On("UpdateManaBalance", mock.Anything,
+		mock.MatchedBy(func(req *wizardpb.UpdateManaBalanceRequest) bool {
+			return req.Reason == "Investment return" && req.Amount >= 1199
+		})).Return(&wizardpb.UpdateManaBalanceResponse{Success: true}, nil)
+	expectSagaFinish(sqlMock, sagaCompleted, "completed")
+
+	_, err = scheduler.processNext(context.Background())
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+	wizardMock.AssertExpectations(t)
+}
+
+func TestProcessNextCreditsWhenRolloversUsedUp(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	wizardMock := &MockWizardServiceClient{}
+	scheduler := newTestScheduler(db, wizardMock)
+
+	// No terms lookup: the investment has no rollovers left
+	expectRolloverClaimed(sqlMock, rolloverCompound, 0)
+	expectSagaBegin(sqlMock, sagaSettleInvestment, 1)
+	sqlMock.ExpectCommit()
+
+	wizardMock.On("GetRewardModifiers", mock.Anything, mock.Anything).
+		Return(&wizardpb.GetRewardModifiersResponse{}, nil)
+	wizardMock.On("UpdateManaBalance", mock.Anything, mock.Anything).
+		Return(&wizardpb.UpdateManaBalanceResponse{Success: true}, nil)
+	expectSagaFinish(sqlMock, sagaCompleted, "completed")
+
+	_, err = scheduler.processNext(context.Background())
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestSetInvestmentRollover(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	setup.mock.ExpectBegin()
+	setup.mock.ExpectQuery("SELECT wizard_id, status, rollovers_remaining FROM wizard_investments").
+		WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"wizard_id", "status", "rollovers_remaining"}).
+			AddRow(1, "active", 5))
+	setup.mock.ExpectExec("UPDATE wizard_investments SET auto_rollover = \\$1").
+		WithArgs(false, int64(4)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	setup.mock.ExpectCommit()
+
+	resp, err := setup.service.SetInvestmentRollover(setup.ctx, &pb.SetInvestmentRolloverRequest{
+		InvestmentId: 4,
+		WizardId:     setup.testWizard1,
+	})
+
+	assert.NoError(t, err)
+	assert.False(t, resp.AutoRollover)
+	assert.Equal(t, int32(5), resp.RolloversRemaining)
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+}
+
+func TestSetInvestmentRolloverAfterSettlementStarted(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	setup.mock.ExpectBegin()
+	setup.mock.ExpectQuery("SELECT wizard_id, status, rollovers_remaining FROM wizard_investments").
+		WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"wizard_id", "status", "rollovers_remaining"}).
+			AddRow(1, "settling", 5))
+	setup.mock.ExpectRollback()
+
+	_, err := setup.service.SetInvestmentRollover(setup.ctx, &pb.SetInvestmentRolloverRequest{
+		InvestmentId: 4,
+		WizardId:     setup.testWizard1,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,405 @@
+package mana
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/tectix/mysticfunds/pkg/logger"
+	"github.com/tectix/mysticfunds/pkg/sim"
+	wizardpb "github.com/tectix/mysticfunds/proto/wizard"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Saga types
+const (
+	sagaCreateInvestment   = "create_investment"
+	sagaSettleInvestment   = "settle_investment"
+	sagaWithdrawInvestment = "withdraw_investment"
+	sagaRolloverInvestment = "rollover_investment"
+)
+
+// Saga states
+const (
+	sagaPending      = "pending"
+	sagaCompensating = "compensating"
+	sagaCompleted    = "completed"
+	sagaCompensated  = "compensated"
+	sagaFailed       = "failed"
+)
+
+// Journaled steps
+const (
+	stepRequest      = "request"
+	stepCompensation = "compensation"
+)
+
+// sagaInvestmentStatus gives, per saga type, the investment status while the saga is
+// running and the status it moves to when the saga ends in each final state
+var sagaInvestmentStatus = map[string]map[string]string{
+	sagaCreateInvestment: {
+		sagaPending:     "pending",
+		sagaCompleted:   "active",
+		sagaCompensated: "failed",
+		sagaFailed:      "failed",
+	},
+	sagaSettleInvestment: {
+		sagaPending:   "settling",
+		sagaCompleted: "completed",
+		sagaFailed:    "failed",
+	},
+	sagaWithdrawInvestment: {
+		sagaPending:   "withdrawing",
+		sagaCompleted: "withdrawn",
+		sagaFailed:    "failed",
+	},
+	sagaRolloverInvestment: {
+		sagaPending:   "settling",
+		sagaCompleted: "rolled_over",
+		sagaFailed:    "failed",
+	},
+}
+
+// rolledOverStatus gives the status the pending investment opened by a rollover
+// saga moves to when the saga ends in each final state
+var rolledOverStatus = map[string]string{
+	sagaCompleted: "active",
+	sagaFailed:    "failed",
+}
+
+// investmentSaga moves mana for an investment through the wizard service. The
+// request and its compensation carry ledger references, so resending either one
+// after an unknown outcome never applies it twice.
+type investmentSaga struct {
+	id           int64
+	investmentID int64
+	sagaType     string
+	state        string
+	request      *wizardpb.UpdateManaBalanceRequest
+	compensation *wizardpb.UpdateManaBalanceRequest
+}
+
+// sagaCoordinator runs investment sagas and resumes the ones left unfinished
+type sagaCoordinator struct {
+	db           *sql.DB
+	log          logger.Logger
+	wizardClient wizardpb.WizardServiceClient
+	maxAttempts  int
+	retryDelay   time.Duration
+	staleAfter   time.Duration
+	// clock stamps updated_at, so resume measures staleness in the same time
+	// as the rest of the scheduler
+	clock sim.Clock
+}
+
+func newSagaCoordinator(db *sql.DB, log logger.Logger, wizardClient wizardpb.WizardServiceClient, clock sim.Clock) *sagaCoordinator {
+	return &sagaCoordinator{
+		db:           db,
+		log:          log,
+		wizardClient: wizardClient,
+		clock:        clock,
+		maxAttempts:  3,
+		retryDelay:   500 * time.Millisecond,
+		staleAfter:   time.Minute,
+	}
+}
+
+// begin journals a new saga within tx. Nothing is sent to the wizard service
+// until the caller commits and calls execute.
+func (c *sagaCoordinator) begin(ctx context.Context, tx *sql.Tx, investmentID int64, sagaType string,
+	request, compensation *wizardpb.UpdateManaBalanceRequest) (*investmentSaga, error) {
+	requestJSON, err := protojson.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("marshal saga request: %w", err)
+	}
+
+	var compensationJSON sql.NullString
+	if compensation != nil {
+		data, err := protojson.Marshal(compensation)
+		if err != nil {
+			return nil, fmt.Errorf("marshal saga compensation: %w", err)
+		}
+		compensationJSON = sql.NullString{String: string(data), Valid: true}
+	}
+
+	saga := &investmentSaga{
+		investmentID: investmentID,
+		sagaType:     sagaType,
+		state:        sagaPending,
+		request:      request,
+		compensation: compensation,
+	}
+	err = tx.QueryRowContext(ctx,
+		`INSERT INTO investment_sagas (investment_id, saga_type, state, request, compensation, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $6)
+		 RETURNING id`,
+		investmentID, sagaType, sagaPending, string(requestJSON), compensationJSON, c.clock.Now()).Scan(&saga.id)
+	if err != nil {
+		return nil, fmt.Errorf("insert saga: %w", err)
+	}
+
+	return saga, nil
+}
+
+// execute drives a saga as far as it can go. A pending saga sends its request and
+// then completes; if the request is rejected outright the saga fails without any
+// mana having moved. If the request succeeds but the investment cannot be updated
+// the compensation is sent instead. On a retryable error the saga is left in its
+// current state for resume to pick up.
+func (c *sagaCoordinator) execute(ctx context.Context, saga *investmentSaga) error {
+	if saga.state == sagaCompensating {
+		return c.compensate(ctx, saga, nil)
+	}
+
+	attempts, err := c.call(ctx, saga.request)
+	if err != nil {
+		if retryable(err) {
+			c.journal(ctx, saga, stepRequest, attempts, err)
+			return err
+		}
+
+		if finishErr := c.finish(ctx, saga, stepRequest, attempts, err, sagaFailed); finishErr != nil {
+			c.log.Error("Failed to record rejected saga", "error", finishErr, "sagaId", saga.id)
+		}
+		return err
+	}
+
+	if err := c.finish(ctx, saga, stepRequest, attempts, nil, sagaCompleted); err != nil {
+		c.log.Error("Failed to complete saga", "error", err, "sagaId", saga.id)
+		if saga.compensation == nil {
+			// Settlement only moves forward: resending the credit is a no-op,
+			// so resume simply retries the completion
+			return err
+		}
+		return c.compensate(ctx, saga, err)
+	}
+
+	return nil
+}
+
+// compensate undoes a saga's request. The saga is marked compensating before the
+// compensation is sent, so a saga that has been refunded can never be resumed
+// forwards. It returns cause once the compensation has been applied.
+func (c *sagaCoordinator) compensate(ctx context.Context, saga *investmentSaga, cause error) error {
+	if saga.state != sagaCompensating {
+		_, err := c.db.ExecContext(ctx,
+			"UPDATE investment_sagas SET state = $1, last_error = $2, updated_at = $5 WHERE id = $3 AND state = $4",
+			sagaCompensating, errorText(cause), saga.id, sagaPending, c.clock.Now())
+		if err != nil {
+			c.log.Error("Failed to start saga compensation", "error", err, "sagaId", saga.id)
+			return cause
+		}
+		saga.state = sagaCompensating
+	}
+
+	attempts, err := c.call(ctx, saga.compensation)
+	if err != nil {
+		if retryable(err) {
+			c.journal(ctx, saga, stepCompensation, attempts, err)
+			return err
+		}
+
+		c.log.Error("Saga compensation rejected", "error", err, "sagaId", saga.id)
+		if finishErr := c.finish(ctx, saga, stepCompensation, attempts, err, sagaFailed); finishErr != nil {
+			c.log.Error("Failed to record rejected compensation", "error", finishErr, "sagaId", saga.id)
+		}
+		return err
+	}
+
+	if err := c.finish(ctx, saga, stepCompensation, attempts, nil, sagaCompensated); err != nil {
+		c.log.Error("Failed to record saga compensation", "error", err, "sagaId", saga.id)
+		return err
+	}
+
+	if cause == nil {
+		cause = fmt.Errorf("saga %d compensated", saga.id)
+	}
+	return cause
+}
+
+// call sends req to the wizard service, retrying errors that leave the outcome
+// unknown. It returns the number of attempts made.
+func (c *sagaCoordinator) call(ctx context.Context, req *wizardpb.UpdateManaBalanceRequest) (int, error) {
+	var err error
+	for attempt := 1; attempt <= c.maxAttempts; attempt++ {
+		if _, err = c.wizardClient.UpdateManaBalance(ctx, req); err == nil {
+			return attempt, nil
+		}
+		if !retryable(err) || attempt == c.maxAttempts {
+			return attempt, err
+		}
+
+		select {
+		case <-time.After(c.retryDelay * time.Duration(attempt)):
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		}
+	}
+
+	return c.maxAttempts, err
+}
+
+// finish journals the step and moves the saga and its investment to state in one
+// transaction
+func (c *sagaCoordinator) finish(ctx context.Context, saga *investmentSaga, step string, attempts int, stepErr error, state string) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO investment_saga_steps (saga_id, step, succeeded, attempts, error)
+		 VALUES ($1, $2, $3, $4, $5)`,
+		saga.id, step, stepErr == nil, attempts, errorText(stepErr))
+	if err != nil {
+		return fmt.Errorf("journal saga step: %w", err)
+	}
+
+	// A successful compensation keeps the error that made it necessary
+	_, err = tx.ExecContext(ctx,
+		"UPDATE investment_sagas SET state = $1, attempts = attempts + $2, last_error = COALESCE($3, last_error), updated_at = $5 WHERE id = $4",
+		state, attempts, errorText(stepErr), saga.id, c.clock.Now())
+	if err != nil {
+		return fmt.Errorf("update saga: %w", err)
+	}
+
+	// A failed investment takes the saga's last error as its failure reason
+	statuses := sagaInvestmentStatus[saga.sagaType]
+	_, err = tx.ExecContext(ctx,
+		`UPDATE wizard_investments SET status = $1,
+		     failure_reason = CASE WHEN $1 = 'failed' THEN (SELECT last_error FROM investment_sagas WHERE id = $4) END
+		 WHERE id = $2 AND status = $3`,
+		statuses[state], saga.investmentID, statuses[sagaPending], saga.id)
+	if err != nil {
+		return fmt.Errorf("update investment: %w", err)
+	}
+
+	if saga.sagaType == sagaRolloverInvestment {
+		_, err = tx.ExecContext(ctx,
+			`UPDATE wizard_investments SET status = $1,
+			     failure_reason = CASE WHEN $1 = 'failed' THEN (SELECT last_error FROM investment_sagas WHERE id = $3) END
+			 WHERE rolled_over_from = $2 AND status = 'pending'`,
+			rolledOverStatus[state], saga.investmentID, saga.id)
+		if err != nil {
+			return fmt.Errorf("update rolled over investment: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	saga.state = state
+	return nil
+}
+
+// journal records a step that did not succeed and leaves the saga's state as is
+func (c *sagaCoordinator) journal(ctx context.Context, saga *investmentSaga, step string, attempts int, stepErr error) {
+	_, err := c.db.ExecContext(ctx,
+		`WITH step AS (
+		     INSERT INTO investment_saga_steps (saga_id, step, succeeded, attempts, error)
+		     VALUES ($1, $2, false, $3, $4)
+		 )
+		 UPDATE investment_sagas SET attempts = attempts + $3, last_error = $4, updated_at = $5 WHERE id = $1`,
+		saga.id, step, attempts, errorText(stepErr), c.clock.Now())
+	if err != nil {
+		c.log.Error("Failed to journal saga step", "error", err, "sagaId", saga.id, "step", step)
+	}
+}
+
+// resume executes sagas that have not moved for staleAfter, which covers calls
+// that exhausted their retries and sagas interrupted by a restart
+func (c *sagaCoordinator) resume(ctx context.Context) {
+	rows, err := c.db.QueryContext(ctx,
+		`SELECT id, investment_id, saga_type, state, request, compensation
+		 FROM investment_sagas
+		 WHERE state IN ($1, $2) AND updated_at < $3
+		 ORDER BY id`,
+		sagaPending, sagaCompensating, c.clock.Now().Add(-c.staleAfter))
+	if err != nil {
+		c.log.Error("Failed to load unfinished sagas", "error", err)
+		return
+	}
+
+	var sagas []*investmentSaga
+	for rows.Next() {
+		var saga investmentSaga
+		var requestJSON, compensationJSON []byte
+		if err := rows.Scan(&saga.id, &saga.investmentID, &saga.sagaType, &saga.state,
+			&requestJSON, &compensationJSON); err != nil {
+			c.log.Error("Failed to scan saga", "error", err)
+			continue
+		}
+
+		saga.request = &wizardpb.UpdateManaBalanceRequest{}
+		if err := protojson.Unmarshal(requestJSON, saga.request); err != nil {
+			c.log.Error("Failed to decode saga request", "error", err, "sagaId", saga.id)
+			continue
+		}
+		if len(compensationJSON) > 0 {
+			saga.compensation = &wizardpb.UpdateManaBalanceRequest{}
+			if err := protojson.Unmarshal(compensationJSON, saga.compensation); err != nil {
+				c.log.Error("Failed to decode saga compensation", "error", err, "sagaId", saga.id)
+				continue
+			}
+		}
+		sagas = append(sagas, &saga)
+	}
+	rows.Close()
+
+	for _, saga := range sagas {
+		if err := c.execute(ctx, saga); err != nil {
+			c.log.Warn("Saga still unfinished", "error", err, "sagaId", saga.id, "state", saga.state)
+			continue
+		}
+		c.log.Info("Resumed saga", "sagaId", saga.id, "state", saga.state)
+	}
+}
+
+// principalRequest moves an investment's principal into the wizard's investment account
+func principalRequest(wizardID, investmentID, amount int64) *wizardpb.UpdateManaBalanceRequest {
+	return &wizardpb.UpdateManaBalanceRequest{
+		WizardId: wizardID,
+		Amount:   -amount,
+		Reason:   "Investment creation",
+		CounterPostings: []*wizardpb.LedgerPosting{
+			{AccountType: "investment", Amount: amount},
+		},
+		Reference: fmt.Sprintf("investment:%d:principal", investmentID),
+	}
+}
+
+// refundRequest returns a principal that was taken for an investment that could not be created
+func refundRequest(wizardID, investmentID, amount int64) *wizardpb.UpdateManaBalanceRequest {
+	return &wizardpb.UpdateManaBalanceRequest{
+		WizardId: wizardID,
+		Amount:   amount,
+		Reason:   "Investment refund",
+		CounterPostings: []*wizardpb.LedgerPosting{
+			{AccountType: "investment", Amount: -amount},
+		},
+		Reference: fmt.Sprintf("investment:%d:refund", investmentID),
+	}
+}
+
+// retryable reports whether err may have left the outcome of a call unknown. The
+// listed codes mean the wizard service rejected the call without applying it.
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition,
+		codes.PermissionDenied, codes.AlreadyExists:
+		return false
+	}
+	return true
+}
+
+func errorText(err error) sql.NullString {
+	if err == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: err.Error(), Valid: true}
+}
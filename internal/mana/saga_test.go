@@ -0,0 +1,331 @@
+package mana
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	pb "github.com/tectix/mysticfunds/proto/mana"
+	wizardpb "github.com/tectix/mysticfunds/proto/wizard"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func expectSagaBegin(sqlMock sqlmock.Sqlmock, sagaType string, sagaID int64) {
+	sqlMock.ExpectQuery("INSERT INTO investment_sagas").
+		WithArgs(sqlmock.AnyArg(), sagaType, sagaPending, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(sagaID))
+}
+
+func expectSagaFinish(sqlMock sqlmock.Sqlmock, state, investmentStatus string) {
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec("INSERT INTO investment_saga_steps").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	sqlMock.ExpectExec("UPDATE investment_sagas SET state = \\$1").
+		WithArgs(state, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec("UPDATE wizard_investments SET status = \\$1").
+		WithArgs(investmentStatus, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+}
+
+func expectSagaJournal(sqlMock sqlmock.Sqlmock, step string, attempts int) {
+	sqlMock.ExpectExec("WITH step AS").
+		WithArgs(sqlmock.AnyArg(), step, attempts, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+// expectInvestmentRecorded sets up a 200 mana investment that is recorded as
+// investment 1 with saga 1, up to the point where the debit is sent
+func expectInvestmentRecorded(setup *testSetup) {
+	setup.service.scheduler.sagas.retryDelay = 0
+
+	setup.mock.ExpectQuery("SELECT (.+) FROM investment_types t JOIN investment_type_versions v").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "min_amount", "max_amount", "duration_hours"}).
+			AddRow(7, 100, 1000, 24))
+	setup.wizardMock.On("GetManaBalance", mock.Anything, mock.Anything).
+		Return(&wizardpb.GetManaBalanceResponse{Balance: 1000}, nil)
+
+	setup.mock.ExpectBegin()
+	setup.mock.ExpectQuery("INSERT INTO wizard_investments").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	expectSagaBegin(setup.mock, sagaCreateInvestment, 1)
+	setup.mock.ExpectCommit()
+}
+
+func withReference(reference string) interface{} {
+	return mock.MatchedBy(func(req *wizardpb.UpdateManaBalanceRequest) bool {
+		return req.Reference == reference
+	})
+}
+
+func createTestInvestment(setup *testSetup) (*pb.CreateInvestmentResponse, error) {
+	return setup.service.CreateInvestment(setup.ctx, &pb.CreateInvestmentRequest{
+		WizardId:         setup.testWizard1,
+		InvestmentTypeId: 1,
+		Amount:           200,
+	})
+}
+
+func TestCreateInvestmentDebitRejected(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	expectInvestmentRecorded(setup)
+	setup.wizardMock.On("UpdateManaBalance", mock.Anything, withReference("investment:1:principal")).
+		Return((*wizardpb.UpdateManaBalanceResponse)(nil), status.Error(codes.FailedPrecondition, "Insufficient mana balance")).
+		Once()
+	expectSagaFinish(setup.mock, sagaFailed, "failed")
+
+	_, err := createTestInvestment(setup)
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+	setup.wizardMock.AssertExpectations(t)
+}
+
+func TestCreateInvestmentDebitRetried(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	expectInvestmentRecorded(setup)
+	setup.wizardMock.On("UpdateManaBalance", mock.Anything, withReference("investment:1:principal")).
+		Return((*wizardpb.UpdateManaBalanceResponse)(nil), status.Error(codes.Unavailable, "connection reset")).
+		Once()
+	setup.wizardMock.On("UpdateManaBalance", mock.Anything, withReference("investment:1:principal")).
+		Return(&wizardpb.UpdateManaBalanceResponse{Success: true, NewBalance: 800}, nil).
+		Once()
+	expectSagaFinish(setup.mock, sagaCompleted, "active")
+
+	resp, err := createTestInvestment(setup)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), resp.InvestmentId)
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+	setup.wizardMock.AssertNumberOfCalls(t, "UpdateManaBalance", 2)
+}
+
+func TestCreateInvestmentDebitUnknown(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	// Every attempt times out, so the saga stays pending for resume
+	expectInvestmentRecorded(setup)
+	setup.wizardMock.On("UpdateManaBalance", mock.Anything, withReference("investment:1:principal")).
+		Return((*wizardpb.UpdateManaBalanceResponse)(nil), status.Error(codes.DeadlineExceeded, "deadline exceeded"))
+	expectSagaJournal(setup.mock, stepRequest, 3)
+
+	_, err := createTestInvestment(setup)
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+	setup.wizardMock.AssertNumberOfCalls(t, "UpdateManaBalance", 3)
+}
+
+func TestCreateInvestmentRefundsWhenActivationFails(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	expectInvestmentRecorded(setup)
+	setup.wizardMock.On("UpdateManaBalance", mock.Anything, withReference("investment:1:principal")).
+		Return(&wizardpb.UpdateManaBalanceResponse{Success: true, NewBalance: 800}, nil).
+		Once()
+
+	// The debit went through but the investment cannot be activated
+	setup.mock.ExpectBegin()
+	setup.mock.ExpectExec("INSERT INTO investment_saga_steps").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	setup.mock.ExpectExec("UPDATE investment_sagas SET state = \\$1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	setup.mock.ExpectExec("UPDATE wizard_investments SET status = \\$1").
+		WillReturnError(errors.New("connection lost"))
+	setup.mock.ExpectRollback()
+
+	setup.mock.ExpectExec("UPDATE investment_sagas SET state = \\$1, last_error = \\$2").
+		WithArgs(sagaCompensating, sqlmock.AnyArg(), 1, sagaPending, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	setup.wizardMock.On("UpdateManaBalance", mock.Anything,
+		mock.MatchedBy(func(req *wizardpb.UpdateManaBalanceRequest) bool {
+			return req.Reference == "investment:1:refund" && req.Amount == 200 &&
+				sumPostings(req.CounterPostings) == -200
+		})).
+		Return(&wizardpb.UpdateManaBalanceResponse{Success: true, NewBalance: 1000}, nil).
+		Once()
+	expectSagaFinish(setup.mock, sagaCompensated, "failed")
+
+	_, err := createTestInvestment(setup)
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+	setup.wizardMock.AssertExpectations(t)
+}
+
+func TestCreateInvestmentRefundUnknown(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	expectInvestmentRecorded(setup)
+	setup.wizardMock.On("UpdateManaBalance", mock.Anything, withReference("investment:1:principal")).
+		Return(&wizardpb.UpdateManaBalanceResponse{Success: true, NewBalance: 800}, nil).
+		Once()
+	setup.mock.ExpectBegin().WillReturnError(errors.New("too many connections"))
+
+	setup.mock.ExpectExec("UPDATE investment_sagas SET state = \\$1, last_error = \\$2").
+		WithArgs(sagaCompensating, sqlmock.AnyArg(), 1, sagaPending, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	setup.wizardMock.On("UpdateManaBalance", mock.Anything, withReference("investment:1:refund")).
+		Return((*wizardpb.UpdateManaBalanceResponse)(nil), status.Error(codes.Unavailable, "wizard service down"))
+	expectSagaJournal(setup.mock, stepCompensation, 3)
+
+	_, err := createTestInvestment(setup)
+
+	// The refund is owed but not yet made; resume keeps compensating
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+	setup.wizardMock.AssertNumberOfCalls(t, "UpdateManaBalance", 4)
+}
+
+func TestCreateInvestmentNotJournaled(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	setup.mock.ExpectQuery("SELECT (.+) FROM investment_types t JOIN investment_type_versions v").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "min_amount", "max_amount", "duration_hours"}).
+			AddRow(7, 100, 1000, 24))
+	setup.wizardMock.On("GetManaBalance", mock.Anything, mock.Anything).
+		Return(&wizardpb.GetManaBalanceResponse{Balance: 1000}, nil)
+	setup.mock.ExpectBegin()
+	setup.mock.ExpectQuery("INSERT INTO wizard_investments").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	setup.mock.ExpectQuery("INSERT INTO investment_sagas").
+		WillReturnError(errors.New("disk full"))
+	setup.mock.ExpectRollback()
+
+	_, err := createTestInvestment(setup)
+
+	// Without a journal entry no mana may move
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+	setup.wizardMock.AssertNotCalled(t, "UpdateManaBalance", mock.Anything, mock.Anything)
+}
+
+func TestProcessInvestmentCreditUnknown(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	wizardMock := &MockWizardServiceClient{}
+	scheduler := newTestScheduler(db, wizardMock)
+	scheduler.sagas.retryDelay = 0
+
+	// A loss skips the reward modifiers lookup
+	expectInvestmentClaimed(sqlMock, 1000, 0, -5.0)
+	sqlMock.ExpectExec("UPDATE wizard_investments").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectSagaBegin(sqlMock, sagaSettleInvestment, 4)
+	sqlMock.ExpectCommit()
+
+	wizardMock.On("UpdateManaBalance", mock.Anything, withReference("investment:1:return")).
+		Return((*wizardpb.UpdateManaBalanceResponse)(nil), status.Error(codes.Unavailable, "wizard service down"))
+	expectSagaJournal(sqlMock, stepRequest, 3)
+
+	_, err = scheduler.processNext(context.Background())
+	assert.NoError(t, err)
+
+	// The investment stays settling and is not marked completed
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+	wizardMock.AssertNumberOfCalls(t, "UpdateManaBalance", 3)
+}
+
+func TestResumeSettlementAfterCompletionFailure(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	wizardMock := &MockWizardServiceClient{}
+	scheduler := newTestScheduler(db, wizardMock)
+	scheduler.sagas.retryDelay = 0
+
+	request := &wizardpb.UpdateManaBalanceRequest{
+		WizardId:        1,
+		Amount:          1050,
+		Reason:          "Investment return",
+		CounterPostings: returnPostings(1000, 1050),
+		Reference:       "investment:1:return",
+	}
+	requestJSON, err := protojson.Marshal(request)
+	assert.NoError(t, err)
+
+	sqlMock.ExpectQuery("SELECT id, investment_id, saga_type, state, request, compensation").
+		// Staleness is measured on the scheduler's clock, not the wall clock
+		WithArgs(sagaPending, sagaCompensating, testEpoch.Add(-time.Minute)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "investment_id", "saga_type", "state", "request", "compensation"}).
+			AddRow(4, 1, sagaSettleInvestment, sagaPending, requestJSON, nil))
+
+	// The credit was applied before the earlier completion failed, so the wizard
+	// service replays it and only the completion is redone
+	wizardMock.On("UpdateManaBalance", mock.Anything,
+		mock.MatchedBy(func(req *wizardpb.UpdateManaBalanceRequest) bool {
+			return req.Reference == "investment:1:return" && req.Amount == 1050 &&
+				sumPostings(req.CounterPostings) == -1050
+		})).
+		Return(&wizardpb.UpdateManaBalanceResponse{Success: true, NewBalance: 2050, Replayed: true}, nil).
+		Once()
+	expectSagaFinish(sqlMock, sagaCompleted, "completed")
+
+	scheduler.sagas.resume(context.Background())
+
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+	wizardMock.AssertExpectations(t)
+}
+
+func TestResumeCompensatingSaga(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	wizardMock := &MockWizardServiceClient{}
+	scheduler := newTestScheduler(db, wizardMock)
+
+	requestJSON, err := protojson.Marshal(principalRequest(1, 7, 300))
+	assert.NoError(t, err)
+	compensationJSON, err := protojson.Marshal(refundRequest(1, 7, 300))
+	assert.NoError(t, err)
+
+	sqlMock.ExpectQuery("SELECT id, investment_id, saga_type, state, request, compensation").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "investment_id", "saga_type", "state", "request", "compensation"}).
+			AddRow(9, 7, sagaCreateInvestment, sagaCompensating, requestJSON, compensationJSON))
+
+	// A compensating saga never resends its request, only the refund
+	wizardMock.On("UpdateManaBalance", mock.Anything, withReference("investment:7:refund")).
+		Return(&wizardpb.UpdateManaBalanceResponse{Success: true, NewBalance: 1000}, nil).
+		Once()
+	expectSagaFinish(sqlMock, sagaCompensated, "failed")
+
+	scheduler.sagas.resume(context.Background())
+
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+	wizardMock.AssertExpectations(t)
+}
+
+func TestRetryable(t *testing.T) {
+	assert.True(t, retryable(status.Error(codes.Unavailable, "down")))
+	assert.True(t, retryable(status.Error(codes.Aborted, "already being applied")))
+	assert.True(t, retryable(errors.New("connection reset")))
+	assert.False(t, retryable(status.Error(codes.FailedPrecondition, "Insufficient mana balance")))
+	assert.False(t, retryable(status.Error(codes.NotFound, "Wizard not found")))
+}
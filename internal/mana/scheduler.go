@@ -3,11 +3,13 @@ package mana
 import (
 	"context"
 	"database/sql"
-	"math/rand"
+	"fmt"
 	"sync"
 	"time"
 
+	"github.com/tectix/mysticfunds/internal/rewards"
 	"github.com/tectix/mysticfunds/pkg/logger"
+	"github.com/tectix/mysticfunds/pkg/sim"
 	wizardpb "github.com/tectix/mysticfunds/proto/wizard"
 )
 
@@ -15,48 +17,73 @@ type InvestmentScheduler struct {
 	db           *sql.DB
 	log          logger.Logger
 	wizardClient wizardpb.WizardServiceClient
+	sagas        *sagaCoordinator
+	clock        sim.Clock
+	rng          sim.RNG
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int
+	retryBackoff time.Duration
+	maxBackoff   time.Duration
+	marketTick   time.Duration
 	done         chan struct{}
+	wake         chan struct{}
 	mutex        sync.Mutex
-	active       map[int64]*time.Timer
+	active       map[int64]sim.Timer
 }
 
-func NewInvestmentScheduler(db *sql.DB, log logger.Logger, wizardClient wizardpb.WizardServiceClient) *InvestmentScheduler {
+// dueInvestment is an investment claimed for settlement
+type dueInvestment struct {
+	id                 int64
+	wizardId           int64
+	investmentTypeId   int64
+	amount             int64
+	attempts           int
+	startTime          time.Time
+	endTime            time.Time
+	baseReturnRate     float64
+	riskLevel          int32
+	autoRollover       bool
+	rolloverMode       string
+	rolloversRemaining int32
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// NewInvestmentScheduler creates a scheduler that reads the time from clock and
+// draws investment returns from rng
+func NewInvestmentScheduler(db *sql.DB, log logger.Logger, wizardClient wizardpb.WizardServiceClient,
+	clock sim.Clock, rng sim.RNG) *InvestmentScheduler {
 	return &InvestmentScheduler{
 		db:           db,
 		log:          log,
 		wizardClient: wizardClient,
+		sagas:        newSagaCoordinator(db, log, wizardClient, clock),
+		clock:        clock,
+		rng:          rng,
+		pollInterval: 10 * time.Second,
+		batchSize:    100,
+		maxAttempts:  5,
+		retryBackoff: 30 * time.Second,
+		maxBackoff:   30 * time.Minute,
+		marketTick:   time.Hour,
 		done:         make(chan struct{}),
-		active:       make(map[int64]*time.Timer),
+		wake:         make(chan struct{}, 1),
+		active:       make(map[int64]sim.Timer),
 	}
 }
 
+// Start begins settling due investments. wizard_investments is the work queue:
+// every replica polls it and claims due rows with SKIP LOCKED, so each investment
+// is settled by exactly one of them and nothing is lost when a replica restarts.
 func (s *InvestmentScheduler) Start() {
 	s.log.Info("Starting investment scheduler")
 
-	// Load and schedule existing active investments
-	rows, err := s.db.Query(`
-		SELECT id, end_time 
-		FROM wizard_investments 
-		WHERE status = 'active' AND end_time > NOW()
-	`)
-	if err != nil {
-		s.log.Error("Failed to load active investments", "error", err)
-		return
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var id int64
-		var endTime time.Time
-		if err := rows.Scan(&id, &endTime); err != nil {
-			s.log.Error("Failed to scan investment", "error", err)
-			continue
-		}
-		s.ScheduleInvestmentCompletion(id, endTime)
-	}
-
-	// Start periodic cleanup of completed investments
-	go s.cleanupRoutine()
+	go s.workRoutine()
+	go s.sagaRecoveryRoutine()
 }
 
 func (s *InvestmentScheduler) Stop() {
@@ -70,9 +97,12 @@ func (s *InvestmentScheduler) Stop() {
 	for _, timer := range s.active {
 		timer.Stop()
 	}
-	s.active = make(map[int64]*time.Timer)
+	s.active = make(map[int64]sim.Timer)
 }
 
+// ScheduleInvestmentCompletion wakes the worker as soon as the investment is due.
+// The timer only saves waiting for the next poll; the poll finds the investment
+// either way, even if another replica created it.
 func (s *InvestmentScheduler) ScheduleInvestmentCompletion(investmentId int64, endTime time.Time) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -80,159 +110,294 @@ func (s *InvestmentScheduler) ScheduleInvestmentCompletion(investmentId int64, e
 	// Cancel existing timer if any
 	if timer, exists := s.active[investmentId]; exists {
 		timer.Stop()
+		delete(s.active, investmentId)
 	}
 
-	duration := time.Until(endTime)
-	if duration < 0 {
+	duration := endTime.Sub(s.clock.Now())
+	if duration <= 0 {
 		// Investment already past due, process immediately
-		go s.processInvestment(investmentId)
+		s.notify()
 		return
 	}
 
-	timer := time.AfterFunc(duration, func() {
-		s.processInvestment(investmentId)
+	s.active[investmentId] = s.clock.AfterFunc(duration, func() {
+		s.mutex.Lock()
+		delete(s.active, investmentId)
+		s.mutex.Unlock()
+		s.notify()
 	})
-	s.active[investmentId] = timer
 }
 
-func (s *InvestmentScheduler) processInvestment(investmentId int64) {
-	ctx := context.Background()
+// CancelInvestmentCompletion drops the wake-up timer of an investment that will not
+// mature, such as one that has been withdrawn
+func (s *InvestmentScheduler) CancelInvestmentCompletion(investmentId int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if timer, exists := s.active[investmentId]; exists {
+		timer.Stop()
+		delete(s.active, investmentId)
+	}
+}
+
+// notify asks the worker to poll now. A wake-up that is already queued covers
+// this one too.
+func (s *InvestmentScheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *InvestmentScheduler) workRoutine() {
+	ticker := s.clock.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.processDue(context.Background())
+
+		select {
+		case <-ticker.C():
+		case <-s.wake:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// processDue brings the mystic markets up to date, then settles due investments
+// until none are left or the batch is used up
+func (s *InvestmentScheduler) processDue(ctx context.Context) {
+	// Investments whose market is behind back off and are retried
+	if err := s.advanceMarkets(ctx); err != nil {
+		s.log.Error("Failed to advance mystic markets", "error", err)
+	}
+
+	for i := 0; i < s.batchSize; i++ {
+		claimed, err := s.processNext(ctx)
+		if err != nil {
+			s.log.Error("Failed to process due investment", "error", err)
+			return
+		}
+		if !claimed {
+			return
+		}
+	}
+}
+
+// processNext claims the next due investment and settles it. It reports whether
+// an investment was claimed.
+func (s *InvestmentScheduler) processNext(ctx context.Context) (bool, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		s.log.Error("Failed to begin transaction", "error", err, "investmentId", investmentId)
-		return
+		return false, fmt.Errorf("begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
-	// Get investment details
-	var investment struct {
-		wizardId       int64
-		amount         int64
-		baseReturnRate float64
-		riskLevel      int32
-	}
-
+	var investment dueInvestment
 	err = tx.QueryRowContext(ctx, `
-		SELECT i.wizard_id, i.amount, t.base_return_rate, t.risk_level
+		SELECT i.id, i.wizard_id, i.investment_type_id, i.amount, i.attempts, i.start_time, i.end_time,
+			t.base_return_rate, t.risk_level, i.auto_rollover, i.rollover_mode, i.rollovers_remaining
 		FROM wizard_investments i
-		JOIN investment_types t ON i.investment_type_id = t.id
-		WHERE i.id = $1 AND i.status = 'active'`,
-		investmentId).Scan(
+		JOIN investment_type_versions t ON i.investment_type_version_id = t.id
+		WHERE i.status = 'active' AND i.end_time <= $1
+			AND (i.next_attempt_at IS NULL OR i.next_attempt_at <= $1)
+		ORDER BY i.end_time
+		LIMIT 1
+		FOR UPDATE OF i SKIP LOCKED`,
+		s.clock.Now()).Scan(
+		&investment.id,
 		&investment.wizardId,
+		&investment.investmentTypeId,
 		&investment.amount,
+		&investment.attempts,
+		&investment.startTime,
+		&investment.endTime,
 		&investment.baseReturnRate,
 		&investment.riskLevel,
+		&investment.autoRollover,
+		&investment.rolloverMode,
+		&investment.rolloversRemaining,
 	)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
 	if err != nil {
-		if err == sql.ErrNoRows {
-			s.log.Info("Investment already processed", "investmentId", investmentId)
-			return
+		return false, fmt.Errorf("claim investment: %w", err)
+	}
+
+	saga, err := s.settle(ctx, tx, &investment)
+	if err != nil {
+		// Record the attempt while the row is still locked, so no other worker
+		// retries the investment before its backoff has passed
+		if recordErr := s.recordFailure(ctx, tx, &investment, err); recordErr == nil {
+			if commitErr := tx.Commit(); commitErr == nil {
+				return true, nil
+			}
 		}
-		s.log.Error("Failed to fetch investment", "error", err, "investmentId", investmentId)
-		return
+
+		// The transaction is unusable, so record the attempt on its own
+		tx.Rollback()
+		if recordErr := s.recordFailure(ctx, s.db, &investment, err); recordErr != nil {
+			return true, fmt.Errorf("record failed attempt for investment %d: %w", investment.id, recordErr)
+		}
+		return true, nil
 	}
 
-	// Calculate return based on risk level and random variance
-	actualReturnRate := calculateReturnRate(investment.baseReturnRate, investment.riskLevel)
+	if err = tx.Commit(); err != nil {
+		return true, fmt.Errorf("commit settlement of investment %d: %w", investment.id, err)
+	}
+
+	// Credit returned amount to wizard via wizard service. Once the saga is
+	// journaled its retries belong to saga recovery rather than the queue.
+	if err := s.sagas.execute(ctx, saga); err != nil {
+		s.log.Error("Failed to credit return", "error", err, "investmentId", investment.id, "sagaState", saga.state)
+		return true, nil
+	}
+
+	// An investment it rolled over into is picked up by the poll once it is due
+	if saga.sagaType == sagaRolloverInvestment {
+		s.log.Info("Investment rolled over",
+			"investmentId", investment.id,
+			"creditedAmount", saga.request.Amount)
+		return true, nil
+	}
+
+	s.log.Info("Investment completed successfully",
+		"investmentId", investment.id,
+		"returnedAmount", saga.request.Amount)
+	return true, nil
+}
+
+// settle fixes the investment's return and journals the saga that credits it, or
+// rolls it over, within the transaction that holds the investment's row
+func (s *InvestmentScheduler) settle(ctx context.Context, tx *sql.Tx, investment *dueInvestment) (*investmentSaga, error) {
+	// Investments of a type that mature in the same tick share the market's return
+	actualReturnRate, err := s.marketReturnRate(ctx, tx, investment)
+	if err != nil {
+		return nil, fmt.Errorf("get market return: %w", err)
+	}
 	returnedAmount := int64(float64(investment.amount) * (1 + actualReturnRate/100))
 
-	// Update investment status and return
+	// Realm boosts scale the profit only; losses are never amplified
+	var appliedModifiers []rewards.Applied
+	if profit := returnedAmount - investment.amount; profit > 0 {
+		modifiers, err := s.wizardClient.GetRewardModifiers(ctx, &wizardpb.GetRewardModifiersRequest{
+			WizardId:   investment.wizardId,
+			RewardType: rewards.RewardTypeInvestment,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get reward modifiers: %w", err)
+		}
+
+		profit, appliedModifiers = rewards.FromProto(modifiers.Modifiers).Apply(profit)
+		returnedAmount = investment.amount + profit
+	}
+
+	// Fix the return and journal the credit before it is sent, so retries pay
+	// out exactly this amount
 	_, err = tx.ExecContext(ctx, `
 		UPDATE wizard_investments 
-		SET status = 'completed',
+		SET status = 'settling',
 			actual_return_rate = $1,
 			returned_amount = $2,
+			next_attempt_at = NULL,
 			updated_at = NOW()
 		WHERE id = $3`,
-		actualReturnRate, returnedAmount, investmentId)
+		actualReturnRate, returnedAmount, investment.id)
 	if err != nil {
-		s.log.Error("Failed to update investment", "error", err, "investmentId", investmentId)
-		return
+		return nil, fmt.Errorf("update investment: %w", err)
 	}
 
-	// Credit returned amount to wizard via wizard service
-	_, err = s.wizardClient.UpdateManaBalance(ctx, &wizardpb.UpdateManaBalanceRequest{
-		WizardId: investment.wizardId,
-		Amount:   returnedAmount,
-		Reason:   "Investment return",
-	})
-	if err != nil {
-		s.log.Error("Failed to credit return", "error", err, "investmentId", investmentId)
-		return
+	if investment.autoRollover && investment.rolloversRemaining > 0 {
+		saga, err := s.rollOver(ctx, tx, investment, returnedAmount, appliedModifiers)
+		if err != nil || saga != nil {
+			return saga, err
+		}
 	}
 
-	if err = tx.Commit(); err != nil {
-		s.log.Error("Failed to commit transaction", "error", err, "investmentId", investmentId)
-		return
+	return s.sagas.begin(ctx, tx, investment.id, sagaSettleInvestment, &wizardpb.UpdateManaBalanceRequest{
+		WizardId:        investment.wizardId,
+		Amount:          returnedAmount,
+		Reason:          "Investment return",
+		Modifiers:       rewards.AppliedToProto(appliedModifiers),
+		CounterPostings: returnPostings(investment.amount, returnedAmount),
+		Reference:       fmt.Sprintf("investment:%d:return", investment.id),
+	}, nil)
+}
+
+// recordFailure schedules another attempt with exponential backoff, or marks the
+// investment failed once it has used up its attempts. The principal of a failed
+// investment stays in the wizard's investment account for an operator to settle.
+func (s *InvestmentScheduler) recordFailure(ctx context.Context, db execer, investment *dueInvestment, cause error) error {
+	attempts := investment.attempts + 1
+	if attempts >= s.maxAttempts {
+		reason := fmt.Sprintf("Settlement failed after %d attempts: %v", attempts, cause)
+		s.log.Error("Investment failed", "error", cause, "investmentId", investment.id, "attempts", attempts)
+		_, err := db.ExecContext(ctx, `
+			UPDATE wizard_investments
+			SET status = 'failed', attempts = $1, last_error = $2, failure_reason = $3, next_attempt_at = NULL
+			WHERE id = $4 AND status = 'active'`,
+			attempts, cause.Error(), reason, investment.id)
+		return err
 	}
 
-	s.mutex.Lock()
-	delete(s.active, investmentId)
-	s.mutex.Unlock()
+	delay := s.backoff(attempts)
+	s.log.Warn("Investment settlement failed, will retry",
+		"error", cause, "investmentId", investment.id, "attempts", attempts, "retryIn", delay)
+	_, err := db.ExecContext(ctx, `
+		UPDATE wizard_investments
+		SET attempts = $1, last_error = $2, next_attempt_at = $3
+		WHERE id = $4 AND status = 'active'`,
+		attempts, cause.Error(), s.clock.Now().Add(delay), investment.id)
+	return err
+}
 
-	s.log.Info("Investment completed successfully",
-		"investmentId", investmentId,
-		"returnRate", actualReturnRate,
-		"returnedAmount", returnedAmount)
+// backoff doubles the retry delay with every attempt, up to maxBackoff
+func (s *InvestmentScheduler) backoff(attempts int) time.Duration {
+	delay := s.retryBackoff
+	for i := 1; i < attempts && delay < s.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > s.maxBackoff {
+		delay = s.maxBackoff
+	}
+	return delay
 }
 
-func (s *InvestmentScheduler) cleanupRoutine() {
-	ticker := time.NewTicker(6 * time.Hour)
+// sagaRecoveryRoutine finishes investment sagas whose wizard service calls could
+// not be completed when they were first made
+func (s *InvestmentScheduler) sagaRecoveryRoutine() {
+	ticker := s.clock.NewTicker(time.Minute)
 	defer ticker.Stop()
 
 	for {
 		select {
-		case <-ticker.C:
-			s.cleanupExpiredInvestments()
+		case <-ticker.C():
+			s.sagas.resume(context.Background())
 		case <-s.done:
 			return
 		}
 	}
 }
 
-func (s *InvestmentScheduler) cleanupExpiredInvestments() {
-	ctx := context.Background()
-
-	// Find expired but still active investments
-	rows, err := s.db.QueryContext(ctx, `
-		SELECT id, end_time 
-		FROM wizard_investments 
-		WHERE status = 'active' AND end_time < NOW()
-	`)
-	if err != nil {
-		s.log.Error("Failed to query expired investments", "error", err)
-		return
+// returnPostings releases the principal from the wizard's investment account and
+// settles the profit or loss against the system account
+func returnPostings(principal, returnedAmount int64) []*wizardpb.LedgerPosting {
+	postings := []*wizardpb.LedgerPosting{
+		{AccountType: "investment", Amount: -principal},
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var id int64
-		var endTime time.Time
-		if err := rows.Scan(&id, &endTime); err != nil {
-			s.log.Error("Failed to scan expired investment", "error", err)
-			continue
-		}
-		// Process expired investment
-		go s.processInvestment(id)
+	if profit := returnedAmount - principal; profit != 0 {
+		postings = append(postings, &wizardpb.LedgerPosting{AccountType: "system", Amount: -profit})
 	}
+	return postings
 }
 
-func calculateReturnRate(baseRate float64, riskLevel int32) float64 {
-	rand.Seed(time.Now().UnixNano())
-
-	// Calculate variance based on risk level (higher risk = higher variance)
-	variance := float64(riskLevel) * 2.0
-
-	// Generate random adjustment within variance range
-	adjustment := (rand.Float64()*2 - 1) * variance
-
-	// Calculate actual return rate
-	actualRate := baseRate + adjustment
-
-	// Ensure return rate doesn't go below -90% (to prevent total loss)
-	if actualRate < -90 {
-		actualRate = -90
-	}
+// minReturnRate is the worst return rate an investment can settle at
+const minReturnRate = -90.0
 
-	return actualRate
+// returnRateVariance is the spread, in percentage points, of a type's returns over
+// one term in a calm market. Higher risk means higher variance.
+func returnRateVariance(riskLevel int32) float64 {
+	return float64(riskLevel) * 2.0
 }
@@ -1,6 +1,8 @@
 package mana
 
 import (
+	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -8,126 +10,274 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/tectix/mysticfunds/pkg/logger"
+	"github.com/tectix/mysticfunds/pkg/sim"
 	wizardpb "github.com/tectix/mysticfunds/proto/wizard"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func TestInvestmentScheduler(t *testing.T) {
+// testEpoch is where the fake clock of every test scheduler starts
+var testEpoch = time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+// newTestScheduler returns a scheduler on a fake clock with a fixed seed, so
+// investment outcomes are the same on every run
+func newTestScheduler(db *sql.DB, wizardClient wizardpb.WizardServiceClient) *InvestmentScheduler {
+	return NewInvestmentScheduler(db, logger.NewLogger("info"), wizardClient,
+		sim.NewFakeClock(testEpoch), sim.NewRNG(1))
+}
+
+// dueInvestmentColumns are the columns processNext claims a due investment with
+var dueInvestmentColumns = []string{
+	"id", "wizard_id", "investment_type_id", "amount", "attempts", "start_time", "end_time",
+	"base_return_rate", "risk_level", "auto_rollover", "rollover_mode", "rollovers_remaining",
+}
+
+// Due investments in tests run for the day up to testEpoch
+var (
+	testStartTime = testEpoch.Add(-24 * time.Hour)
+	testEndTime   = testEpoch
+)
+
+// expectInvestmentClaimed sets up investment 1 of wizard 1, of investment type 1,
+// as the next due investment, and its market as having moved by marketReturn percent
+func expectInvestmentClaimed(sqlMock sqlmock.Sqlmock, amount int64, attempts int, marketReturn float64) {
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery("SELECT (.+) FROM wizard_investments (.+) FOR UPDATE OF i SKIP LOCKED").
+		WithArgs(testEpoch).
+		WillReturnRows(sqlmock.NewRows(dueInvestmentColumns).
+			AddRow(1, 1, 1, amount, attempts, testStartTime, testEndTime, 5.0, 2, false, rolloverCompound, 0))
+	expectMarketReturn(sqlMock, marketReturn)
+}
+
+// expectMarketReturn sets up the market prices at the start and end of the term
+// of a due investment
+func expectMarketReturn(sqlMock sqlmock.Sqlmock, marketReturn float64) {
+	columns := []string{"price", "tick_at"}
+	sqlMock.ExpectQuery("SELECT price, tick_at FROM market_prices").
+		WithArgs(int64(1), testEndTime).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(100*(1+marketReturn/100), testEndTime))
+	sqlMock.ExpectQuery("SELECT price, tick_at FROM market_prices").
+		WithArgs(int64(1), testStartTime).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(100.0, testStartTime))
+}
+
+func TestProcessNextSettlesInvestment(t *testing.T) {
 	db, sqlMock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("Failed to create mock DB: %v", err)
 	}
 	defer db.Close()
 
-	log := logger.NewLogger("info")
 	wizardMock := &MockWizardServiceClient{}
-	scheduler := NewInvestmentScheduler(db, log, wizardMock)
+	scheduler := newTestScheduler(db, wizardMock)
 
-	t.Run("Initialize scheduler", func(t *testing.T) {
-		sqlMock.ExpectQuery("SELECT id, end_time FROM wizard_investments").
-			WillReturnRows(sqlmock.NewRows([]string{"id", "end_time"}).
-				AddRow(1, time.Now().Add(1*time.Hour)).
-				AddRow(2, time.Now().Add(2*time.Hour)))
+	expectInvestmentClaimed(sqlMock, 1000, 0, 5.0)
+	sqlMock.ExpectExec("UPDATE wizard_investments").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectSagaBegin(sqlMock, sagaSettleInvestment, 1)
+	sqlMock.ExpectCommit()
 
-		scheduler.Start()
-		assert.NoError(t, sqlMock.ExpectationsWereMet())
-		scheduler.Stop()
-	})
+	wizardMock.On("GetRewardModifiers", mock.Anything, mock.Anything).
+		Return(&wizardpb.GetRewardModifiersResponse{}, nil).Once()
 
-	t.Run("Process investment completion", func(t *testing.T) {
-		sqlMock.ExpectBegin()
-
-		sqlMock.ExpectQuery("SELECT (.+) FROM wizard_investments").
-			WithArgs(1).
-			WillReturnRows(sqlmock.NewRows([]string{
-				"wizard_id", "amount", "base_return_rate", "risk_level",
-			}).AddRow(1, 1000, 5.0, 2))
-
-		sqlMock.ExpectExec("UPDATE wizard_investments").
-			WillReturnResult(sqlmock.NewResult(1, 1))
-
-		// Mock wizard service call with flexible matching
-		wizardMock.On("UpdateManaBalance",
-			mock.Anything, // context
-			mock.MatchedBy(func(req *wizardpb.UpdateManaBalanceRequest) bool {
-				return req.WizardId == int64(1) && req.Reason == "Investment return"
-			})).Return(&wizardpb.UpdateManaBalanceResponse{
-			NewBalance: 1100,
-			Success:    true,
-		}, nil)
-
-		sqlMock.ExpectCommit()
-
-		scheduler.processInvestment(1)
-		assert.NoError(t, sqlMock.ExpectationsWereMet())
-		wizardMock.AssertExpectations(t)
-	})
+	// Mock wizard service call with flexible matching
+	wizardMock.On("UpdateManaBalance",
+		mock.Anything, // context
+		mock.MatchedBy(func(req *wizardpb.UpdateManaBalanceRequest) bool {
+			return req.WizardId == int64(1) && req.Reason == "Investment return"
+		})).Return(&wizardpb.UpdateManaBalanceResponse{
+		NewBalance: 1100,
+		Success:    true,
+	}, nil)
+
+	expectSagaFinish(sqlMock, sagaCompleted, "completed")
+
+	claimed, err := scheduler.processNext(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+	wizardMock.AssertExpectations(t)
 }
 
-func TestScheduleInvestmentCompletion(t *testing.T) {
+func TestProcessNextNothingDue(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	scheduler := newTestScheduler(db, &MockWizardServiceClient{})
+
+	// Every market is already at the current tick
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery("SELECT (.+) FROM investment_type_markets m").
+		WillReturnRows(sqlmock.NewRows(nil))
+	sqlMock.ExpectCommit()
+
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery("SELECT (.+) FROM wizard_investments").
+		WillReturnRows(sqlmock.NewRows(dueInvestmentColumns))
+	sqlMock.ExpectRollback()
+
+	scheduler.processDue(context.Background())
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestProcessInvestmentAppliesRealmBoost(t *testing.T) {
 	db, sqlMock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("Failed to create mock DB: %v", err)
 	}
 	defer db.Close()
 
-	log := logger.NewLogger("info")
 	wizardMock := &MockWizardServiceClient{}
-	scheduler := NewInvestmentScheduler(db, log, wizardMock)
+	scheduler := newTestScheduler(db, wizardMock)
+
+	// The market rose 20%, so the profit is 200 before the boost
+	expectInvestmentClaimed(sqlMock, 1000, 0, 20.0)
+	sqlMock.ExpectExec("UPDATE wizard_investments").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectSagaBegin(sqlMock, sagaSettleInvestment, 1)
+	sqlMock.ExpectCommit()
+
+	wizardMock.On("GetRewardModifiers", mock.Anything,
+		mock.MatchedBy(func(req *wizardpb.GetRewardModifiersRequest) bool {
+			return req.WizardId == int64(1) && req.RewardType == "investment"
+		})).Return(&wizardpb.GetRewardModifiersResponse{
+		Modifiers: []*wizardpb.RewardModifier{{Name: "Nyxthar", Source: "realm", Factor: 1.5}},
+	}, nil)
+
+	wizardMock.On("UpdateManaBalance", mock.Anything,
+		mock.MatchedBy(func(req *wizardpb.UpdateManaBalanceRequest) bool {
+			return req.Amount >= 1270 && req.Amount <= 1330 &&
+				len(req.Modifiers) == 1 && req.Modifiers[0].Name == "Nyxthar" &&
+				req.Modifiers[0].AmountAfter == req.Amount-1000 &&
+				sumPostings(req.CounterPostings) == -req.Amount
+		})).Return(&wizardpb.UpdateManaBalanceResponse{Success: true}, nil)
+
+	expectSagaFinish(sqlMock, sagaCompleted, "completed")
+
+	_, err = scheduler.processNext(context.Background())
+	assert.NoError(t, err)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+	wizardMock.AssertExpectations(t)
+}
+
+func TestProcessNextBacksOffWhenWizardServiceUnavailable(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	wizardMock := &MockWizardServiceClient{}
+	scheduler := newTestScheduler(db, wizardMock)
+
+	// A profit needs the reward modifiers, which cannot be fetched
+	expectInvestmentClaimed(sqlMock, 1000, 1, 20.0)
+	wizardMock.On("GetRewardModifiers", mock.Anything, mock.Anything).
+		Return((*wizardpb.GetRewardModifiersResponse)(nil), status.Error(codes.Unavailable, "wizard service down"))
+	sqlMock.ExpectExec("UPDATE wizard_investments SET attempts = \\$1").
+		WithArgs(2, sqlmock.AnyArg(), sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	claimed, err := scheduler.processNext(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+	wizardMock.AssertNotCalled(t, "UpdateManaBalance", mock.Anything, mock.Anything)
+}
+
+func TestProcessNextFailsInvestmentAfterMaxAttempts(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	wizardMock := &MockWizardServiceClient{}
+	scheduler := newTestScheduler(db, wizardMock)
+
+	expectInvestmentClaimed(sqlMock, 1000, scheduler.maxAttempts-1, 20.0)
+	wizardMock.On("GetRewardModifiers", mock.Anything, mock.Anything).
+		Return((*wizardpb.GetRewardModifiersResponse)(nil), status.Error(codes.Unavailable, "wizard service down"))
+	sqlMock.ExpectExec("UPDATE wizard_investments SET status = 'failed'").
+		WithArgs(scheduler.maxAttempts, sqlmock.AnyArg(),
+			sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	claimed, err := scheduler.processNext(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestBackoff(t *testing.T) {
+	scheduler := newTestScheduler(nil, &MockWizardServiceClient{})
+	scheduler.retryBackoff = time.Minute
+	scheduler.maxBackoff = 10 * time.Minute
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{4, 8 * time.Minute},
+		{5, 10 * time.Minute},
+		{40, 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, scheduler.backoff(tt.attempts), "attempts %d", tt.attempts)
+	}
+}
+
+func TestScheduleInvestmentCompletion(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	wizardMock := &MockWizardServiceClient{}
+	scheduler := newTestScheduler(db, wizardMock)
+	clock := scheduler.clock.(*sim.FakeClock)
 
 	t.Run("Schedule future investment", func(t *testing.T) {
-		futureTime := time.Now().Add(1 * time.Hour)
-		scheduler.ScheduleInvestmentCompletion(1, futureTime)
+		scheduler.ScheduleInvestmentCompletion(1, testEpoch.Add(time.Hour))
 
 		assert.Contains(t, scheduler.active, int64(1))
+		assert.Len(t, scheduler.wake, 0)
+
+		clock.Advance(59 * time.Minute)
+		assert.Len(t, scheduler.wake, 0)
+
+		// The timer wakes the worker once the investment is due
+		clock.Advance(time.Minute)
+		assert.NotContains(t, scheduler.active, int64(1))
+		assert.Len(t, scheduler.wake, 1)
+		<-scheduler.wake
 	})
 
 	t.Run("Schedule past investment", func(t *testing.T) {
-		pastTime := time.Now().Add(-1 * time.Hour)
-
-		sqlMock.ExpectBegin()
-		sqlMock.ExpectQuery("SELECT (.+) FROM wizard_investments").
-			WithArgs(1).
-			WillReturnRows(sqlmock.NewRows([]string{
-				"wizard_id", "amount", "base_return_rate", "risk_level",
-			}).AddRow(1, 1000, 5.0, 2))
-		sqlMock.ExpectExec("UPDATE wizard_investments").
-			WillReturnResult(sqlmock.NewResult(1, 1))
-
-		// Mock wizard service call with flexible matching
-		wizardMock.On("UpdateManaBalance",
-			mock.Anything, // context
-			mock.MatchedBy(func(req *wizardpb.UpdateManaBalanceRequest) bool {
-				return req.WizardId == int64(1) && req.Reason == "Investment return"
-			})).Return(&wizardpb.UpdateManaBalanceResponse{
-			NewBalance: 1100,
-			Success:    true,
-		}, nil)
-
-		sqlMock.ExpectCommit()
-
-		scheduler.ScheduleInvestmentCompletion(1, pastTime)
-
-		// Give some time for the goroutine to complete
-		time.Sleep(100 * time.Millisecond)
-		assert.NoError(t, sqlMock.ExpectationsWereMet())
-		wizardMock.AssertExpectations(t)
+		pastTime := clock.Now().Add(-1 * time.Hour)
+		scheduler.ScheduleInvestmentCompletion(2, pastTime)
+		scheduler.ScheduleInvestmentCompletion(3, pastTime)
+
+		// Due investments wake the worker instead of getting a timer
+		assert.NotContains(t, scheduler.active, int64(2))
+		assert.Len(t, scheduler.wake, 1)
 	})
 }
 
-func TestCalculateReturnRate(t *testing.T) {
-	baseRate := 10.0
-	riskLevel := int32(3)
-
-	// Calculate return rate multiple times to test variance
-	for i := 0; i < 10; i++ {
-		actualRate := calculateReturnRate(baseRate, riskLevel)
-
-		// Should be within reasonable bounds (baseRate ± variance)
-		variance := float64(riskLevel) * 2.0
-		assert.GreaterOrEqual(t, actualRate, baseRate-variance-1.0) // Allow small margin
-		assert.LessOrEqual(t, actualRate, baseRate+variance+1.0)    // Allow small margin
-
-		// Should never go below -90%
-		assert.GreaterOrEqual(t, actualRate, -90.0)
+func sumPostings(postings []*wizardpb.LedgerPosting) int64 {
+	var sum int64
+	for _, posting := range postings {
+		sum += posting.Amount
 	}
+	return sum
 }
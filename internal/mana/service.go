@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/tectix/mysticfunds/internal/idempotency"
 	"github.com/tectix/mysticfunds/pkg/config"
 	"github.com/tectix/mysticfunds/pkg/logger"
+	"github.com/tectix/mysticfunds/pkg/sim"
 	pb "github.com/tectix/mysticfunds/proto/mana"
 	wizardpb "github.com/tectix/mysticfunds/proto/wizard"
 	"google.golang.org/grpc"
@@ -19,6 +21,7 @@ import (
 
 type ManaServiceImpl struct {
 	db           *sql.DB
+	idempotency  *idempotency.Store
 	cfg          *config.Config
 	log          logger.Logger
 	scheduler    *InvestmentScheduler
@@ -35,12 +38,19 @@ func NewManaServiceImpl(db *sql.DB, cfg *config.Config, log logger.Logger) *Mana
 	}
 	wizardClient := wizardpb.NewWizardServiceClient(wizardConn)
 
+	clock, rng, err := sim.FromConfig(cfg)
+	if err != nil {
+		log.Warn("Invalid simulation settings, using the system clock", "error", err)
+		clock, rng = sim.RealClock(), sim.SystemRNG()
+	}
+
 	// Create scheduler with wizard client
-	scheduler := NewInvestmentScheduler(db, log, wizardClient)
+	scheduler := NewInvestmentScheduler(db, log, wizardClient, clock, rng)
 	scheduler.Start()
 
 	return &ManaServiceImpl{
 		db:           db,
+		idempotency:  idempotency.NewStore(db, log),
 		cfg:          cfg,
 		log:          log,
 		scheduler:    scheduler,
@@ -49,12 +59,19 @@ func NewManaServiceImpl(db *sql.DB, cfg *config.Config, log logger.Logger) *Mana
 }
 
 func (s *ManaServiceImpl) TransferMana(ctx context.Context, req *pb.TransferManaRequest) (*pb.TransferManaResponse, error) {
+	return idempotency.Do(ctx, s.idempotency, "mana.TransferMana", req.IdempotencyKey, req, func() (*pb.TransferManaResponse, error) {
+		return s.transferMana(ctx, req)
+	})
+}
+
+func (s *ManaServiceImpl) transferMana(ctx context.Context, req *pb.TransferManaRequest) (*pb.TransferManaResponse, error) {
 	// Use wizard service to handle the transfer
 	transferResp, err := s.wizardClient.TransferMana(ctx, &wizardpb.TransferManaRequest{
-		FromWizardId: req.FromWizardId,
-		ToWizardId:   req.ToWizardId,
-		Amount:       req.Amount,
-		Reason:       "Mana service transfer",
+		FromWizardId:   req.FromWizardId,
+		ToWizardId:     req.ToWizardId,
+		Amount:         req.Amount,
+		Reason:         "Mana service transfer",
+		IdempotencyKey: req.IdempotencyKey,
 	})
 	if err != nil {
 		s.log.Error("Failed to transfer mana via wizard service", "error", err)
@@ -78,27 +95,29 @@ func (s *ManaServiceImpl) TransferMana(ctx context.Context, req *pb.TransferMana
 }
 
 func (s *ManaServiceImpl) CreateInvestment(ctx context.Context, req *pb.CreateInvestmentRequest) (*pb.CreateInvestmentResponse, error) {
-	// Validate investment type exists
-	var minAmount, maxAmount int64
-	var duration int32
-	err := s.db.QueryRowContext(ctx,
-		"SELECT min_amount, max_amount, duration_hours FROM investment_types WHERE id = $1",
-		req.InvestmentTypeId).Scan(&minAmount, &maxAmount, &duration)
+	return idempotency.Do(ctx, s.idempotency, "mana.CreateInvestment", req.IdempotencyKey, req, func() (*pb.CreateInvestmentResponse, error) {
+		return s.createInvestment(ctx, req)
+	})
+}
+
+func (s *ManaServiceImpl) createInvestment(ctx context.Context, req *pb.CreateInvestmentRequest) (*pb.CreateInvestmentResponse, error) {
+	rolloverMode, maxRollovers, err := validateRollover(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate investment type exists and take its current terms; the investment
+	// keeps this version even if the type is updated later
+	terms, err := currentTerms(ctx, s.db, req.InvestmentTypeId)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "Investment type not found: %v", err)
 	}
 
 	// Validate investment amount
-	if req.Amount < minAmount || (maxAmount > 0 && req.Amount > maxAmount) {
+	if !terms.allows(req.Amount) {
 		return nil, status.Errorf(codes.InvalidArgument, "Investment amount outside allowed range")
 	}
 
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to begin transaction: %v", err)
-	}
-	defer tx.Rollback()
-
 	// Check wizard's balance via wizard service
 	balanceResp, err := s.wizardClient.GetManaBalance(ctx, &wizardpb.GetManaBalanceRequest{
 		WizardId: req.WizardId,
@@ -111,33 +130,54 @@ func (s *ManaServiceImpl) CreateInvestment(ctx context.Context, req *pb.CreateIn
 		return nil, status.Errorf(codes.FailedPrecondition, "Insufficient mana balance")
 	}
 
-	// Deduct investment amount via wizard service
-	_, err = s.wizardClient.UpdateManaBalance(ctx, &wizardpb.UpdateManaBalanceRequest{
-		WizardId: req.WizardId,
-		Amount:   -req.Amount, // Negative amount to deduct
-		Reason:   "Investment creation",
-	})
+	// Record the pending investment and the saga that funds it before any mana
+	// moves, so a failure at any later point leaves a journal to resume from
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to update balance: %v", err)
+		return nil, status.Errorf(codes.Internal, "Failed to begin transaction: %v", err)
 	}
+	defer tx.Rollback()
 
-	// Create investment record
-	endTime := time.Now().Add(time.Duration(duration) * time.Hour)
+	startTime := s.scheduler.clock.Now()
+	endTime := startTime.Add(time.Duration(terms.durationHours) * time.Hour)
 	var investmentId int64
 	err = tx.QueryRowContext(ctx,
 		`INSERT INTO wizard_investments 
-		(wizard_id, investment_type_id, amount, end_time, status) 
-		VALUES ($1, $2, $3, $4, 'active') 
+		(wizard_id, investment_type_id, investment_type_version_id, amount, start_time, end_time, status,
+		 auto_rollover, rollover_mode, rollovers_remaining) 
+		VALUES ($1, $2, $3, $4, $5, $6, 'pending', $7, $8, $9) 
 		RETURNING id`,
-		req.WizardId, req.InvestmentTypeId, req.Amount, endTime).Scan(&investmentId)
+		req.WizardId, req.InvestmentTypeId, terms.versionId, req.Amount, startTime, endTime,
+		req.AutoRollover, rolloverMode, maxRollovers).Scan(&investmentId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to create investment: %v", err)
 	}
 
+	saga, err := s.scheduler.sagas.begin(ctx, tx, investmentId, sagaCreateInvestment,
+		principalRequest(req.WizardId, investmentId, req.Amount),
+		refundRequest(req.WizardId, investmentId, req.Amount))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to record investment saga: %v", err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to commit transaction: %v", err)
 	}
 
+	// Deduct investment amount via wizard service; the principal is held in the
+	// wizard's investment account until the investment matures
+	if err := s.scheduler.sagas.execute(ctx, saga); err != nil {
+		switch saga.state {
+		case sagaPending, sagaCompensating:
+			// The outcome is not known yet; the saga is resumed in the background
+			return nil, status.Errorf(codes.Unavailable, "Investment %d is pending: %v", investmentId, err)
+		case sagaFailed:
+			return nil, err
+		default:
+			return nil, status.Errorf(codes.Internal, "Failed to create investment: %v", err)
+		}
+	}
+
 	// Schedule investment completion
 	s.scheduler.ScheduleInvestmentCompletion(investmentId, endTime)
 
@@ -150,9 +190,11 @@ func (s *ManaServiceImpl) CreateInvestment(ctx context.Context, req *pb.CreateIn
 func (s *ManaServiceImpl) GetInvestments(ctx context.Context, req *pb.GetInvestmentsRequest) (*pb.GetInvestmentsResponse, error) {
 	rows, err := s.db.QueryContext(ctx,
 		`SELECT i.id, i.amount, i.start_time, i.end_time, i.status, 
-		        i.actual_return_rate, i.returned_amount, t.name, t.risk_level
+		        i.actual_return_rate, i.returned_amount, t.name, t.risk_level,
+		        i.failure_reason, i.penalty_amount, i.auto_rollover, i.rollover_mode, i.rollovers_remaining,
+		        i.rolled_over_from
 		 FROM wizard_investments i
-		 JOIN investment_types t ON i.investment_type_id = t.id
+		 JOIN investment_type_versions t ON i.investment_type_version_id = t.id
 		 WHERE i.wizard_id = $1
 		 ORDER BY i.created_at DESC`,
 		req.WizardId)
@@ -165,6 +207,8 @@ func (s *ManaServiceImpl) GetInvestments(ctx context.Context, req *pb.GetInvestm
 	for rows.Next() {
 		var inv pb.Investment
 		var returnRate, returnedAmount sql.NullFloat64
+		var failureReason sql.NullString
+		var penaltyAmount, rolledOverFrom sql.NullInt64
 		if err := rows.Scan(
 			&inv.Id,
 			&inv.Amount,
@@ -175,6 +219,12 @@ func (s *ManaServiceImpl) GetInvestments(ctx context.Context, req *pb.GetInvestm
 			&returnedAmount,
 			&inv.InvestmentType,
 			&inv.RiskLevel,
+			&failureReason,
+			&penaltyAmount,
+			&inv.AutoRollover,
+			&inv.RolloverMode,
+			&inv.RolloversRemaining,
+			&rolledOverFrom,
 		); err != nil {
 			return nil, status.Errorf(codes.Internal, "Failed to scan investment: %v", err)
 		}
@@ -185,6 +235,9 @@ func (s *ManaServiceImpl) GetInvestments(ctx context.Context, req *pb.GetInvestm
 		if returnedAmount.Valid {
 			inv.ReturnedAmount = int64(returnedAmount.Float64)
 		}
+		inv.FailureReason = failureReason.String
+		inv.PenaltyAmount = penaltyAmount.Int64
+		inv.RolledOverFrom = rolledOverFrom.Int64
 
 		investments = append(investments, &inv)
 	}
@@ -270,10 +323,9 @@ func (s *ManaServiceImpl) ListTransactions(ctx context.Context, req *pb.ListTran
 }
 
 func (s *ManaServiceImpl) GetInvestmentTypes(ctx context.Context, req *pb.GetInvestmentTypesRequest) (*pb.GetInvestmentTypesResponse, error) {
-	query := `SELECT id, name, description, min_amount, max_amount, duration_hours, base_return_rate, risk_level
-	          FROM investment_types WHERE is_active = true`
-	var args []interface{}
-	argCount := 0
+	query := "SELECT " + investmentTypeColumns + " FROM investment_types WHERE (is_active = true OR $1)"
+	args := []interface{}{req.IncludeInactive}
+	argCount := 1
 
 	// Add filters if provided
 	if req.MinAmount > 0 {
@@ -304,28 +356,11 @@ func (s *ManaServiceImpl) GetInvestmentTypes(ctx context.Context, req *pb.GetInv
 
 	var investmentTypes []*pb.InvestmentType
 	for rows.Next() {
-		var it pb.InvestmentType
-		var maxAmount sql.NullInt64
-
-		err := rows.Scan(
-			&it.Id,
-			&it.Name,
-			&it.Description,
-			&it.MinAmount,
-			&maxAmount,
-			&it.DurationHours,
-			&it.BaseReturnRate,
-			&it.RiskLevel,
-		)
+		it, err := scanInvestmentType(rows)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Failed to scan investment type: %v", err)
 		}
-
-		if maxAmount.Valid {
-			it.MaxAmount = maxAmount.Int64
-		}
-
-		investmentTypes = append(investmentTypes, &it)
+		investmentTypes = append(investmentTypes, it)
 	}
 
 	return &pb.GetInvestmentTypesResponse{
@@ -2,17 +2,23 @@ package mana
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tectix/mysticfunds/internal/idempotency"
 	"github.com/tectix/mysticfunds/pkg/config"
 	"github.com/tectix/mysticfunds/pkg/logger"
 	pb "github.com/tectix/mysticfunds/proto/mana"
 	wizardpb "github.com/tectix/mysticfunds/proto/wizard"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type testSetup struct {
@@ -40,11 +46,12 @@ func setupTest(t *testing.T) *testSetup {
 	wizardMock := &MockWizardServiceClient{}
 
 	// Create mock scheduler
-	scheduler := NewInvestmentScheduler(db, log, wizardMock)
+	scheduler := newTestScheduler(db, wizardMock)
 
 	// Create service with all dependencies
 	service := &ManaServiceImpl{
 		db:           db,
+		idempotency:  idempotency.NewStore(db, log),
 		cfg:          cfg,
 		log:          log,
 		scheduler:    scheduler,
@@ -101,6 +108,43 @@ func TestTransferMana(t *testing.T) {
 	setup.wizardMock.AssertExpectations(t)
 }
 
+func TestTransferManaReplaysIdempotencyKey(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	req := &pb.TransferManaRequest{
+		FromWizardId:   setup.testWizard1,
+		ToWizardId:     setup.testWizard2,
+		Amount:         100,
+		IdempotencyKey: "transfer-1",
+	}
+	hash := sha256.Sum256(mustMarshal(t, req))
+	stored := mustMarshal(t, &pb.TransferManaResponse{Success: true})
+
+	// The first attempt already completed, so the wizard service is not called again
+	setup.mock.ExpectExec("INSERT INTO idempotency_keys").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	setup.mock.ExpectQuery("SELECT request_hash, response FROM idempotency_keys").
+		WithArgs("mana.TransferMana", "transfer-1").
+		WillReturnRows(sqlmock.NewRows([]string{"request_hash", "response"}).
+			AddRow(hex.EncodeToString(hash[:]), stored))
+
+	resp, err := setup.service.TransferMana(setup.ctx, req)
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+	setup.wizardMock.AssertNotCalled(t, "TransferMana", mock.Anything, mock.Anything)
+}
+
+func mustMarshal(t *testing.T, m proto.Message) []byte {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		t.Fatalf("Failed to marshal %T: %v", m, err)
+	}
+	return data
+}
+
 func TestTransferManaFailure(t *testing.T) {
 	setup := setupTest(t)
 	defer setup.db.Close()
@@ -191,10 +235,10 @@ func TestCreateInvestment(t *testing.T) {
 	expectedBalance := int64(1000)
 
 	// Mock investment type query
-	setup.mock.ExpectQuery("SELECT min_amount, max_amount, duration_hours FROM investment_types").
+	setup.mock.ExpectQuery("SELECT (.+) FROM investment_types t JOIN investment_type_versions v").
 		WithArgs(investmentTypeId).
-		WillReturnRows(sqlmock.NewRows([]string{"min_amount", "max_amount", "duration_hours"}).
-			AddRow(100, 1000, 24))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "min_amount", "max_amount", "duration_hours"}).
+			AddRow(7, 100, 1000, 24))
 
 	// Mock wizard service balance check
 	setup.wizardMock.On("GetManaBalance", setup.ctx, &wizardpb.GetManaBalanceRequest{
@@ -208,17 +252,24 @@ func TestCreateInvestment(t *testing.T) {
 		WizardId: setup.testWizard1,
 		Amount:   -amount,
 		Reason:   "Investment creation",
+		CounterPostings: []*wizardpb.LedgerPosting{
+			{AccountType: "investment", Amount: amount},
+		},
+		Reference: "investment:1:principal",
 	}).Return(&wizardpb.UpdateManaBalanceResponse{
 		NewBalance: expectedBalance - amount,
 		Success:    true,
 	}, nil)
 
-	// Mock transaction begin/commit
+	// The investment and its saga are recorded before the debit
 	setup.mock.ExpectBegin()
 	setup.mock.ExpectQuery("INSERT INTO wizard_investments").
-		WithArgs(setup.testWizard1, investmentTypeId, amount, sqlmock.AnyArg()).
+		WithArgs(setup.testWizard1, investmentTypeId, int64(7), amount, testEpoch, testEpoch.Add(24*time.Hour),
+			false, rolloverCompound, int32(maxRollovers)).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	expectSagaBegin(setup.mock, sagaCreateInvestment, 1)
 	setup.mock.ExpectCommit()
+	expectSagaFinish(setup.mock, sagaCompleted, "active")
 
 	// Execute create investment
 	resp, err := setup.service.CreateInvestment(setup.ctx, &pb.CreateInvestmentRequest{
@@ -231,7 +282,7 @@ func TestCreateInvestment(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Equal(t, int64(1), resp.InvestmentId)
-	assert.Greater(t, resp.EndTime, int64(0))
+	assert.Equal(t, testEpoch.Add(24*time.Hour).Unix(), resp.EndTime)
 
 	// Verify all expectations
 	assert.NoError(t, setup.mock.ExpectationsWereMet())
@@ -247,13 +298,10 @@ func TestCreateInvestmentInsufficientBalance(t *testing.T) {
 	insufficientBalance := int64(100)
 
 	// Mock investment type query
-	setup.mock.ExpectQuery("SELECT min_amount, max_amount, duration_hours FROM investment_types").
+	setup.mock.ExpectQuery("SELECT (.+) FROM investment_types t JOIN investment_type_versions v").
 		WithArgs(investmentTypeId).
-		WillReturnRows(sqlmock.NewRows([]string{"min_amount", "max_amount", "duration_hours"}).
-			AddRow(100, 1000, 24))
-
-	// Mock transaction begin
-	setup.mock.ExpectBegin()
+		WillReturnRows(sqlmock.NewRows([]string{"id", "min_amount", "max_amount", "duration_hours"}).
+			AddRow(7, 100, 1000, 24))
 
 	// Mock wizard service balance check with insufficient balance
 	setup.wizardMock.On("GetManaBalance", setup.ctx, &wizardpb.GetManaBalanceRequest{
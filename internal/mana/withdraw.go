@@ -0,0 +1,220 @@
+package mana
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tectix/mysticfunds/internal/idempotency"
+	pb "github.com/tectix/mysticfunds/proto/mana"
+	wizardpb "github.com/tectix/mysticfunds/proto/wizard"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// penaltyTier is one step of an investment type's early exit penalty schedule
+type penaltyTier struct {
+	AfterPercent   float64 `json:"after_percent"`
+	PenaltyPercent float64 `json:"penalty_percent"`
+}
+
+// penaltySchedule is sorted by AfterPercent
+type penaltySchedule []penaltyTier
+
+func parsePenaltySchedule(data []byte) (penaltySchedule, error) {
+	var schedule penaltySchedule
+	if len(data) == 0 {
+		return schedule, nil
+	}
+	if err := json.Unmarshal(data, &schedule); err != nil {
+		return nil, err
+	}
+	if err := schedule.validate(); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(schedule, func(i, j int) bool { return schedule[i].AfterPercent < schedule[j].AfterPercent })
+
+	return schedule, nil
+}
+
+func (p penaltySchedule) validate() error {
+	for _, tier := range p {
+		if tier.AfterPercent < 0 || tier.AfterPercent > 100 {
+			return fmt.Errorf("after_percent %v is outside 0-100", tier.AfterPercent)
+		}
+		// A withdrawal always pays something back, or there would be nothing to post
+		if tier.PenaltyPercent < 0 || tier.PenaltyPercent >= 100 {
+			return fmt.Errorf("penalty_percent %v must be at least 0 and below 100", tier.PenaltyPercent)
+		}
+	}
+	return nil
+}
+
+// penaltyAt returns the penalty for withdrawing once elapsedPercent of the term has
+// passed: that of the last tier it has reached, or none before the first tier
+func (p penaltySchedule) penaltyAt(elapsedPercent float64) float64 {
+	penalty := 0.0
+	for _, tier := range p {
+		if elapsedPercent < tier.AfterPercent {
+			break
+		}
+		penalty = tier.PenaltyPercent
+	}
+	return penalty
+}
+
+func (p penaltySchedule) toProto() []*pb.EarlyExitPenalty {
+	penalties := make([]*pb.EarlyExitPenalty, 0, len(p))
+	for _, tier := range p {
+		penalties = append(penalties, &pb.EarlyExitPenalty{
+			AfterPercent:   tier.AfterPercent,
+			PenaltyPercent: tier.PenaltyPercent,
+		})
+	}
+	return penalties
+}
+
+// elapsedPercent is the share of the term between start and end that has passed at now
+func elapsedPercent(start, end, now time.Time) float64 {
+	term := end.Sub(start)
+	if term <= 0 {
+		return 100
+	}
+
+	elapsed := float64(now.Sub(start)) / float64(term) * 100
+	switch {
+	case elapsed < 0:
+		return 0
+	case elapsed > 100:
+		return 100
+	}
+	return elapsed
+}
+
+func (s *ManaServiceImpl) WithdrawInvestment(ctx context.Context, req *pb.WithdrawInvestmentRequest) (*pb.WithdrawInvestmentResponse, error) {
+	return idempotency.Do(ctx, s.idempotency, "mana.WithdrawInvestment", req.IdempotencyKey, req, func() (*pb.WithdrawInvestmentResponse, error) {
+		return s.withdrawInvestment(ctx, req)
+	})
+}
+
+// withdrawInvestment pays out an active investment before it matures, less the
+// penalty its type charges for the share of the term served. It locks the
+// investment's row, which the scheduler claims with SKIP LOCKED, so an investment
+// is either withdrawn or settled but never both.
+func (s *ManaServiceImpl) withdrawInvestment(ctx context.Context, req *pb.WithdrawInvestmentRequest) (*pb.WithdrawInvestmentResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var investment struct {
+		wizardId  int64
+		amount    int64
+		startTime time.Time
+		endTime   time.Time
+		status    string
+		penalties []byte
+	}
+	err = tx.QueryRowContext(ctx, `
+		SELECT i.wizard_id, i.amount, i.start_time, i.end_time, i.status, t.early_exit_penalties
+		FROM wizard_investments i
+		JOIN investment_type_versions t ON i.investment_type_version_id = t.id
+		WHERE i.id = $1
+		FOR UPDATE OF i`,
+		req.InvestmentId).Scan(
+		&investment.wizardId,
+		&investment.amount,
+		&investment.startTime,
+		&investment.endTime,
+		&investment.status,
+		&investment.penalties,
+	)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "Investment not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch investment: %v", err)
+	}
+
+	if investment.wizardId != req.WizardId {
+		return nil, status.Errorf(codes.PermissionDenied, "Investment belongs to another wizard")
+	}
+	if investment.status != "active" {
+		return nil, status.Errorf(codes.FailedPrecondition, "Investment is %s and cannot be withdrawn", investment.status)
+	}
+
+	now := s.scheduler.clock.Now()
+	if !now.Before(investment.endTime) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Investment has matured and is being settled")
+	}
+
+	schedule, err := parsePenaltySchedule(investment.penalties)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to read early exit penalties: %v", err)
+	}
+
+	penaltyPercent := schedule.penaltyAt(elapsedPercent(investment.startTime, investment.endTime, now))
+	penaltyAmount := int64(float64(investment.amount) * penaltyPercent / 100)
+	returnedAmount := investment.amount - penaltyAmount
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE wizard_investments
+		SET status = 'withdrawing',
+			actual_return_rate = $1,
+			returned_amount = $2,
+			penalty_amount = $3,
+			updated_at = NOW()
+		WHERE id = $4`,
+		-penaltyPercent, returnedAmount, penaltyAmount, req.InvestmentId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update investment: %v", err)
+	}
+
+	saga, err := s.scheduler.sagas.begin(ctx, tx, req.InvestmentId, sagaWithdrawInvestment,
+		withdrawalRequest(investment.wizardId, req.InvestmentId, investment.amount, returnedAmount), nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to record withdrawal saga: %v", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to commit transaction: %v", err)
+	}
+
+	s.scheduler.CancelInvestmentCompletion(req.InvestmentId)
+
+	if err := s.scheduler.sagas.execute(ctx, saga); err != nil {
+		switch saga.state {
+		case sagaPending:
+			// The payout is journaled and resumed in the background
+			return nil, status.Errorf(codes.Unavailable, "Withdrawal of investment %d is pending: %v", req.InvestmentId, err)
+		case sagaFailed:
+			return nil, err
+		default:
+			return nil, status.Errorf(codes.Internal, "Failed to withdraw investment: %v", err)
+		}
+	}
+
+	return &pb.WithdrawInvestmentResponse{
+		InvestmentId:   req.InvestmentId,
+		PenaltyPercent: penaltyPercent,
+		PenaltyAmount:  penaltyAmount,
+		ReturnedAmount: returnedAmount,
+		Status:         sagaInvestmentStatus[sagaWithdrawInvestment][saga.state],
+	}, nil
+}
+
+// withdrawalRequest releases a withdrawn investment's principal and pays out all
+// of it but the penalty, which goes to the system account
+func withdrawalRequest(wizardID, investmentID, principal, returnedAmount int64) *wizardpb.UpdateManaBalanceRequest {
+	return &wizardpb.UpdateManaBalanceRequest{
+		WizardId:        wizardID,
+		Amount:          returnedAmount,
+		Reason:          "Investment withdrawal",
+		CounterPostings: returnPostings(principal, returnedAmount),
+		Reference:       fmt.Sprintf("investment:%d:withdrawal", investmentID),
+	}
+}
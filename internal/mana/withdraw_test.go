@@ -0,0 +1,135 @@
+package mana
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	pb "github.com/tectix/mysticfunds/proto/mana"
+	wizardpb "github.com/tectix/mysticfunds/proto/wizard"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testPenalties = `[{"after_percent": 0, "penalty_percent": 10}, {"after_percent": 50, "penalty_percent": 5}, {"after_percent": 90, "penalty_percent": 2}]`
+
+// expectInvestmentLocked sets up investment 1 with a 1000 mana principal and a 24
+// hour term, locked for withdrawal
+func expectInvestmentLocked(sqlMock sqlmock.Sqlmock, wizardID int64, start time.Time, investmentStatus string) {
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery("SELECT (.+) FROM wizard_investments (.+) FOR UPDATE OF i").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"wizard_id", "amount", "start_time", "end_time", "status", "early_exit_penalties",
+		}).AddRow(wizardID, 1000, start, start.Add(24*time.Hour), investmentStatus, []byte(testPenalties)))
+}
+
+func TestWithdrawInvestmentAppliesPenalty(t *testing.T) {
+	setup := setupTest(t)
+	defer setup.db.Close()
+
+	// 18 of 24 hours have passed, which is in the 5% tier
+	setup.service.scheduler.ScheduleInvestmentCompletion(1, testEpoch.Add(6*time.Hour))
+	expectInvestmentLocked(setup.mock, setup.testWizard1, testEpoch.Add(-18*time.Hour), "active")
+	setup.mock.ExpectExec("UPDATE wizard_investments").
+		WithArgs(-5.0, 950, 50, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectSagaBegin(setup.mock, sagaWithdrawInvestment, 1)
+	setup.mock.ExpectCommit()
+
+	setup.wizardMock.On("UpdateManaBalance", mock.Anything,
+		mock.MatchedBy(func(req *wizardpb.UpdateManaBalanceRequest) bool {
+			return req.Reference == "investment:1:withdrawal" && req.Amount == 950 &&
+				sumPostings(req.CounterPostings) == -950
+		})).Return(&wizardpb.UpdateManaBalanceResponse{Success: true, NewBalance: 950}, nil)
+	expectSagaFinish(setup.mock, sagaCompleted, "withdrawn")
+
+	resp, err := setup.service.WithdrawInvestment(setup.ctx, &pb.WithdrawInvestmentRequest{
+		InvestmentId: 1,
+		WizardId:     setup.testWizard1,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5.0, resp.PenaltyPercent)
+	assert.Equal(t, int64(50), resp.PenaltyAmount)
+	assert.Equal(t, int64(950), resp.ReturnedAmount)
+	assert.Equal(t, "withdrawn", resp.Status)
+	assert.NotContains(t, setup.service.scheduler.active, int64(1))
+	assert.NoError(t, setup.mock.ExpectationsWereMet())
+	setup.wizardMock.AssertExpectations(t)
+}
+
+func TestWithdrawInvestmentRejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		wizardID int64
+		start    time.Time
+		status   string
+		code     codes.Code
+	}{
+		{"other wizard", 2, testEpoch.Add(-time.Hour), "active", codes.PermissionDenied},
+		{"already settling", 1, testEpoch.Add(-24 * time.Hour), "settling", codes.FailedPrecondition},
+		{"already withdrawn", 1, testEpoch.Add(-time.Hour), "withdrawn", codes.FailedPrecondition},
+		{"matured", 1, testEpoch.Add(-25 * time.Hour), "active", codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup := setupTest(t)
+			defer setup.db.Close()
+
+			expectInvestmentLocked(setup.mock, tt.wizardID, tt.start, tt.status)
+			setup.mock.ExpectRollback()
+
+			_, err := setup.service.WithdrawInvestment(setup.ctx, &pb.WithdrawInvestmentRequest{
+				InvestmentId: 1,
+				WizardId:     setup.testWizard1,
+			})
+
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.NoError(t, setup.mock.ExpectationsWereMet())
+			setup.wizardMock.AssertNotCalled(t, "UpdateManaBalance", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestPenaltySchedule(t *testing.T) {
+	schedule, err := parsePenaltySchedule([]byte(testPenalties))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		elapsed float64
+		want    float64
+	}{
+		{0, 10},
+		{49.9, 10},
+		{50, 5},
+		{89, 5},
+		{90, 2},
+		{100, 2},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, schedule.penaltyAt(tt.elapsed), "elapsed %v", tt.elapsed)
+	}
+
+	// Without a tier at zero, early withdrawals are free
+	schedule, err = parsePenaltySchedule([]byte(`[{"after_percent": 20, "penalty_percent": 3}]`))
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, schedule.penaltyAt(10))
+
+	_, err = parsePenaltySchedule([]byte(`[{"after_percent": 0, "penalty_percent": 100}]`))
+	assert.Error(t, err)
+	_, err = parsePenaltySchedule([]byte(`[{"after_percent": 120, "penalty_percent": 5}]`))
+	assert.Error(t, err)
+}
+
+func TestElapsedPercent(t *testing.T) {
+	start := testEpoch
+	end := start.Add(10 * time.Hour)
+
+	assert.Equal(t, 0.0, elapsedPercent(start, end, start.Add(-time.Hour)))
+	assert.Equal(t, 25.0, elapsedPercent(start, end, start.Add(150*time.Minute)))
+	assert.Equal(t, 100.0, elapsedPercent(start, end, end.Add(time.Hour)))
+}
@@ -0,0 +1,1136 @@
+package marketplace
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tectix/mysticfunds/internal/idempotency"
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/pkg/config"
+	"github.com/tectix/mysticfunds/pkg/logger"
+	pb "github.com/tectix/mysticfunds/proto/marketplace"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// levelRequirementPattern extracts the minimum level from requirement strings such as "Level 5+, Fire Affinity"
+var levelRequirementPattern = regexp.MustCompile(`(?i)level\s+(\d+)\+?`)
+
+type MarketplaceServiceImpl struct {
+	db          *sql.DB
+	idempotency *idempotency.Store
+	cfg         *config.Config
+	logger      logger.Logger
+	pb.UnimplementedMarketplaceServiceServer
+}
+
+func NewMarketplaceServiceImpl(db *sql.DB, cfg *config.Config, logger logger.Logger) *MarketplaceServiceImpl {
+	return &MarketplaceServiceImpl{
+		db:          db,
+		idempotency: idempotency.NewStore(db, logger),
+		cfg:         cfg,
+		logger:      logger,
+	}
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// Artifact methods
+
+const artifactColumns = `a.id, a.realm_id, r.name, a.name, a.description, a.lore, a.power_level, a.rarity,
+	a.mana_cost, a.artifact_type, a.special_abilities, a.requirements, a.image_url, a.is_available, a.created_at`
+
+func scanArtifact(row rowScanner) (*pb.Artifact, error) {
+	var artifact pb.Artifact
+	var abilities []string
+	var requirements, imageURL sql.NullString
+	var createdAt sql.NullTime
+
+	if err := row.Scan(
+		&artifact.Id, &artifact.RealmId, &artifact.RealmName, &artifact.Name, &artifact.Description,
+		&artifact.Lore, &artifact.PowerLevel, &artifact.Rarity, &artifact.ManaCost, &artifact.ArtifactType,
+		pq.Array(&abilities), &requirements, &imageURL, &artifact.IsAvailable, &createdAt); err != nil {
+		return nil, err
+	}
+
+	artifact.SpecialAbilities = abilities
+	if requirements.Valid {
+		artifact.Requirements = requirements.String
+	}
+	if imageURL.Valid {
+		artifact.ImageUrl = imageURL.String
+	}
+	if createdAt.Valid {
+		artifact.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+
+	return &artifact, nil
+}
+
+func (s *MarketplaceServiceImpl) GetArtifacts(ctx context.Context, req *pb.GetArtifactsRequest) (*pb.GetArtifactsResponse, error) {
+	limit, offset := pagination(req.PageSize, req.PageNumber)
+
+	where := " WHERE a.is_available = true"
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.Rarity != "" {
+		where += fmt.Sprintf(" AND a.rarity = $%d", argIndex)
+		args = append(args, req.Rarity)
+		argIndex++
+	}
+
+	if req.ArtifactType != "" {
+		where += fmt.Sprintf(" AND a.artifact_type = $%d", argIndex)
+		args = append(args, req.ArtifactType)
+		argIndex++
+	}
+
+	if req.MaxPowerLevel > 0 {
+		where += fmt.Sprintf(" AND a.power_level <= $%d", argIndex)
+		args = append(args, req.MaxPowerLevel)
+		argIndex++
+	}
+
+	if req.MaxManaCost > 0 {
+		where += fmt.Sprintf(" AND a.mana_cost <= $%d", argIndex)
+		args = append(args, req.MaxManaCost)
+		argIndex++
+	}
+
+	return s.queryArtifacts(ctx, where, args, argIndex, limit, offset)
+}
+
+func (s *MarketplaceServiceImpl) GetArtifactsByRealm(ctx context.Context, req *pb.GetArtifactsByRealmRequest) (*pb.GetArtifactsResponse, error) {
+	limit, offset := pagination(req.PageSize, req.PageNumber)
+
+	return s.queryArtifacts(ctx, " WHERE a.is_available = true AND a.realm_id = $1",
+		[]interface{}{req.RealmId}, 2, limit, offset)
+}
+
+func (s *MarketplaceServiceImpl) queryArtifacts(ctx context.Context, where string, args []interface{}, argIndex int, limit, offset int32) (*pb.GetArtifactsResponse, error) {
+	var totalCount int64
+	err := s.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM artifacts a"+where, args...).Scan(&totalCount)
+	if err != nil {
+		s.logger.Error("Failed to count artifacts", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get artifacts")
+	}
+
+	query := "SELECT " + artifactColumns + " FROM artifacts a JOIN realms r ON a.realm_id = r.id" + where +
+		fmt.Sprintf(" ORDER BY a.realm_id, a.mana_cost LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	rows, err := s.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		s.logger.Error("Failed to get artifacts", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get artifacts")
+	}
+	defer rows.Close()
+
+	var artifacts []*pb.Artifact
+	for rows.Next() {
+		artifact, err := scanArtifact(rows)
+		if err != nil {
+			s.logger.Error("Failed to scan artifact row", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get artifacts")
+		}
+		artifacts = append(artifacts, artifact)
+	}
+
+	return &pb.GetArtifactsResponse{
+		Artifacts:  artifacts,
+		TotalCount: totalCount,
+	}, nil
+}
+
+func (s *MarketplaceServiceImpl) PurchaseArtifact(ctx context.Context, req *pb.PurchaseArtifactRequest) (*pb.PurchaseResponse, error) {
+	return idempotency.DoTx(ctx, s.idempotency, "marketplace.PurchaseArtifact", req.IdempotencyKey, req, func(tx *sql.Tx) (*pb.PurchaseResponse, error) {
+		return s.purchaseArtifact(ctx, tx, req)
+	})
+}
+
+func (s *MarketplaceServiceImpl) purchaseArtifact(ctx context.Context, tx *sql.Tx, req *pb.PurchaseArtifactRequest) (*pb.PurchaseResponse, error) {
+	var name string
+	var manaCost int64
+	var isAvailable bool
+	var requirements sql.NullString
+	err := tx.QueryRowContext(ctx,
+		"SELECT name, mana_cost, is_available, requirements FROM artifacts WHERE id = $1",
+		req.ArtifactId).Scan(&name, &manaCost, &isAvailable, &requirements)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Artifact not found")
+		}
+		s.logger.Error("Failed to get artifact", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to purchase artifact")
+	}
+
+	if !isAvailable {
+		return nil, status.Error(codes.FailedPrecondition, "Artifact is not available for purchase")
+	}
+
+	var alreadyOwned bool
+	err = tx.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM wizard_artifacts WHERE wizard_id = $1 AND artifact_id = $2)",
+		req.WizardId, req.ArtifactId).Scan(&alreadyOwned)
+	if err != nil {
+		s.logger.Error("Failed to check artifact ownership", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to purchase artifact")
+	}
+
+	if alreadyOwned {
+		return nil, status.Error(codes.AlreadyExists, "Wizard already owns this artifact")
+	}
+
+	remaining, err := s.debitWizard(ctx, tx, req.WizardId, requiredLevel(requirements.String),
+		ledger.Transfer("artifact_purchase", "Purchased "+name, ledger.Wizard(req.WizardId), ledger.System(), manaCost))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO wizard_artifacts (wizard_id, artifact_id) VALUES ($1, $2)",
+		req.WizardId, req.ArtifactId)
+	if err != nil {
+		s.logger.Error("Failed to add artifact to inventory", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to purchase artifact")
+	}
+
+	if err := s.recordTransaction(ctx, tx, req.WizardId, "artifact", req.ArtifactId, manaCost, 0, "Purchased "+name); err != nil {
+		return nil, err
+	}
+
+	if err := s.logActivity(ctx, tx, req.WizardId, "artifact_purchased",
+		fmt.Sprintf("Purchased artifact: %s for %d mana", name, manaCost),
+		map[string]interface{}{"artifact_id": req.ArtifactId, "artifact_name": name, "mana_spent": manaCost}); err != nil {
+		return nil, err
+	}
+
+	return &pb.PurchaseResponse{
+		Success:       true,
+		Message:       fmt.Sprintf("Purchased %s", name),
+		ManaSpent:     manaCost,
+		RemainingMana: remaining,
+	}, nil
+}
+
+func (s *MarketplaceServiceImpl) GetWizardArtifacts(ctx context.Context, req *pb.GetWizardArtifactsRequest) (*pb.GetWizardArtifactsResponse, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT wa.id, wa.wizard_id, wa.acquired_at, wa.is_equipped, `+artifactColumns+`
+		 FROM wizard_artifacts wa
+		 JOIN artifacts a ON wa.artifact_id = a.id
+		 JOIN realms r ON a.realm_id = r.id
+		 WHERE wa.wizard_id = $1
+		 ORDER BY wa.acquired_at DESC`,
+		req.WizardId)
+	if err != nil {
+		s.logger.Error("Failed to get wizard artifacts", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get wizard artifacts")
+	}
+	defer rows.Close()
+
+	var artifacts []*pb.WizardArtifact
+	for rows.Next() {
+		var owned pb.WizardArtifact
+		var acquiredAt sql.NullTime
+		var artifact pb.Artifact
+		var abilities []string
+		var requirements, imageURL sql.NullString
+		var createdAt sql.NullTime
+
+		if err := rows.Scan(
+			&owned.Id, &owned.WizardId, &acquiredAt, &owned.IsEquipped,
+			&artifact.Id, &artifact.RealmId, &artifact.RealmName, &artifact.Name, &artifact.Description,
+			&artifact.Lore, &artifact.PowerLevel, &artifact.Rarity, &artifact.ManaCost, &artifact.ArtifactType,
+			pq.Array(&abilities), &requirements, &imageURL, &artifact.IsAvailable, &createdAt); err != nil {
+			s.logger.Error("Failed to scan wizard artifact row", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get wizard artifacts")
+		}
+
+		artifact.SpecialAbilities = abilities
+		if requirements.Valid {
+			artifact.Requirements = requirements.String
+		}
+		if imageURL.Valid {
+			artifact.ImageUrl = imageURL.String
+		}
+		if createdAt.Valid {
+			artifact.CreatedAt = timestamppb.New(createdAt.Time)
+		}
+		if acquiredAt.Valid {
+			owned.AcquiredAt = timestamppb.New(acquiredAt.Time)
+		}
+		owned.Artifact = &artifact
+
+		artifacts = append(artifacts, &owned)
+	}
+
+	return &pb.GetWizardArtifactsResponse{
+		Artifacts: artifacts,
+	}, nil
+}
+
+// EquipArtifact equips or unequips an owned artifact. Only one artifact of each
+// artifact_type can be equipped at a time, so equipping swaps out the previous one.
+func (s *MarketplaceServiceImpl) EquipArtifact(ctx context.Context, req *pb.EquipArtifactRequest) (*pb.EquipArtifactResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	var name, artifactType string
+	err = tx.QueryRowContext(ctx,
+		`SELECT a.name, a.artifact_type
+		 FROM wizard_artifacts wa
+		 JOIN artifacts a ON wa.artifact_id = a.id
+		 WHERE wa.wizard_id = $1 AND wa.artifact_id = $2
+		 FOR UPDATE OF wa`,
+		req.WizardId, req.ArtifactId).Scan(&name, &artifactType)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Wizard does not own this artifact")
+		}
+		s.logger.Error("Failed to get wizard artifact", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to equip artifact")
+	}
+
+	if req.Equip {
+		_, err = tx.ExecContext(ctx,
+			`UPDATE wizard_artifacts wa SET is_equipped = false
+			 FROM artifacts a
+			 WHERE wa.artifact_id = a.id AND wa.wizard_id = $1 AND a.artifact_type = $2 AND wa.artifact_id <> $3`,
+			req.WizardId, artifactType, req.ArtifactId)
+		if err != nil {
+			s.logger.Error("Failed to unequip artifacts of the same type", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to equip artifact")
+		}
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE wizard_artifacts SET is_equipped = $1 WHERE wizard_id = $2 AND artifact_id = $3",
+		req.Equip, req.WizardId, req.ArtifactId)
+	if err != nil {
+		s.logger.Error("Failed to update artifact equip state", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to equip artifact")
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to equip artifact")
+	}
+
+	message := fmt.Sprintf("%s equipped", name)
+	if !req.Equip {
+		message = fmt.Sprintf("%s unequipped", name)
+	}
+
+	return &pb.EquipArtifactResponse{
+		Success: true,
+		Message: message,
+	}, nil
+}
+
+// Scroll methods
+
+const scrollColumns = `sc.id, sc.name, sc.description, sc.skill_type, sc.skill_level, sc.mana_cost,
+	sc.prerequisites, sc.benefits, sc.rarity, sc.is_available, sc.created_at`
+
+func scanScroll(row rowScanner, extra ...interface{}) (*pb.Scroll, error) {
+	var scroll pb.Scroll
+	var prerequisites []string
+	var createdAt sql.NullTime
+
+	dest := append(extra,
+		&scroll.Id, &scroll.Name, &scroll.Description, &scroll.SkillType, &scroll.SkillLevel,
+		&scroll.ManaCost, pq.Array(&prerequisites), &scroll.Benefits, &scroll.Rarity,
+		&scroll.IsAvailable, &createdAt)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	scroll.Prerequisites = prerequisites
+	if createdAt.Valid {
+		scroll.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+
+	return &scroll, nil
+}
+
+func (s *MarketplaceServiceImpl) GetScrolls(ctx context.Context, req *pb.GetScrollsRequest) (*pb.GetScrollsResponse, error) {
+	limit, offset := pagination(req.PageSize, req.PageNumber)
+
+	where := " WHERE sc.is_available = true"
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.SkillType != "" {
+		where += fmt.Sprintf(" AND sc.skill_type = $%d", argIndex)
+		args = append(args, req.SkillType)
+		argIndex++
+	}
+
+	if req.Rarity != "" {
+		where += fmt.Sprintf(" AND sc.rarity = $%d", argIndex)
+		args = append(args, req.Rarity)
+		argIndex++
+	}
+
+	if req.MaxSkillLevel > 0 {
+		where += fmt.Sprintf(" AND sc.skill_level <= $%d", argIndex)
+		args = append(args, req.MaxSkillLevel)
+		argIndex++
+	}
+
+	if req.MaxManaCost > 0 {
+		where += fmt.Sprintf(" AND sc.mana_cost <= $%d", argIndex)
+		args = append(args, req.MaxManaCost)
+		argIndex++
+	}
+
+	var totalCount int64
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM scrolls sc"+where, args...).Scan(&totalCount)
+	if err != nil {
+		s.logger.Error("Failed to count scrolls", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get scrolls")
+	}
+
+	query := "SELECT " + scrollColumns + " FROM scrolls sc" + where +
+		fmt.Sprintf(" ORDER BY sc.skill_level, sc.mana_cost LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	rows, err := s.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		s.logger.Error("Failed to get scrolls", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get scrolls")
+	}
+	defer rows.Close()
+
+	var scrolls []*pb.Scroll
+	for rows.Next() {
+		scroll, err := scanScroll(rows)
+		if err != nil {
+			s.logger.Error("Failed to scan scroll row", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get scrolls")
+		}
+		scrolls = append(scrolls, scroll)
+	}
+
+	return &pb.GetScrollsResponse{
+		Scrolls:    scrolls,
+		TotalCount: totalCount,
+	}, nil
+}
+
+func (s *MarketplaceServiceImpl) PurchaseScroll(ctx context.Context, req *pb.PurchaseScrollRequest) (*pb.PurchaseResponse, error) {
+	return idempotency.DoTx(ctx, s.idempotency, "marketplace.PurchaseScroll", req.IdempotencyKey, req, func(tx *sql.Tx) (*pb.PurchaseResponse, error) {
+		return s.purchaseScroll(ctx, tx, req)
+	})
+}
+
+func (s *MarketplaceServiceImpl) purchaseScroll(ctx context.Context, tx *sql.Tx, req *pb.PurchaseScrollRequest) (*pb.PurchaseResponse, error) {
+	var name string
+	var manaCost int64
+	var isAvailable bool
+	var prerequisites []string
+	err := tx.QueryRowContext(ctx,
+		"SELECT name, mana_cost, is_available, prerequisites FROM scrolls WHERE id = $1",
+		req.ScrollId).Scan(&name, &manaCost, &isAvailable, pq.Array(&prerequisites))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Scroll not found")
+		}
+		s.logger.Error("Failed to get scroll", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to purchase scroll")
+	}
+
+	if !isAvailable {
+		return nil, status.Error(codes.FailedPrecondition, "Scroll is not available for purchase")
+	}
+
+	// Collect the scrolls the wizard already knows to check ownership and prerequisites
+	rows, err := tx.QueryContext(ctx,
+		`SELECT sc.id, sc.name FROM wizard_scrolls ws
+		 JOIN scrolls sc ON ws.scroll_id = sc.id
+		 WHERE ws.wizard_id = $1`,
+		req.WizardId)
+	if err != nil {
+		s.logger.Error("Failed to get learned scrolls", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to purchase scroll")
+	}
+	learned := make(map[string]bool)
+	alreadyLearned := false
+	for rows.Next() {
+		var learnedID int64
+		var learnedName string
+		if err := rows.Scan(&learnedID, &learnedName); err != nil {
+			rows.Close()
+			s.logger.Error("Failed to scan learned scroll", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to purchase scroll")
+		}
+		if learnedID == req.ScrollId {
+			alreadyLearned = true
+		}
+		learned[strings.TrimPrefix(learnedName, "Scroll of ")] = true
+	}
+	rows.Close()
+
+	if alreadyLearned {
+		return nil, status.Error(codes.AlreadyExists, "Wizard has already learned this scroll")
+	}
+
+	var missing []string
+	for _, prerequisite := range prerequisites {
+		if !learned[prerequisite] {
+			missing = append(missing, prerequisite)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, status.Error(codes.FailedPrecondition,
+			fmt.Sprintf("Missing prerequisite scrolls: %s", strings.Join(missing, ", ")))
+	}
+
+	remaining, err := s.debitWizard(ctx, tx, req.WizardId, 0,
+		ledger.Transfer("scroll_purchase", "Purchased "+name, ledger.Wizard(req.WizardId), ledger.System(), manaCost))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO wizard_scrolls (wizard_id, scroll_id) VALUES ($1, $2)",
+		req.WizardId, req.ScrollId)
+	if err != nil {
+		s.logger.Error("Failed to add scroll to wizard", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to purchase scroll")
+	}
+
+	if err := s.recordTransaction(ctx, tx, req.WizardId, "scroll", req.ScrollId, manaCost, 0, "Purchased "+name); err != nil {
+		return nil, err
+	}
+
+	if err := s.logActivity(ctx, tx, req.WizardId, "scroll_purchased",
+		fmt.Sprintf("Learned scroll: %s for %d mana", name, manaCost),
+		map[string]interface{}{"scroll_id": req.ScrollId, "scroll_name": name, "mana_spent": manaCost}); err != nil {
+		return nil, err
+	}
+
+	return &pb.PurchaseResponse{
+		Success:       true,
+		Message:       fmt.Sprintf("Purchased %s", name),
+		ManaSpent:     manaCost,
+		RemainingMana: remaining,
+	}, nil
+}
+
+func (s *MarketplaceServiceImpl) GetWizardScrolls(ctx context.Context, req *pb.GetWizardScrollsRequest) (*pb.GetWizardScrollsResponse, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT ws.id, ws.wizard_id, ws.learned_at, ws.mastery_level, `+scrollColumns+`
+		 FROM wizard_scrolls ws
+		 JOIN scrolls sc ON ws.scroll_id = sc.id
+		 WHERE ws.wizard_id = $1
+		 ORDER BY ws.learned_at DESC`,
+		req.WizardId)
+	if err != nil {
+		s.logger.Error("Failed to get wizard scrolls", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get wizard scrolls")
+	}
+	defer rows.Close()
+
+	var scrolls []*pb.WizardScroll
+	for rows.Next() {
+		var learned pb.WizardScroll
+		var learnedAt sql.NullTime
+
+		scroll, err := scanScroll(rows, &learned.Id, &learned.WizardId, &learnedAt, &learned.MasteryLevel)
+		if err != nil {
+			s.logger.Error("Failed to scan wizard scroll row", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get wizard scrolls")
+		}
+
+		if learnedAt.Valid {
+			learned.LearnedAt = timestamppb.New(learnedAt.Time)
+		}
+		learned.Scroll = scroll
+
+		scrolls = append(scrolls, &learned)
+	}
+
+	return &pb.GetWizardScrollsResponse{
+		Scrolls: scrolls,
+	}, nil
+}
+
+// Spell methods
+
+const spellColumns = `sp.id, sp.name, sp.description, sp.spell_school, sp.element, sp.power_level,
+	sp.mana_cost_to_learn, sp.mana_cost_to_cast, sp.requirements, sp.effects, sp.rarity, sp.created_at`
+
+func scanSpell(row rowScanner, extra ...interface{}) (*pb.Spell, error) {
+	var spell pb.Spell
+	var element, requirements sql.NullString
+	var createdAt sql.NullTime
+
+	dest := append(extra,
+		&spell.Id, &spell.Name, &spell.Description, &spell.SpellSchool, &element, &spell.PowerLevel,
+		&spell.ManaCostToLearn, &spell.ManaCostToCast, &requirements, &spell.Effects, &spell.Rarity, &createdAt)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	if element.Valid {
+		spell.Element = element.String
+	}
+	if requirements.Valid {
+		spell.Requirements = requirements.String
+	}
+	if createdAt.Valid {
+		spell.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+
+	return &spell, nil
+}
+
+func (s *MarketplaceServiceImpl) GetSpells(ctx context.Context, req *pb.GetSpellsRequest) (*pb.GetSpellsResponse, error) {
+	limit, offset := pagination(req.PageSize, req.PageNumber)
+
+	where := " WHERE 1=1"
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.SpellSchool != "" {
+		where += fmt.Sprintf(" AND sp.spell_school = $%d", argIndex)
+		args = append(args, req.SpellSchool)
+		argIndex++
+	}
+
+	if req.Element != "" {
+		where += fmt.Sprintf(" AND sp.element = $%d", argIndex)
+		args = append(args, req.Element)
+		argIndex++
+	}
+
+	if req.Rarity != "" {
+		where += fmt.Sprintf(" AND sp.rarity = $%d", argIndex)
+		args = append(args, req.Rarity)
+		argIndex++
+	}
+
+	if req.MaxPowerLevel > 0 {
+		where += fmt.Sprintf(" AND sp.power_level <= $%d", argIndex)
+		args = append(args, req.MaxPowerLevel)
+		argIndex++
+	}
+
+	if req.MaxManaCost > 0 {
+		where += fmt.Sprintf(" AND sp.mana_cost_to_learn <= $%d", argIndex)
+		args = append(args, req.MaxManaCost)
+		argIndex++
+	}
+
+	var totalCount int64
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM spells sp"+where, args...).Scan(&totalCount)
+	if err != nil {
+		s.logger.Error("Failed to count spells", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get spells")
+	}
+
+	query := "SELECT " + spellColumns + " FROM spells sp" + where +
+		fmt.Sprintf(" ORDER BY sp.power_level, sp.mana_cost_to_learn LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	rows, err := s.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		s.logger.Error("Failed to get spells", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get spells")
+	}
+	defer rows.Close()
+
+	var spells []*pb.Spell
+	for rows.Next() {
+		spell, err := scanSpell(rows)
+		if err != nil {
+			s.logger.Error("Failed to scan spell row", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get spells")
+		}
+		spells = append(spells, spell)
+	}
+
+	return &pb.GetSpellsResponse{
+		Spells:     spells,
+		TotalCount: totalCount,
+	}, nil
+}
+
+func (s *MarketplaceServiceImpl) GetAvailableTeachers(ctx context.Context, req *pb.GetAvailableTeachersRequest) (*pb.GetAvailableTeachersResponse, error) {
+	query := `SELECT t.wizard_id, w.name, t.teaching_price, COALESCE(t.max_students, 0), t.students_taught, ` + spellColumns + `
+	          FROM wizard_spell_teaching t
+	          JOIN wizards w ON t.wizard_id = w.id
+	          JOIN spells sp ON t.spell_id = sp.id
+	          WHERE t.spell_id = $1 AND t.can_teach = true
+	          AND (t.max_students IS NULL OR t.students_taught < t.max_students)`
+	args := []interface{}{req.SpellId}
+
+	if req.MaxPrice > 0 {
+		query += " AND t.teaching_price <= $2"
+		args = append(args, req.MaxPrice)
+	}
+
+	query += " ORDER BY t.teaching_price, t.students_taught DESC"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		s.logger.Error("Failed to get spell teachers", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get available teachers")
+	}
+	defer rows.Close()
+
+	var teachers []*pb.SpellTeacher
+	for rows.Next() {
+		var teacher pb.SpellTeacher
+		spell, err := scanSpell(rows, &teacher.WizardId, &teacher.WizardName, &teacher.TeachingPrice,
+			&teacher.MaxStudents, &teacher.StudentsTaught)
+		if err != nil {
+			s.logger.Error("Failed to scan spell teacher row", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get available teachers")
+		}
+		teacher.SpellId = spell.Id
+		teacher.Spell = spell
+
+		teachers = append(teachers, &teacher)
+	}
+
+	return &pb.GetAvailableTeachersResponse{
+		Teachers: teachers,
+	}, nil
+}
+
+// LearnSpellFromWizard pays the teacher's price from the student to the teacher and
+// records the spell as learned, all within a single transaction.
+func (s *MarketplaceServiceImpl) LearnSpellFromWizard(ctx context.Context, req *pb.LearnSpellRequest) (*pb.LearnSpellResponse, error) {
+	if req.StudentWizardId == req.TeacherWizardId {
+		return nil, status.Error(codes.InvalidArgument, "A wizard cannot learn a spell from themselves")
+	}
+
+	return idempotency.DoTx(ctx, s.idempotency, "marketplace.LearnSpellFromWizard", req.IdempotencyKey, req, func(tx *sql.Tx) (*pb.LearnSpellResponse, error) {
+		return s.learnSpellFromWizard(ctx, tx, req)
+	})
+}
+
+func (s *MarketplaceServiceImpl) learnSpellFromWizard(ctx context.Context, tx *sql.Tx, req *pb.LearnSpellRequest) (*pb.LearnSpellResponse, error) {
+	// Lock the teaching offer so concurrent students cannot exceed max_students
+	var spellName string
+	var requirements sql.NullString
+	var price int64
+	var maxStudents sql.NullInt32
+	var studentsTaught int32
+	var canTeach bool
+	err := tx.QueryRowContext(ctx,
+		`SELECT sp.name, sp.requirements, t.teaching_price, t.max_students, t.students_taught, t.can_teach
+		 FROM wizard_spell_teaching t
+		 JOIN spells sp ON t.spell_id = sp.id
+		 WHERE t.wizard_id = $1 AND t.spell_id = $2
+		 FOR UPDATE OF t`,
+		req.TeacherWizardId, req.SpellId).Scan(&spellName, &requirements, &price, &maxStudents, &studentsTaught, &canTeach)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Teacher is not offering this spell")
+		}
+		s.logger.Error("Failed to get teaching offer", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to learn spell")
+	}
+
+	if !canTeach {
+		return nil, status.Error(codes.FailedPrecondition, "Teacher is no longer teaching this spell")
+	}
+
+	if maxStudents.Valid && studentsTaught >= maxStudents.Int32 {
+		return nil, status.Error(codes.FailedPrecondition, "Teacher has no open student slots for this spell")
+	}
+
+	var alreadyKnown bool
+	err = tx.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM wizard_spells WHERE wizard_id = $1 AND spell_id = $2)",
+		req.StudentWizardId, req.SpellId).Scan(&alreadyKnown)
+	if err != nil {
+		s.logger.Error("Failed to check known spells", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to learn spell")
+	}
+
+	if alreadyKnown {
+		return nil, status.Error(codes.AlreadyExists, "Wizard already knows this spell")
+	}
+
+	// Lock both wizards in id order so opposing lessons cannot deadlock
+	if err := s.lockWizards(ctx, tx, req.StudentWizardId, req.TeacherWizardId); err != nil {
+		return nil, err
+	}
+
+	// The teacher is paid in the same ledger entry that debits the student
+	_, err = s.debitWizard(ctx, tx, req.StudentWizardId, requiredLevel(requirements.String),
+		ledger.Transfer("spell_learning", "Learned "+spellName,
+			ledger.Wizard(req.StudentWizardId), ledger.Wizard(req.TeacherWizardId), price))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO wizard_spells (wizard_id, spell_id, learned_from_wizard_id) VALUES ($1, $2, $3)",
+		req.StudentWizardId, req.SpellId, req.TeacherWizardId)
+	if err != nil {
+		s.logger.Error("Failed to add spell to wizard", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to learn spell")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE wizard_spell_teaching SET students_taught = students_taught + 1 WHERE wizard_id = $1 AND spell_id = $2",
+		req.TeacherWizardId, req.SpellId)
+	if err != nil {
+		s.logger.Error("Failed to update students taught", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to learn spell")
+	}
+
+	if err := s.recordTransaction(ctx, tx, req.StudentWizardId, "spell_learning", req.SpellId, price,
+		req.TeacherWizardId, "Learned "+spellName); err != nil {
+		return nil, err
+	}
+
+	if err := s.logActivity(ctx, tx, req.StudentWizardId, "spell_learned",
+		fmt.Sprintf("Learned spell: %s for %d mana", spellName, price),
+		map[string]interface{}{"spell_id": req.SpellId, "spell_name": spellName, "teacher_wizard_id": req.TeacherWizardId, "mana_spent": price}); err != nil {
+		return nil, err
+	}
+
+	if err := s.logActivity(ctx, tx, req.TeacherWizardId, "spell_taught",
+		fmt.Sprintf("Taught spell: %s for %d mana", spellName, price),
+		map[string]interface{}{"spell_id": req.SpellId, "spell_name": spellName, "student_wizard_id": req.StudentWizardId, "mana_earned": price}); err != nil {
+		return nil, err
+	}
+
+	return &pb.LearnSpellResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("Learned %s", spellName),
+		ManaSpent: price,
+	}, nil
+}
+
+func (s *MarketplaceServiceImpl) OfferSpellTeaching(ctx context.Context, req *pb.OfferSpellTeachingRequest) (*pb.OfferSpellTeachingResponse, error) {
+	if req.TeachingPrice <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Teaching price must be positive")
+	}
+	if req.MaxStudents < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Max students cannot be negative")
+	}
+
+	var knowsSpell bool
+	err := s.db.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM wizard_spells WHERE wizard_id = $1 AND spell_id = $2)",
+		req.WizardId, req.SpellId).Scan(&knowsSpell)
+	if err != nil {
+		s.logger.Error("Failed to check known spells", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to offer spell teaching")
+	}
+
+	if !knowsSpell {
+		return nil, status.Error(codes.FailedPrecondition, "Wizard can only teach spells they know")
+	}
+
+	// 0 means unlimited, which is stored as NULL
+	var maxStudents sql.NullInt32
+	if req.MaxStudents > 0 {
+		maxStudents = sql.NullInt32{Int32: req.MaxStudents, Valid: true}
+	}
+
+	_, err = s.db.ExecContext(ctx,
+		`INSERT INTO wizard_spell_teaching (wizard_id, spell_id, teaching_price, max_students)
+		 VALUES ($1, $2, $3, $4)
+		 ON CONFLICT (wizard_id, spell_id) DO UPDATE
+		 SET teaching_price = EXCLUDED.teaching_price, max_students = EXCLUDED.max_students, can_teach = true`,
+		req.WizardId, req.SpellId, req.TeachingPrice, maxStudents)
+	if err != nil {
+		s.logger.Error("Failed to offer spell teaching", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to offer spell teaching")
+	}
+
+	return &pb.OfferSpellTeachingResponse{
+		Success: true,
+		Message: fmt.Sprintf("Now teaching for %d mana", req.TeachingPrice),
+	}, nil
+}
+
+func (s *MarketplaceServiceImpl) GetWizardSpells(ctx context.Context, req *pb.GetWizardSpellsRequest) (*pb.GetWizardSpellsResponse, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT ws.id, ws.wizard_id, ws.learned_at, ws.learned_from_wizard_id, teacher.name,
+		        ws.mastery_level, ws.times_cast, `+spellColumns+`
+		 FROM wizard_spells ws
+		 JOIN spells sp ON ws.spell_id = sp.id
+		 LEFT JOIN wizards teacher ON ws.learned_from_wizard_id = teacher.id
+		 WHERE ws.wizard_id = $1
+		 ORDER BY ws.learned_at DESC`,
+		req.WizardId)
+	if err != nil {
+		s.logger.Error("Failed to get wizard spells", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get wizard spells")
+	}
+	defer rows.Close()
+
+	var spells []*pb.WizardSpell
+	for rows.Next() {
+		var known pb.WizardSpell
+		var learnedAt sql.NullTime
+		var teacherID sql.NullInt64
+		var teacherName sql.NullString
+
+		spell, err := scanSpell(rows, &known.Id, &known.WizardId, &learnedAt, &teacherID, &teacherName,
+			&known.MasteryLevel, &known.TimesCast)
+		if err != nil {
+			s.logger.Error("Failed to scan wizard spell row", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get wizard spells")
+		}
+
+		if learnedAt.Valid {
+			known.LearnedAt = timestamppb.New(learnedAt.Time)
+		}
+		if teacherID.Valid {
+			known.LearnedFromWizardId = teacherID.Int64
+		}
+		if teacherName.Valid {
+			known.LearnedFromWizardName = teacherName.String
+		}
+		known.Spell = spell
+
+		spells = append(spells, &known)
+	}
+
+	return &pb.GetWizardSpellsResponse{
+		Spells: spells,
+	}, nil
+}
+
+// Transaction history
+
+func (s *MarketplaceServiceImpl) GetMarketplaceTransactions(ctx context.Context, req *pb.GetMarketplaceTransactionsRequest) (*pb.GetMarketplaceTransactionsResponse, error) {
+	limit, offset := pagination(req.PageSize, req.PageNumber)
+
+	where := " WHERE 1=1"
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.WizardId > 0 {
+		where += fmt.Sprintf(" AND (mt.buyer_wizard_id = $%d OR mt.seller_wizard_id = $%d)", argIndex, argIndex)
+		args = append(args, req.WizardId)
+		argIndex++
+	}
+
+	if req.TransactionType != "" {
+		where += fmt.Sprintf(" AND mt.transaction_type = $%d", argIndex)
+		args = append(args, req.TransactionType)
+		argIndex++
+	}
+
+	var totalCount int64
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM marketplace_transactions mt"+where, args...).Scan(&totalCount)
+	if err != nil {
+		s.logger.Error("Failed to count marketplace transactions", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get marketplace transactions")
+	}
+
+	query := `SELECT mt.id, mt.buyer_wizard_id, buyer.name, mt.transaction_type, mt.item_id,
+	          COALESCE(CASE mt.transaction_type
+	              WHEN 'artifact' THEN a.name
+	              WHEN 'scroll' THEN sc.name
+	              ELSE sp.name END, ''),
+	          mt.mana_spent, mt.seller_wizard_id, seller.name, mt.transaction_date, mt.notes
+	          FROM marketplace_transactions mt
+	          JOIN wizards buyer ON mt.buyer_wizard_id = buyer.id
+	          LEFT JOIN wizards seller ON mt.seller_wizard_id = seller.id
+	          LEFT JOIN artifacts a ON mt.transaction_type = 'artifact' AND mt.item_id = a.id
+	          LEFT JOIN scrolls sc ON mt.transaction_type = 'scroll' AND mt.item_id = sc.id
+	          LEFT JOIN spells sp ON mt.transaction_type = 'spell_learning' AND mt.item_id = sp.id` + where +
+		fmt.Sprintf(" ORDER BY mt.transaction_date DESC LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+
+	rows, err := s.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		s.logger.Error("Failed to get marketplace transactions", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get marketplace transactions")
+	}
+	defer rows.Close()
+
+	var transactions []*pb.MarketplaceTransaction
+	for rows.Next() {
+		var transaction pb.MarketplaceTransaction
+		var sellerID sql.NullInt64
+		var sellerName, notes sql.NullString
+		var transactionDate sql.NullTime
+
+		if err := rows.Scan(
+			&transaction.Id, &transaction.BuyerWizardId, &transaction.BuyerWizardName, &transaction.TransactionType,
+			&transaction.ItemId, &transaction.ItemName, &transaction.ManaSpent, &sellerID, &sellerName,
+			&transactionDate, &notes); err != nil {
+			s.logger.Error("Failed to scan marketplace transaction row", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get marketplace transactions")
+		}
+
+		if sellerID.Valid {
+			transaction.SellerWizardId = sellerID.Int64
+		}
+		if sellerName.Valid {
+			transaction.SellerWizardName = sellerName.String
+		}
+		if transactionDate.Valid {
+			transaction.TransactionDate = timestamppb.New(transactionDate.Time)
+		}
+		if notes.Valid {
+			transaction.Notes = notes.String
+		}
+
+		transactions = append(transactions, &transaction)
+	}
+
+	return &pb.GetMarketplaceTransactionsResponse{
+		Transactions: transactions,
+		TotalCount:   totalCount,
+	}, nil
+}
+
+// Helpers
+
+// debitWizard locks the wizard row, validates the level requirement and balance,
+// and posts entry, which must debit the wizard. It returns the remaining balance.
+func (s *MarketplaceServiceImpl) debitWizard(ctx context.Context, tx *sql.Tx, wizardID int64, minLevel int32, entry ledger.Entry) (int64, error) {
+	amount := -entry.Amount(ledger.Wizard(wizardID))
+
+	var balance int64
+	var level int32
+	err := tx.QueryRowContext(ctx,
+		"SELECT mana_balance, level FROM wizards WHERE id = $1 FOR UPDATE",
+		wizardID).Scan(&balance, &level)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, status.Error(codes.NotFound, "Wizard not found")
+		}
+		s.logger.Error("Failed to lock wizard", "error", err)
+		return 0, status.Error(codes.Internal, "Failed to debit mana")
+	}
+
+	if level < minLevel {
+		return 0, status.Error(codes.FailedPrecondition,
+			fmt.Sprintf("Wizard level %d is below required level %d", level, minLevel))
+	}
+
+	if balance < amount {
+		return 0, status.Error(codes.FailedPrecondition, "Insufficient mana balance")
+	}
+
+	if amount == 0 {
+		return balance, nil
+	}
+
+	posted, err := ledger.Post(ctx, tx, entry)
+	if err != nil {
+		s.logger.Error("Failed to debit mana", "error", err)
+		return 0, status.Error(codes.Internal, "Failed to debit mana")
+	}
+
+	return posted.Balances[wizardID], nil
+}
+
+// lockWizards takes row locks on the given wizards in ascending id order
+func (s *MarketplaceServiceImpl) lockWizards(ctx context.Context, tx *sql.Tx, first, second int64) error {
+	if first > second {
+		first, second = second, first
+	}
+
+	for _, id := range []int64{first, second} {
+		var locked int64
+		err := tx.QueryRowContext(ctx, "SELECT id FROM wizards WHERE id = $1 FOR UPDATE", id).Scan(&locked)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return status.Error(codes.NotFound, "Wizard not found")
+			}
+			s.logger.Error("Failed to lock wizard", "error", err)
+			return status.Error(codes.Internal, "Failed to lock wizards")
+		}
+	}
+
+	return nil
+}
+
+func (s *MarketplaceServiceImpl) recordTransaction(ctx context.Context, tx *sql.Tx, buyerID int64, transactionType string, itemID, manaSpent, sellerID int64, notes string) error {
+	var seller sql.NullInt64
+	if sellerID > 0 {
+		seller = sql.NullInt64{Int64: sellerID, Valid: true}
+	}
+
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO marketplace_transactions (buyer_wizard_id, transaction_type, item_id, mana_spent, seller_wizard_id, notes)
+		 VALUES ($1, $2, $3, $4, $5, $6)`,
+		buyerID, transactionType, itemID, manaSpent, seller, notes)
+	if err != nil {
+		s.logger.Error("Failed to record marketplace transaction", "error", err)
+		return status.Error(codes.Internal, "Failed to record marketplace transaction")
+	}
+
+	return nil
+}
+
+func (s *MarketplaceServiceImpl) logActivity(ctx context.Context, tx *sql.Tx, wizardID int64, activityType, description string, metadata map[string]interface{}) error {
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to encode activity metadata")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata)
+		 SELECT user_id, id, $2, $3, $4::jsonb FROM wizards WHERE id = $1`,
+		wizardID, activityType, description, string(metadataJSON))
+	if err != nil {
+		s.logger.Error("Failed to create activity log", "error", err)
+		return status.Error(codes.Internal, "Failed to create activity log")
+	}
+
+	return nil
+}
+
+func pagination(pageSize, pageNumber int32) (int32, int32) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	if pageNumber <= 0 {
+		pageNumber = 1
+	}
+
+	return pageSize, (pageNumber - 1) * pageSize
+}
+
+// requiredLevel returns the minimum wizard level named in a requirements string, or 0 if none
+func requiredLevel(requirements string) int32 {
+	match := levelRequirementPattern.FindStringSubmatch(requirements)
+	if match == nil {
+		return 0
+	}
+
+	level, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0
+	}
+
+	return int32(level)
+}
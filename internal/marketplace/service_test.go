@@ -0,0 +1,302 @@
+package marketplace
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/internal/ledger/ledgertest"
+	"github.com/tectix/mysticfunds/pkg/config"
+	"github.com/tectix/mysticfunds/pkg/logger"
+	pb "github.com/tectix/mysticfunds/proto/marketplace"
+)
+
+func setupTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *MarketplaceServiceImpl) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database connection: %v", err)
+	}
+
+	cfg := &config.Config{
+		JWTSecret: "test_secret",
+	}
+	log := logger.NewLogger("debug")
+
+	return db, mock, NewMarketplaceServiceImpl(db, cfg, log)
+}
+
+func TestPurchaseArtifact(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, mana_cost, is_available, requirements FROM artifacts WHERE id = \\$1").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_cost", "is_available", "requirements"}).
+			AddRow("Ember Crown", 500, true, "Level 5+, Fire Affinity"))
+	mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM wizard_artifacts").
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("SELECT mana_balance, level FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance", "level"}).AddRow(1200, 6))
+	ledgertest.ExpectPost(mock, ledger.Transfer("artifact_purchase", "", ledger.Wizard(1), ledger.System(), 500),
+		map[int64]int64{1: 700})
+	mock.ExpectExec("INSERT INTO wizard_artifacts").
+		WithArgs(1, 3).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO marketplace_transactions").
+		WithArgs(1, "artifact", 3, 500, nil, "Purchased Ember Crown").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(1, "artifact_purchased", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	resp, err := service.PurchaseArtifact(context.Background(), &pb.PurchaseArtifactRequest{
+		WizardId:   1,
+		ArtifactId: 3,
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(500), resp.ManaSpent)
+	assert.Equal(t, int64(700), resp.RemainingMana)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPurchaseArtifactRejectsReusedIdempotencyKey(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	// The key was first used to buy a different artifact
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO idempotency_keys").
+		WithArgs("marketplace.PurchaseArtifact", "purchase-1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT request_hash, response FROM idempotency_keys").
+		WithArgs("marketplace.PurchaseArtifact", "purchase-1").
+		WillReturnRows(sqlmock.NewRows([]string{"request_hash", "response"}).
+			AddRow("a3f1c2d4e5b6a7980123456789abcdef0123456789abcdef0123456789abcdef", []byte{}))
+	mock.ExpectRollback()
+
+	_, err := service.PurchaseArtifact(context.Background(), &pb.PurchaseArtifactRequest{
+		WizardId:       1,
+		ArtifactId:     2,
+		IdempotencyKey: "purchase-1",
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPurchaseArtifactInsufficientMana(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, mana_cost, is_available, requirements FROM artifacts").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_cost", "is_available", "requirements"}).
+			AddRow("Ember Crown", 500, true, nil))
+	mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM wizard_artifacts").
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("SELECT mana_balance, level FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance", "level"}).AddRow(100, 10))
+	mock.ExpectRollback()
+
+	resp, err := service.PurchaseArtifact(context.Background(), &pb.PurchaseArtifactRequest{
+		WizardId:   1,
+		ArtifactId: 3,
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPurchaseArtifactLevelTooLow(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, mana_cost, is_available, requirements FROM artifacts").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_cost", "is_available", "requirements"}).
+			AddRow("Ember Crown", 500, true, "Level 5+"))
+	mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM wizard_artifacts").
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("SELECT mana_balance, level FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance", "level"}).AddRow(5000, 2))
+	mock.ExpectRollback()
+
+	_, err := service.PurchaseArtifact(context.Background(), &pb.PurchaseArtifactRequest{
+		WizardId:   1,
+		ArtifactId: 3,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPurchaseArtifactAlreadyOwned(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, mana_cost, is_available, requirements FROM artifacts").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_cost", "is_available", "requirements"}).
+			AddRow("Ember Crown", 500, true, nil))
+	mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM wizard_artifacts").
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	_, err := service.PurchaseArtifact(context.Background(), &pb.PurchaseArtifactRequest{
+		WizardId:   1,
+		ArtifactId: 3,
+	})
+
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPurchaseScrollMissingPrerequisite(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, mana_cost, is_available, prerequisites FROM scrolls").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_cost", "is_available", "prerequisites"}).
+			AddRow("Scroll of Advanced Combat", 800, true, pq.StringArray{"Basic Combat"}))
+	mock.ExpectQuery("SELECT sc.id, sc.name FROM wizard_scrolls").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "Scroll of Basic Healing"))
+	mock.ExpectRollback()
+
+	_, err := service.PurchaseScroll(context.Background(), &pb.PurchaseScrollRequest{
+		WizardId: 1,
+		ScrollId: 4,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "Basic Combat")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLearnSpellFromWizard(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT sp.name, sp.requirements, t.teaching_price").
+		WithArgs(2, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "requirements", "teaching_price", "max_students", "students_taught", "can_teach"}).
+			AddRow("Fireball", "Level 2+", 300, 5, 1, true))
+	mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM wizard_spells").
+		WithArgs(5, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	// Wizards are locked in ascending id order
+	mock.ExpectQuery("SELECT id FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectQuery("SELECT id FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectQuery("SELECT mana_balance, level FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance", "level"}).AddRow(1000, 3))
+	ledgertest.ExpectPost(mock, ledger.Transfer("spell_learning", "", ledger.Wizard(5), ledger.Wizard(2), 300),
+		map[int64]int64{5: 700, 2: 1300})
+	mock.ExpectExec("INSERT INTO wizard_spells").
+		WithArgs(5, 7, 2).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE wizard_spell_teaching SET students_taught = students_taught \\+ 1").
+		WithArgs(2, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO marketplace_transactions").
+		WithArgs(5, "spell_learning", 7, 300, 2, "Learned Fireball").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(5, "spell_learned", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(2, "spell_taught", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	resp, err := service.LearnSpellFromWizard(context.Background(), &pb.LearnSpellRequest{
+		StudentWizardId: 5,
+		TeacherWizardId: 2,
+		SpellId:         7,
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(300), resp.ManaSpent)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLearnSpellFromSelf(t *testing.T) {
+	db, _, service := setupTest(t)
+	defer db.Close()
+
+	_, err := service.LearnSpellFromWizard(context.Background(), &pb.LearnSpellRequest{
+		StudentWizardId: 1,
+		TeacherWizardId: 1,
+		SpellId:         7,
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestEquipArtifactNotOwned(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT a.name, a.artifact_type").
+		WithArgs(1, 3).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err := service.EquipArtifact(context.Background(), &pb.EquipArtifactRequest{
+		WizardId:   1,
+		ArtifactId: 3,
+		Equip:      true,
+	})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRequiredLevel(t *testing.T) {
+	tests := []struct {
+		requirements string
+		expected     int32
+	}{
+		{"Level 5+, Fire Affinity", 5},
+		{"Level 12+", 12},
+		{"Fire Affinity", 0},
+		{"", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.requirements, func(t *testing.T) {
+			assert.Equal(t, tt.expected, requiredLevel(tt.requirements))
+		})
+	}
+}
@@ -0,0 +1,200 @@
+// Package progression turns a wizard's experience into a level along a
+// configurable curve, and describes the rewards levels grant.
+package progression
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tectix/mysticfunds/pkg/config"
+)
+
+// Curve kinds
+const (
+	CurveLinear    = "linear"
+	CurveQuadratic = "quadratic"
+	CurveTable     = "table"
+)
+
+// Reward types a level can grant
+const (
+	// RewardManaBonus pays Amount mana when the level is reached
+	RewardManaBonus = "mana_bonus"
+	// RewardJobTier unlocks jobs of JobDifficulty
+	RewardJobTier = "job_tier"
+	// RewardWizardSlot lets the wizard's owner create Amount more wizards
+	RewardWizardSlot = "wizard_slot"
+)
+
+// Defaults match the curve wizards levelled on before curves were configurable
+const (
+	DefaultExpBase  = 100
+	DefaultMaxLevel = 50
+)
+
+// Curve maps experience to levels. Level 1 starts at 0 experience.
+type Curve struct {
+	name string
+	// thresholds[i] is the experience needed to reach level i+1
+	thresholds []int32
+}
+
+// Reward is something a wizard is granted on reaching a level
+type Reward struct {
+	Level         int32  `json:"level"`
+	Type          string `json:"type"`
+	Amount        int64  `json:"amount"`
+	JobDifficulty string `json:"job_difficulty,omitempty"`
+	Description   string `json:"description"`
+}
+
+// Progress is where an amount of experience stands on a curve
+type Progress struct {
+	Level    int32
+	MaxLevel int32
+	// LevelExp is the experience the current level starts at
+	LevelExp int32
+	// NextLevelExp is the experience the next level starts at, 0 at the max level
+	NextLevelExp int32
+}
+
+// Linear levels up every expPerLevel experience
+func Linear(expPerLevel int32, maxLevel int32) (*Curve, error) {
+	if expPerLevel <= 0 || maxLevel < 1 {
+		return nil, fmt.Errorf("linear curve needs positive experience per level and max level")
+	}
+	return build(CurveLinear, maxLevel, func(level int32) int64 {
+		return int64(level-1) * int64(expPerLevel)
+	})
+}
+
+// Quadratic needs base times the square of the levels gained, so level is
+// floor(sqrt(experience / base)) + 1
+func Quadratic(base int32, maxLevel int32) (*Curve, error) {
+	if base <= 0 || maxLevel < 1 {
+		return nil, fmt.Errorf("quadratic curve needs positive base and max level")
+	}
+	return build(CurveQuadratic, maxLevel, func(level int32) int64 {
+		gained := int64(level - 1)
+		return gained * gained * int64(base)
+	})
+}
+
+// Table uses the listed experience thresholds, one per level starting at level
+// 1, which must be 0
+func Table(thresholds []int32) (*Curve, error) {
+	if len(thresholds) == 0 || thresholds[0] != 0 {
+		return nil, fmt.Errorf("table curve must start at 0 experience")
+	}
+	for i := 1; i < len(thresholds); i++ {
+		if thresholds[i] <= thresholds[i-1] {
+			return nil, fmt.Errorf("table curve thresholds must increase, level %d does not", i+1)
+		}
+	}
+	return &Curve{name: CurveTable, thresholds: append([]int32(nil), thresholds...)}, nil
+}
+
+func build(name string, maxLevel int32, threshold func(level int32) int64) (*Curve, error) {
+	thresholds := make([]int32, maxLevel)
+	for level := int32(1); level <= maxLevel; level++ {
+		exp := threshold(level)
+		if exp > math.MaxInt32 {
+			return nil, fmt.Errorf("%s curve needs more experience than a wizard can hold for level %d", name, level)
+		}
+		thresholds[level-1] = int32(exp)
+	}
+	return &Curve{name: name, thresholds: thresholds}, nil
+}
+
+// DefaultCurve levels up every 100 experience up to level 50
+func DefaultCurve() *Curve {
+	curve, _ := Linear(DefaultExpBase, DefaultMaxLevel)
+	return curve
+}
+
+// FromConfig builds the curve named by LEVEL_CURVE. Linear and quadratic curves
+// take LEVEL_EXP_BASE and LEVEL_CAP; a table curve takes its thresholds from
+// LEVEL_THRESHOLDS as a comma-separated list.
+func FromConfig(cfg *config.Config) (*Curve, error) {
+	kind := cfg.GetString("LEVEL_CURVE", CurveLinear)
+	if kind == CurveTable {
+		var thresholds []int32
+		for _, field := range strings.Split(cfg.GetString("LEVEL_THRESHOLDS", ""), ",") {
+			exp, err := strconv.ParseInt(strings.TrimSpace(field), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid LEVEL_THRESHOLDS %q: %w", field, err)
+			}
+			thresholds = append(thresholds, int32(exp))
+		}
+		return Table(thresholds)
+	}
+
+	base, err := strconv.ParseInt(cfg.GetString("LEVEL_EXP_BASE", strconv.Itoa(DefaultExpBase)), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LEVEL_EXP_BASE: %w", err)
+	}
+	maxLevel, err := strconv.ParseInt(cfg.GetString("LEVEL_CAP", strconv.Itoa(DefaultMaxLevel)), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LEVEL_CAP: %w", err)
+	}
+
+	switch kind {
+	case CurveLinear:
+		return Linear(int32(base), int32(maxLevel))
+	case CurveQuadratic:
+		return Quadratic(int32(base), int32(maxLevel))
+	default:
+		return nil, fmt.Errorf("unknown LEVEL_CURVE %q", kind)
+	}
+}
+
+// Name returns the kind of curve
+func (c *Curve) Name() string {
+	return c.name
+}
+
+// MaxLevel returns the highest level on the curve
+func (c *Curve) MaxLevel() int32 {
+	return int32(len(c.thresholds))
+}
+
+// Level returns the level reached with exp experience
+func (c *Curve) Level(exp int32) int32 {
+	// The first threshold above exp is the level after the one reached
+	level := int32(sort.Search(len(c.thresholds), func(i int) bool {
+		return c.thresholds[i] > exp
+	}))
+	if level < 1 {
+		return 1
+	}
+	return level
+}
+
+// Threshold returns the experience needed to reach level, clamped to the curve
+func (c *Curve) Threshold(level int32) int32 {
+	if level < 1 {
+		level = 1
+	}
+	if level > c.MaxLevel() {
+		level = c.MaxLevel()
+	}
+	return c.thresholds[level-1]
+}
+
+// Progress returns the level reached with exp and the experience bounds of that
+// level
+func (c *Curve) Progress(exp int32) Progress {
+	level := c.Level(exp)
+	progress := Progress{
+		Level:    level,
+		MaxLevel: c.MaxLevel(),
+		LevelExp: c.Threshold(level),
+	}
+	if level < progress.MaxLevel {
+		progress.NextLevelExp = c.Threshold(level + 1)
+	}
+	return progress
+}
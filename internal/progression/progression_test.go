@@ -0,0 +1,74 @@
+package progression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultCurveMatchesFlatLeveling(t *testing.T) {
+	curve := DefaultCurve()
+
+	// Level = exp/100 + 1, capped at 50
+	for _, exp := range []int32{-10, 0, 99, 100, 199, 250, 4899, 4900, 100000} {
+		expected := exp/100 + 1
+		if exp < 0 {
+			expected = 1
+		}
+		if expected > 50 {
+			expected = 50
+		}
+		assert.Equal(t, expected, curve.Level(exp), "exp %d", exp)
+	}
+}
+
+func TestCurves(t *testing.T) {
+	quadratic, err := Quadratic(100, 10)
+	assert.NoError(t, err)
+	table, err := Table([]int32{0, 50, 200, 600})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		curve    *Curve
+		exp      int32
+		expected int32
+	}{
+		{"quadratic start", quadratic, 0, 1},
+		{"quadratic level 2", quadratic, 100, 2},
+		{"quadratic below level 3", quadratic, 399, 2},
+		{"quadratic level 3", quadratic, 400, 3},
+		{"quadratic cap", quadratic, 1000000, 10},
+		{"table start", table, 49, 1},
+		{"table level 3", table, 200, 3},
+		{"table cap", table, 5000, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.curve.Level(tt.exp))
+		})
+	}
+}
+
+func TestProgress(t *testing.T) {
+	curve, err := Quadratic(100, 3)
+	assert.NoError(t, err)
+
+	assert.Equal(t, Progress{Level: 2, MaxLevel: 3, LevelExp: 100, NextLevelExp: 400}, curve.Progress(250))
+	// Nothing is left to reach at the max level
+	assert.Equal(t, Progress{Level: 3, MaxLevel: 3, LevelExp: 400}, curve.Progress(9000))
+}
+
+func TestInvalidCurves(t *testing.T) {
+	_, err := Linear(0, 50)
+	assert.Error(t, err)
+	_, err = Quadratic(100, 0)
+	assert.Error(t, err)
+	_, err = Quadratic(1000000, 100)
+	assert.Error(t, err)
+	_, err = Table([]int32{10, 20})
+	assert.Error(t, err)
+	_, err = Table([]int32{0, 20, 20})
+	assert.Error(t, err)
+}
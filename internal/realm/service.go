@@ -0,0 +1,674 @@
+package realm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tectix/mysticfunds/internal/idempotency"
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/pkg/config"
+	"github.com/tectix/mysticfunds/pkg/logger"
+	pb "github.com/tectix/mysticfunds/proto/realm"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	// maxManaBoostFactor mirrors the CHECK constraint on realms.mana_boost_factor
+	maxManaBoostFactor = 10.0
+
+	// The mana boost range used when REALM_MANA_BOOST_MIN and REALM_MANA_BOOST_MAX are not set
+	defaultMinManaBoost = 0.5
+	defaultMaxManaBoost = 2.0
+)
+
+type RealmServiceImpl struct {
+	db          *sql.DB
+	idempotency *idempotency.Store
+	cfg         *config.Config
+	logger      logger.Logger
+	// A realm's mana boost scales every job and investment payout in it, so
+	// operators bound it
+	minManaBoost float64
+	maxManaBoost float64
+	pb.UnimplementedRealmServiceServer
+}
+
+// parseManaBoostRange reads REALM_MANA_BOOST_MIN and REALM_MANA_BOOST_MAX. The range must
+// be positive, in order, and within what the realms table allows.
+func parseManaBoostRange(minValue, maxValue string) (float64, float64, error) {
+	min, err := strconv.ParseFloat(minValue, 64)
+	if err != nil || min <= 0 {
+		return 0, 0, fmt.Errorf("invalid REALM_MANA_BOOST_MIN %q: must be a positive number", minValue)
+	}
+	max, err := strconv.ParseFloat(maxValue, 64)
+	if err != nil || max < min || max > maxManaBoostFactor {
+		return 0, 0, fmt.Errorf("invalid REALM_MANA_BOOST_MAX %q: must be between REALM_MANA_BOOST_MIN and %g", maxValue, maxManaBoostFactor)
+	}
+	return min, max, nil
+}
+
+func NewRealmServiceImpl(db *sql.DB, cfg *config.Config, logger logger.Logger) *RealmServiceImpl {
+	minBoost, maxBoost, err := parseManaBoostRange(
+		cfg.GetString("REALM_MANA_BOOST_MIN", strconv.FormatFloat(defaultMinManaBoost, 'f', -1, 64)),
+		cfg.GetString("REALM_MANA_BOOST_MAX", strconv.FormatFloat(defaultMaxManaBoost, 'f', -1, 64)))
+	if err != nil {
+		logger.Warn("Invalid mana boost range, using the default", "error", err)
+		minBoost, maxBoost = defaultMinManaBoost, defaultMaxManaBoost
+	}
+
+	return &RealmServiceImpl{
+		db:           db,
+		idempotency:  idempotency.NewStore(db, logger),
+		cfg:          cfg,
+		logger:       logger,
+		minManaBoost: minBoost,
+		maxManaBoost: maxBoost,
+	}
+}
+
+// checkManaBoost checks a boost factor against the configured range
+func (s *RealmServiceImpl) checkManaBoost(boost float64) error {
+	if boost < s.minManaBoost || boost > s.maxManaBoost {
+		return status.Error(codes.InvalidArgument,
+			fmt.Sprintf("Mana boost factor must be between %g and %g", s.minManaBoost, s.maxManaBoost))
+	}
+	return nil
+}
+
+// Realm methods
+
+func (s *RealmServiceImpl) CreateRealm(ctx context.Context, req *pb.CreateRealmRequest) (*pb.Realm, error) {
+	if strings.TrimSpace(req.Name) == "" || strings.TrimSpace(req.Element) == "" {
+		return nil, status.Error(codes.InvalidArgument, "Realm name and element are required")
+	}
+
+	boost := req.ManaBoostFactor
+	if boost == 0 {
+		boost = 1.0
+	}
+	if err := s.checkManaBoost(boost); err != nil {
+		return nil, err
+	}
+
+	var id int64
+	err := s.db.QueryRowContext(ctx,
+		`INSERT INTO realms (name, element, description, lore, artifact_name, artifact_description, mana_boost_factor)
+		 VALUES ($1, $2, $3, $4, '', '', $5)
+		 RETURNING id`,
+		req.Name, req.Element, req.Description, req.Lore, boost).Scan(&id)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Error(codes.AlreadyExists, "Realm name already exists")
+		}
+		s.logger.Error("Failed to create realm", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to create realm")
+	}
+
+	return s.GetRealm(ctx, &pb.GetRealmRequest{Id: id})
+}
+
+func (s *RealmServiceImpl) GetRealm(ctx context.Context, req *pb.GetRealmRequest) (*pb.Realm, error) {
+	realm, err := scanRealm(s.db.QueryRowContext(ctx,
+		`SELECT id, name, element, description, lore, mana_boost_factor, created_at, updated_at
+		 FROM realms WHERE id = $1`,
+		req.Id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Realm not found")
+		}
+		s.logger.Error("Failed to get realm", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get realm")
+	}
+
+	return realm, nil
+}
+
+func (s *RealmServiceImpl) ListRealms(ctx context.Context, req *pb.ListRealmsRequest) (*pb.ListRealmsResponse, error) {
+	limit, offset := pagination(req.PageSize, req.PageNumber)
+
+	var totalCount int32
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM realms").Scan(&totalCount); err != nil {
+		s.logger.Error("Failed to count realms", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list realms")
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, name, element, description, lore, mana_boost_factor, created_at, updated_at
+		 FROM realms ORDER BY name LIMIT $1 OFFSET $2`,
+		limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list realms", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list realms")
+	}
+	defer rows.Close()
+
+	var realms []*pb.Realm
+	for rows.Next() {
+		realm, err := scanRealm(rows)
+		if err != nil {
+			s.logger.Error("Failed to scan realm", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to list realms")
+		}
+		realms = append(realms, realm)
+	}
+
+	return &pb.ListRealmsResponse{
+		Realms:     realms,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// UpdateRealm updates the given fields; empty strings and a zero boost factor keep the current values.
+// It is an operator RPC the gateway does not expose, since the boost factor scales every payout
+// in the realm.
+func (s *RealmServiceImpl) UpdateRealm(ctx context.Context, req *pb.UpdateRealmRequest) (*pb.Realm, error) {
+	if req.ManaBoostFactor != 0 {
+		if err := s.checkManaBoost(req.ManaBoostFactor); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := s.db.ExecContext(ctx,
+		`UPDATE realms SET
+		 name = COALESCE(NULLIF($1, ''), name),
+		 description = COALESCE(NULLIF($2, ''), description),
+		 lore = COALESCE(NULLIF($3, ''), lore),
+		 mana_boost_factor = COALESCE(NULLIF($4::numeric, 0), mana_boost_factor)
+		 WHERE id = $5`,
+		req.Name, req.Description, req.Lore, req.ManaBoostFactor, req.Id)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Error(codes.AlreadyExists, "Realm name already exists")
+		}
+		s.logger.Error("Failed to update realm", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to update realm")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		s.logger.Error("Failed to get rows affected", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to update realm")
+	}
+
+	if rowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "Realm not found")
+	}
+
+	return s.GetRealm(ctx, &pb.GetRealmRequest{Id: req.Id})
+}
+
+func (s *RealmServiceImpl) DeleteRealm(ctx context.Context, req *pb.DeleteRealmRequest) (*pb.DeleteRealmResponse, error) {
+	// Jobs and properties cascade with the realm, so refuse while wizards are still working there
+	var activeAssignments int
+	err := s.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM job_assignments ja
+		 JOIN jobs j ON ja.job_id = j.id
+		 WHERE j.realm_id = $1 AND ja.status IN ('assigned', 'in_progress')`,
+		req.Id).Scan(&activeAssignments)
+	if err != nil {
+		s.logger.Error("Failed to check realm assignments", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to delete realm")
+	}
+
+	if activeAssignments > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Realm has active job assignments")
+	}
+
+	result, err := s.db.ExecContext(ctx, "DELETE FROM realms WHERE id = $1", req.Id)
+	if err != nil {
+		s.logger.Error("Failed to delete realm", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to delete realm")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		s.logger.Error("Failed to get rows affected", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to delete realm")
+	}
+
+	if rowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "Realm not found")
+	}
+
+	return &pb.DeleteRealmResponse{Success: true}, nil
+}
+
+// Property methods
+
+const propertyColumns = `p.id, p.realm_id, r.name, p.name, p.description, p.price, p.owner_wizard_id, w.name,
+	p.for_sale, p.created_at, p.updated_at`
+
+const propertyFrom = ` FROM properties p
+	JOIN realms r ON p.realm_id = r.id
+	LEFT JOIN wizards w ON p.owner_wizard_id = w.id`
+
+func (s *RealmServiceImpl) CreateProperty(ctx context.Context, req *pb.CreatePropertyRequest) (*pb.Property, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "Property name is required")
+	}
+	if req.Price <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Property price must be positive")
+	}
+
+	var id int64
+	err := s.db.QueryRowContext(ctx,
+		`INSERT INTO properties (realm_id, name, description, price)
+		 VALUES ($1, $2, $3, $4)
+		 RETURNING id`,
+		req.RealmId, req.Name, req.Description, req.Price).Scan(&id)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Error(codes.AlreadyExists, "Property name already exists in this realm")
+		}
+		if isForeignKeyViolation(err) {
+			return nil, status.Error(codes.NotFound, "Realm not found")
+		}
+		s.logger.Error("Failed to create property", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to create property")
+	}
+
+	return s.GetProperty(ctx, &pb.GetPropertyRequest{Id: id})
+}
+
+func (s *RealmServiceImpl) GetProperty(ctx context.Context, req *pb.GetPropertyRequest) (*pb.Property, error) {
+	property, err := scanProperty(s.db.QueryRowContext(ctx,
+		"SELECT "+propertyColumns+propertyFrom+" WHERE p.id = $1",
+		req.Id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Property not found")
+		}
+		s.logger.Error("Failed to get property", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get property")
+	}
+
+	return property, nil
+}
+
+func (s *RealmServiceImpl) ListProperties(ctx context.Context, req *pb.ListPropertiesRequest) (*pb.ListPropertiesResponse, error) {
+	limit, offset := pagination(req.PageSize, req.PageNumber)
+
+	where := " WHERE 1=1"
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.RealmId > 0 {
+		where += fmt.Sprintf(" AND p.realm_id = $%d", argIndex)
+		args = append(args, req.RealmId)
+		argIndex++
+	}
+
+	if req.OwnerId > 0 {
+		where += fmt.Sprintf(" AND p.owner_wizard_id = $%d", argIndex)
+		args = append(args, req.OwnerId)
+		argIndex++
+	}
+
+	var totalCount int32
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM properties p"+where, args...).Scan(&totalCount); err != nil {
+		s.logger.Error("Failed to count properties", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list properties")
+	}
+
+	query := "SELECT " + propertyColumns + propertyFrom + where +
+		fmt.Sprintf(" ORDER BY p.realm_id, p.price LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	rows, err := s.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		s.logger.Error("Failed to list properties", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list properties")
+	}
+	defer rows.Close()
+
+	var properties []*pb.Property
+	for rows.Next() {
+		property, err := scanProperty(rows)
+		if err != nil {
+			s.logger.Error("Failed to scan property", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to list properties")
+		}
+		properties = append(properties, property)
+	}
+
+	return &pb.ListPropertiesResponse{
+		Properties: properties,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// UpdateProperty updates the given fields; empty strings and a zero price keep the current values.
+// Only an unowned property's price can change here: an owner sets theirs when listing it.
+func (s *RealmServiceImpl) UpdateProperty(ctx context.Context, req *pb.UpdatePropertyRequest) (*pb.Property, error) {
+	if req.Price < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Property price must be positive")
+	}
+
+	result, err := s.db.ExecContext(ctx,
+		`UPDATE properties SET
+		 name = COALESCE(NULLIF($1, ''), name),
+		 description = COALESCE(NULLIF($2, ''), description),
+		 price = COALESCE(NULLIF($3::bigint, 0), price)
+		 WHERE id = $4 AND ($3::bigint = 0 OR $3::bigint = price OR owner_wizard_id IS NULL)`,
+		req.Name, req.Description, req.Price, req.Id)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Error(codes.AlreadyExists, "Property name already exists in this realm")
+		}
+		s.logger.Error("Failed to update property", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to update property")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		s.logger.Error("Failed to get rows affected", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to update property")
+	}
+
+	if rowsAffected == 0 {
+		// Either there is no such property or its owner sets the price
+		if _, err := s.GetProperty(ctx, &pb.GetPropertyRequest{Id: req.Id}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "An owned property's price is set by its owner")
+	}
+
+	return s.GetProperty(ctx, &pb.GetPropertyRequest{Id: req.Id})
+}
+
+func (s *RealmServiceImpl) DeleteProperty(ctx context.Context, req *pb.DeletePropertyRequest) (*pb.DeletePropertyResponse, error) {
+	result, err := s.db.ExecContext(ctx, "DELETE FROM properties WHERE id = $1", req.Id)
+	if err != nil {
+		s.logger.Error("Failed to delete property", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to delete property")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		s.logger.Error("Failed to get rows affected", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to delete property")
+	}
+
+	if rowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "Property not found")
+	}
+
+	return &pb.DeletePropertyResponse{Success: true}, nil
+}
+
+// SetPropertyListing lets a property's owner put it up for sale, optionally at a new
+// price, or take it off the market
+func (s *RealmServiceImpl) SetPropertyListing(ctx context.Context, req *pb.SetPropertyListingRequest) (*pb.Property, error) {
+	if req.Price < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Property price must be positive")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	var ownerID sql.NullInt64
+	err = tx.QueryRowContext(ctx,
+		"SELECT owner_wizard_id FROM properties WHERE id = $1 FOR UPDATE",
+		req.PropertyId).Scan(&ownerID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Property not found")
+		}
+		s.logger.Error("Failed to get property", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to update property listing")
+	}
+
+	if !ownerID.Valid || ownerID.Int64 != req.OwnerId {
+		return nil, status.Error(codes.PermissionDenied, "Only the property's owner can list it")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE properties SET for_sale = $1, price = COALESCE(NULLIF($2::bigint, 0), price) WHERE id = $3",
+		req.ForSale, req.Price, req.PropertyId)
+	if err != nil {
+		s.logger.Error("Failed to update property listing", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to update property listing")
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to update property listing")
+	}
+
+	return s.GetProperty(ctx, &pb.GetPropertyRequest{Id: req.PropertyId})
+}
+
+// BuyProperty transfers a property that is for sale to the buyer at its price. The mana
+// goes to the current owner, or leaves circulation when the property is unowned. An owned
+// property is only sold once its owner has listed it, and the sale takes it off the market.
+// The property row and both wizard rows are locked so concurrent purchases cannot both
+// succeed.
+func (s *RealmServiceImpl) BuyProperty(ctx context.Context, req *pb.BuyPropertyRequest) (*pb.BuyPropertyResponse, error) {
+	return idempotency.DoTx(ctx, s.idempotency, "realm.BuyProperty", req.IdempotencyKey, req, func(tx *sql.Tx) (*pb.BuyPropertyResponse, error) {
+		return s.buyProperty(ctx, tx, req)
+	})
+}
+
+func (s *RealmServiceImpl) buyProperty(ctx context.Context, tx *sql.Tx, req *pb.BuyPropertyRequest) (*pb.BuyPropertyResponse, error) {
+	var name string
+	var price int64
+	var ownerID sql.NullInt64
+	var forSale bool
+	err := tx.QueryRowContext(ctx,
+		"SELECT name, price, owner_wizard_id, for_sale FROM properties WHERE id = $1 FOR UPDATE",
+		req.PropertyId).Scan(&name, &price, &ownerID, &forSale)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Property not found")
+		}
+		s.logger.Error("Failed to get property", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to buy property")
+	}
+
+	if ownerID.Valid && ownerID.Int64 == req.BuyerId {
+		return nil, status.Error(codes.FailedPrecondition, "Wizard already owns this property")
+	}
+	if ownerID.Valid && !forSale {
+		return nil, status.Error(codes.FailedPrecondition, "Property is not for sale")
+	}
+
+	// Lock wizards in id order so opposing purchases cannot deadlock
+	lockIDs := []int64{req.BuyerId}
+	if ownerID.Valid {
+		if ownerID.Int64 < req.BuyerId {
+			lockIDs = []int64{ownerID.Int64, req.BuyerId}
+		} else {
+			lockIDs = append(lockIDs, ownerID.Int64)
+		}
+	}
+
+	balances := make(map[int64]int64, len(lockIDs))
+	for _, id := range lockIDs {
+		var balance int64
+		err := tx.QueryRowContext(ctx,
+			"SELECT mana_balance FROM wizards WHERE id = $1 FOR UPDATE",
+			id).Scan(&balance)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Error(codes.NotFound, "Wizard not found")
+			}
+			s.logger.Error("Failed to lock wizard", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to buy property")
+		}
+		balances[id] = balance
+	}
+
+	if balances[req.BuyerId] < price {
+		return nil, status.Error(codes.FailedPrecondition, "Insufficient mana balance")
+	}
+
+	// Unowned properties are sold by the realm, so the mana goes to the system account
+	seller := ledger.System()
+	if ownerID.Valid {
+		seller = ledger.Wizard(ownerID.Int64)
+	}
+
+	remaining := balances[req.BuyerId]
+	if price > 0 {
+		posted, err := ledger.Post(ctx, tx, ledger.Transfer("property_purchase", "Purchased "+name,
+			ledger.Wizard(req.BuyerId), seller, price))
+		if err != nil {
+			s.logger.Error("Failed to pay for property", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to buy property")
+		}
+		remaining = posted.Balances[req.BuyerId]
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE properties SET owner_wizard_id = $1, for_sale = false WHERE id = $2",
+		req.BuyerId, req.PropertyId)
+	if err != nil {
+		s.logger.Error("Failed to transfer property", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to buy property")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO property_ownership_history (property_id, seller_wizard_id, buyer_wizard_id, price)
+		 VALUES ($1, $2, $3, $4)`,
+		req.PropertyId, ownerID, req.BuyerId, price)
+	if err != nil {
+		s.logger.Error("Failed to record property sale", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to buy property")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata)
+		 SELECT w.user_id, w.id, 'property_purchased', $2,
+		 json_build_object('property_id', $3::bigint, 'price', $4::bigint, 'seller_wizard_id', $5::bigint)
+		 FROM wizards w WHERE w.id = $1`,
+		req.BuyerId, fmt.Sprintf("Purchased property: %s for %d mana", name, price), req.PropertyId, price, ownerID)
+	if err != nil {
+		s.logger.Error("Failed to create activity log", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to buy property")
+	}
+
+	if ownerID.Valid {
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata)
+			 SELECT w.user_id, w.id, 'property_sold', $2,
+			 json_build_object('property_id', $3::bigint, 'price', $4::bigint, 'buyer_wizard_id', $5::bigint)
+			 FROM wizards w WHERE w.id = $1`,
+			ownerID.Int64, fmt.Sprintf("Sold property: %s for %d mana", name, price), req.PropertyId, price, req.BuyerId)
+		if err != nil {
+			s.logger.Error("Failed to create activity log", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to buy property")
+		}
+	}
+
+	// Read the property back within tx, since the response is stored before it commits
+	property, err := scanProperty(tx.QueryRowContext(ctx,
+		"SELECT "+propertyColumns+propertyFrom+" WHERE p.id = $1",
+		req.PropertyId))
+	if err != nil {
+		s.logger.Error("Failed to get property", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to buy property")
+	}
+
+	return &pb.BuyPropertyResponse{
+		Success:       true,
+		Message:       fmt.Sprintf("Purchased %s", name),
+		Property:      property,
+		ManaSpent:     price,
+		RemainingMana: remaining,
+	}, nil
+}
+
+// Helpers
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRealm(row rowScanner) (*pb.Realm, error) {
+	var realm pb.Realm
+	var createdAt, updatedAt sql.NullTime
+
+	if err := row.Scan(&realm.Id, &realm.Name, &realm.Element, &realm.Description, &realm.Lore,
+		&realm.ManaBoostFactor, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	if createdAt.Valid {
+		realm.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		realm.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+
+	return &realm, nil
+}
+
+func scanProperty(row rowScanner) (*pb.Property, error) {
+	var property pb.Property
+	var ownerID sql.NullInt64
+	var ownerName sql.NullString
+	var forSale bool
+	var createdAt, updatedAt sql.NullTime
+
+	if err := row.Scan(&property.Id, &property.RealmId, &property.RealmName, &property.Name,
+		&property.Description, &property.Price, &ownerID, &ownerName, &forSale, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	// The realm sells whatever it still owns
+	property.ForSale = forSale || !ownerID.Valid
+	if ownerID.Valid {
+		property.OwnerId = ownerID.Int64
+	}
+	if ownerName.Valid {
+		property.OwnerName = ownerName.String
+	}
+	if createdAt.Valid {
+		property.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		property.UpdatedAt = timestamppb.New(updatedAt.Time)
+	}
+
+	return &property, nil
+}
+
+func pagination(pageSize, pageNumber int32) (int32, int32) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	if pageNumber <= 0 {
+		pageNumber = 1
+	}
+
+	return pageSize, (pageNumber - 1) * pageSize
+}
+
+func isUniqueViolation(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == "23505"
+}
+
+func isForeignKeyViolation(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == "23503"
+}
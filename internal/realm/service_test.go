@@ -0,0 +1,312 @@
+package realm
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/internal/ledger/ledgertest"
+	"github.com/tectix/mysticfunds/pkg/config"
+	"github.com/tectix/mysticfunds/pkg/logger"
+	pb "github.com/tectix/mysticfunds/proto/realm"
+)
+
+func setupTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *RealmServiceImpl) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database connection: %v", err)
+	}
+
+	cfg := &config.Config{
+		JWTSecret: "test_secret",
+	}
+	log := logger.NewLogger("debug")
+
+	return db, mock, NewRealmServiceImpl(db, cfg, log)
+}
+
+var propertyRowColumns = []string{"id", "realm_id", "realm_name", "name", "description", "price",
+	"owner_wizard_id", "owner_name", "for_sale", "created_at", "updated_at"}
+
+func TestGetRealm(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery("SELECT id, name, element, description, lore, mana_boost_factor, created_at, updated_at FROM realms WHERE id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "element", "description", "lore", "mana_boost_factor", "created_at", "updated_at"}).
+			AddRow(1, "Pyrrhian Flame", "Fire", "Realm of eternal fire", "Home to the Salamandrine Lords", "1.10", now, now))
+
+	resp, err := service.GetRealm(context.Background(), &pb.GetRealmRequest{Id: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Pyrrhian Flame", resp.Name)
+	assert.Equal(t, "Fire", resp.Element)
+	assert.InDelta(t, 1.10, resp.ManaBoostFactor, 0.0001)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateRealmValidation(t *testing.T) {
+	db, _, service := setupTest(t)
+	defer db.Close()
+
+	_, err := service.CreateRealm(context.Background(), &pb.CreateRealmRequest{
+		Name:            "Glimmerdeep",
+		Element:         "Water",
+		ManaBoostFactor: 25,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.CreateRealm(context.Background(), &pb.CreateRealmRequest{Name: "Glimmerdeep"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBuyPropertyFromOwner(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, price, owner_wizard_id, for_sale FROM properties WHERE id = \\$1 FOR UPDATE").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "price", "owner_wizard_id", "for_sale"}).AddRow("Rootbound Cottage", 2000, 3, true))
+	// Owner (3) is locked before buyer (7)
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(100))
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(5000))
+	ledgertest.ExpectPost(mock, ledger.Transfer("property_purchase", "", ledger.Wizard(7), ledger.Wizard(3), 2000),
+		map[int64]int64{7: 3000, 3: 2100})
+	mock.ExpectExec("UPDATE properties SET owner_wizard_id = \\$1, for_sale = false").
+		WithArgs(7, 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO property_ownership_history").
+		WithArgs(4, sqlmock.AnyArg(), 7, 2000).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(7, sqlmock.AnyArg(), 4, 2000, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(3, sqlmock.AnyArg(), 4, 2000, 7).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM properties p").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows(propertyRowColumns).
+			AddRow(4, 3, "Terravine Hollow", "Rootbound Cottage", "A home carved into roots", 2000, 7, "Buyer", false, now, now))
+	mock.ExpectCommit()
+
+	resp, err := service.BuyProperty(context.Background(), &pb.BuyPropertyRequest{
+		PropertyId: 4,
+		BuyerId:    7,
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(7), resp.Property.OwnerId)
+	assert.Equal(t, int64(3000), resp.RemainingMana)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBuyUnownedProperty(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, price, owner_wizard_id, for_sale FROM properties WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "price", "owner_wizard_id", "for_sale"}).AddRow("Cinder Forge Workshop", 2500, nil, false))
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(2500))
+	ledgertest.ExpectPost(mock, ledger.Transfer("property_purchase", "", ledger.Wizard(2), ledger.System(), 2500),
+		map[int64]int64{2: 0})
+	mock.ExpectExec("UPDATE properties SET owner_wizard_id = \\$1, for_sale = false").
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO property_ownership_history").
+		WithArgs(1, sqlmock.AnyArg(), 2, 2500).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(2, sqlmock.AnyArg(), 1, 2500, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT (.+) FROM properties p").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(propertyRowColumns).
+			AddRow(1, 1, "Pyrrhian Flame", "Cinder Forge Workshop", "A smithy", 2500, 2, "Buyer", false, now, now))
+	mock.ExpectCommit()
+
+	resp, err := service.BuyProperty(context.Background(), &pb.BuyPropertyRequest{
+		PropertyId: 1,
+		BuyerId:    2,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), resp.RemainingMana)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBuyPropertyInsufficientMana(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, price, owner_wizard_id, for_sale FROM properties WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "price", "owner_wizard_id", "for_sale"}).AddRow("Cinder Forge Workshop", 2500, nil, false))
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(100))
+	mock.ExpectRollback()
+
+	_, err := service.BuyProperty(context.Background(), &pb.BuyPropertyRequest{
+		PropertyId: 1,
+		BuyerId:    2,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBuyOwnProperty(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, price, owner_wizard_id, for_sale FROM properties WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "price", "owner_wizard_id", "for_sale"}).AddRow("Cinder Forge Workshop", 2500, 2, false))
+	mock.ExpectRollback()
+
+	_, err := service.BuyProperty(context.Background(), &pb.BuyPropertyRequest{
+		PropertyId: 1,
+		BuyerId:    2,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBuyUnlistedPropertyFromOwner(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, price, owner_wizard_id, for_sale FROM properties WHERE id = \\$1 FOR UPDATE").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "price", "owner_wizard_id", "for_sale"}).AddRow("Rootbound Cottage", 2000, 3, false))
+	mock.ExpectRollback()
+
+	_, err := service.BuyProperty(context.Background(), &pb.BuyPropertyRequest{
+		PropertyId: 4,
+		BuyerId:    7,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetPropertyListing(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT owner_wizard_id FROM properties WHERE id = \\$1 FOR UPDATE").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"owner_wizard_id"}).AddRow(3))
+	mock.ExpectExec("UPDATE properties SET for_sale = \\$1").
+		WithArgs(true, 2600, 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT (.+) FROM properties p").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows(propertyRowColumns).
+			AddRow(4, 3, "Terravine Hollow", "Rootbound Cottage", "A home carved into roots", 2600, 3, "Owner", true, now, now))
+
+	resp, err := service.SetPropertyListing(context.Background(), &pb.SetPropertyListingRequest{
+		PropertyId: 4,
+		OwnerId:    3,
+		ForSale:    true,
+		Price:      2600,
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.ForSale)
+	assert.Equal(t, int64(2600), resp.Price)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetPropertyListingByAnotherWizard(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT owner_wizard_id FROM properties WHERE id = \\$1 FOR UPDATE").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"owner_wizard_id"}).AddRow(3))
+	mock.ExpectRollback()
+
+	_, err := service.SetPropertyListing(context.Background(), &pb.SetPropertyListingRequest{
+		PropertyId: 4,
+		OwnerId:    7,
+		ForSale:    true,
+		Price:      1,
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePropertyKeepsOwnedPrice(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectExec("UPDATE properties SET (.+)owner_wizard_id IS NULL").
+		WithArgs("", "", 1, 4).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT (.+) FROM properties p").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows(propertyRowColumns).
+			AddRow(4, 3, "Terravine Hollow", "Rootbound Cottage", "A home carved into roots", 2000, 3, "Owner", false, now, now))
+
+	// Repricing someone's property would let anyone buy it for nothing
+	_, err := service.UpdateProperty(context.Background(), &pb.UpdatePropertyRequest{Id: 4, Price: 1})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestParseManaBoostRange(t *testing.T) {
+	min, max, err := parseManaBoostRange("0.8", "1.5")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.8, min)
+	assert.Equal(t, 1.5, max)
+
+	for _, tt := range [][2]string{{"0", "2"}, {"1.5", "1.2"}, {"1", "25"}, {"low", "2"}, {"1", "high"}} {
+		_, _, err := parseManaBoostRange(tt[0], tt[1])
+		assert.Error(t, err, "range %v", tt)
+	}
+}
+
+func TestUpdateRealmBoostOutsideConfiguredRange(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	// Within the table's limit of 10, but above the default ceiling
+	_, err := service.UpdateRealm(context.Background(), &pb.UpdateRealmRequest{Id: 1, ManaBoostFactor: 5})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,32 @@
+package rewards
+
+import "time"
+
+// JobRates are what a job pays per hour of work and how long it is planned to
+// take
+type JobRates struct {
+	ManaPerHour int64
+	ExpPerHour  int64
+	Planned     time.Duration
+}
+
+// Earned is the mana and experience accrued for the time worked on a job. Work
+// beyond the planned duration is not paid, so a job worked in full pays exactly
+// its rates times its planned duration.
+func (r JobRates) Earned(worked time.Duration) (int64, int64) {
+	if worked > r.Planned {
+		worked = r.Planned
+	}
+	return Accrue(r.ManaPerHour, worked), Accrue(r.ExpPerHour, worked)
+}
+
+// Accrue is what a per-hour rate pays for worked. The reward accrues every
+// millisecond in fractions of a unit and is only rounded down once, to whole
+// units, when it is paid; a rate of 50 an hour pays 25 for half an hour and 37
+// for 45 minutes.
+func Accrue(perHour int64, worked time.Duration) int64 {
+	if perHour <= 0 || worked <= 0 {
+		return 0
+	}
+	return perHour * worked.Milliseconds() / time.Hour.Milliseconds()
+}
@@ -0,0 +1,155 @@
+package rewards
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccrue(t *testing.T) {
+	tests := []struct {
+		name     string
+		perHour  int64
+		worked   time.Duration
+		expected int64
+	}{
+		{"full hour", 600, time.Hour, 600},
+		{"rate below one a minute", 50, 30 * time.Minute, 25},
+		{"fraction is rounded down once", 50, 45 * time.Minute, 37},
+		{"seconds count", 3600, 90 * time.Second, 90},
+		{"under one unit", 1, 59 * time.Minute, 0},
+		{"nothing worked", 600, 0, 0},
+		{"negative time", 600, -time.Minute, 0},
+		{"no rate", 0, time.Hour, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Accrue(tt.perHour, tt.worked))
+		})
+	}
+}
+
+func TestJobRatesEarnedCapsAtPlanned(t *testing.T) {
+	rates := JobRates{ManaPerHour: 50, ExpPerHour: 15, Planned: 30 * time.Minute}
+
+	mana, exp := rates.Earned(2 * time.Hour)
+	assert.Equal(t, int64(25), mana)
+	assert.Equal(t, int64(7), exp)
+
+	mana, exp = rates.Earned(12 * time.Minute)
+	assert.Equal(t, int64(10), mana)
+	assert.Equal(t, int64(3), exp)
+}
+
+// seededJobs are the jobs seeded by the wizard schema migration, with the rewards
+// each pays when worked for its planned duration
+var seededJobs = []struct {
+	title       string
+	manaPerHour int64
+	expPerHour  int64
+	minutes     int64
+	mana        int64
+	exp         int64
+}{
+	{"Lava Crystal Mining", 50, 15, 30, 25, 7},
+	{"Flame Elemental Pacification", 120, 35, 60, 120, 35},
+	{"Wind Current Mapping", 40, 12, 45, 30, 9},
+	{"Cyclone Core Investigation", 200, 60, 120, 400, 120},
+	{"Stone Titan Archaeology", 70, 20, 90, 105, 30},
+	{"Deep Sea Relic Recovery", 100, 30, 75, 125, 37},
+	{"Light Prism Maintenance", 150, 45, 60, 150, 45},
+	{"Shadow Veil Investigation", 90, 25, 80, 120, 33},
+	{"Void Zone Stabilization", 300, 80, 180, 900, 240},
+	{"Spirit Guide Escort", 60, 18, 45, 45, 13},
+	{"Timeline Repair", 400, 100, 240, 1600, 400},
+	{"Nano-Intelligence Debugging", 180, 50, 90, 270, 75},
+	{"Volcanic Observatory Duty", 80, 22, 60, 80, 22},
+	{"Salamander Ranch Herding", 40, 12, 30, 20, 6},
+	{"Forgemaster Apprenticeship", 120, 35, 90, 180, 52},
+	{"Ember Storm Patrol", 240, 65, 150, 600, 162},
+	{"Tidal Pool Research", 50, 15, 40, 33, 10},
+	{"Leviathan Communication", 350, 90, 200, 1166, 300},
+	{"Coral Garden Restoration", 70, 20, 80, 93, 26},
+	{"Ice Crystal Harvesting", 110, 30, 75, 137, 37},
+	{"Ancient Tree Communion", 160, 45, 120, 320, 90},
+	{"Root Network Maintenance", 60, 18, 50, 50, 15},
+	{"Stone Titan Awakening", 220, 60, 180, 660, 180},
+	{"Mushroom Farm Management", 35, 10, 25, 14, 4},
+	{"Storm Rider Training", 100, 28, 65, 108, 30},
+	{"Cloud Palace Security", 75, 22, 55, 68, 20},
+	{"Weather Prediction Service", 45, 12, 35, 26, 7},
+	{"Cyclone Core Stabilization", 320, 85, 190, 1013, 269},
+	{"Radiant Crystal Polishing", 40, 12, 30, 20, 6},
+	{"Truth Seeking Investigation", 140, 38, 95, 221, 60},
+	{"Healing Temple Service", 65, 18, 45, 48, 13},
+	{"Solar Flare Management", 200, 55, 140, 466, 128},
+	{"Memory Archive Sorting", 70, 20, 60, 70, 20},
+	{"Nightmare Extermination", 130, 35, 85, 184, 49},
+	{"Secret Trade Facilitation", 95, 25, 70, 110, 29},
+	{"Void Rift Sealing", 180, 50, 130, 390, 108},
+	{"Soul Therapy Sessions", 55, 16, 45, 41, 12},
+	{"Dream Bridge Construction", 150, 40, 100, 250, 66},
+	{"Ghost Census Taking", 30, 8, 20, 10, 2},
+	{"Emotional Energy Harvesting", 110, 30, 80, 146, 40},
+	{"Automaton Repair Service", 80, 24, 60, 80, 24},
+	{"Code Integration Projects", 250, 70, 160, 666, 186},
+	{"Steel God Archaeology", 120, 32, 90, 180, 48},
+	{"Precision Manufacturing", 70, 20, 50, 58, 16},
+	{"Paradox Prevention Patrol", 400, 100, 250, 1666, 416},
+	{"Timeline Documentation", 130, 35, 95, 205, 55},
+	{"Clock Tower Maintenance", 90, 25, 70, 105, 29},
+	{"Fate Thread Weaving", 500, 120, 300, 2500, 600},
+	{"Reality Anchor Installation", 160, 45, 120, 320, 90},
+	{"Entropy Measurement", 75, 22, 60, 75, 22},
+	{"Nothing Meditation Guidance", 100, 28, 80, 133, 37},
+	{"Silence Priest Recruitment", 200, 55, 150, 500, 137},
+}
+
+func TestSeededJobRewards(t *testing.T) {
+	for _, job := range seededJobs {
+		t.Run(job.title, func(t *testing.T) {
+			rates := JobRates{ManaPerHour: job.manaPerHour, ExpPerHour: job.expPerHour, Planned: time.Duration(job.minutes) * time.Minute}
+
+			mana, exp := rates.Earned(rates.Planned)
+			assert.Equal(t, job.mana, mana)
+			assert.Equal(t, job.exp, exp)
+			assert.Positive(t, mana)
+			assert.Positive(t, exp)
+
+			// Overrunning pays no more
+			mana, exp = rates.Earned(rates.Planned + time.Hour)
+			assert.Equal(t, job.mana, mana)
+			assert.Equal(t, job.exp, exp)
+
+			// Half the work pays half, give or take the unit rounded off
+			mana, exp = rates.Earned(rates.Planned / 2)
+			assert.InDelta(t, job.mana/2, mana, 1)
+			assert.InDelta(t, job.exp/2, exp, 1)
+		})
+	}
+}
+
+// seededJobRow matches the title and reward columns of a row in the jobs seed
+var seededJobRow = regexp.MustCompile(`(?m)^\(\d+, '((?:[^']|'')*)', '(?:[^']|'')*', '\w+', \d+, (\d+), (\d+), (\d+),`)
+
+func TestSeededJobsCoverMigration(t *testing.T) {
+	migration, err := os.ReadFile("../../migrations/wizard/000010_consolidated_schema.up.sql")
+	if err != nil {
+		t.Fatalf("Failed to read jobs seed: %v", err)
+	}
+
+	rows := seededJobRow.FindAllStringSubmatch(string(migration), -1)
+	if assert.Len(t, rows, len(seededJobs)) {
+		for i, row := range rows {
+			assert.Equal(t, seededJobs[i].title, row[1])
+			assert.Equal(t, strconv.FormatInt(seededJobs[i].manaPerHour, 10), row[2], row[1])
+			assert.Equal(t, strconv.FormatInt(seededJobs[i].expPerHour, 10), row[3], row[1])
+			assert.Equal(t, strconv.FormatInt(seededJobs[i].minutes, 10), row[4], row[1])
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package rewards
+
+import (
+	"math"
+	"time"
+)
+
+// Affinity is how well a wizard's element suits a job's element. RewardFactor
+// scales the mana the job pays and SpeedFactor how fast its work is done.
+type Affinity struct {
+	RewardFactor float64
+	SpeedFactor  float64
+}
+
+// NeutralAffinity is the affinity of a wizard with a job of their own element
+func NeutralAffinity() Affinity {
+	return Affinity{RewardFactor: 1, SpeedFactor: 1}
+}
+
+// Modifier returns the affinity's reward factor as a reward modifier
+func (a Affinity) Modifier() Modifier {
+	return Modifier{
+		Name:   "Element affinity",
+		Source: SourceElement,
+		Factor: a.RewardFactor,
+	}
+}
+
+// Duration is how long work planned to take planned takes at the affinity's
+// speed; a speed of 0.8 takes a quarter longer
+func (a Affinity) Duration(planned time.Duration) time.Duration {
+	if a.SpeedFactor <= 0 {
+		return planned
+	}
+	return time.Duration(math.Round(float64(planned) / a.SpeedFactor))
+}
+
+// Work is how much of a job's planned work is done in elapsed time at the
+// affinity's speed, the inverse of Duration
+func (a Affinity) Work(elapsed time.Duration) time.Duration {
+	if a.SpeedFactor <= 0 {
+		return elapsed
+	}
+	return time.Duration(math.Round(float64(elapsed) * a.SpeedFactor))
+}
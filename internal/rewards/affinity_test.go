@@ -0,0 +1,40 @@
+package rewards
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAffinityDuration(t *testing.T) {
+	slow := Affinity{RewardFactor: 0.85, SpeedFactor: 0.8}
+
+	assert.Equal(t, 75*time.Minute, slow.Duration(time.Hour))
+	// Working the whole of the longer duration does the whole job, and is paid
+	// for it in full
+	assert.Equal(t, time.Hour, slow.Work(75*time.Minute))
+	rates := JobRates{ManaPerHour: 600, ExpPerHour: 120, Planned: time.Hour}
+	mana, exp := rates.Earned(slow.Work(slow.Duration(time.Hour)))
+	assert.Equal(t, int64(600), mana)
+	assert.Equal(t, int64(120), exp)
+
+	fast := Affinity{RewardFactor: 1, SpeedFactor: 1.25}
+	assert.Equal(t, 48*time.Minute, fast.Duration(time.Hour))
+
+	neutral := NeutralAffinity()
+	assert.Equal(t, time.Hour, neutral.Duration(time.Hour))
+	assert.Equal(t, 30*time.Minute, neutral.Work(30*time.Minute))
+}
+
+func TestAffinityModifier(t *testing.T) {
+	boosted, applied := NewPipeline(Affinity{RewardFactor: 0.85, SpeedFactor: 0.8}.Modifier()).Apply(600)
+	assert.Equal(t, int64(510), boosted)
+	if assert.Len(t, applied, 1) {
+		assert.Equal(t, SourceElement, applied[0].Source)
+	}
+
+	// A neutral affinity leaves no trace
+	_, applied = NewPipeline(NeutralAffinity().Modifier()).Apply(600)
+	assert.Empty(t, applied)
+}
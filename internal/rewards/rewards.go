@@ -0,0 +1,135 @@
+// Package rewards applies reward modifiers, such as realm mana boosts, to job
+// payouts and investment returns and records what each modifier contributed.
+package rewards
+
+import (
+	wizardpb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// Modifier sources
+const (
+	SourceRealm   = "realm"
+	SourceElement = "element"
+)
+
+// Reward types a modifier can be requested for
+const (
+	RewardTypeJob        = "job"
+	RewardTypeInvestment = "investment"
+)
+
+// Modifier scales a reward amount. A factor of 1.0 leaves the amount unchanged.
+type Modifier struct {
+	Name   string  `json:"name"`
+	Source string  `json:"source"`
+	Factor float64 `json:"factor"`
+}
+
+// Applied records the effect a modifier had on an amount
+type Applied struct {
+	Name   string  `json:"name"`
+	Source string  `json:"source"`
+	Factor float64 `json:"factor"`
+	Before int64   `json:"amount_before"`
+	After  int64   `json:"amount_after"`
+}
+
+// Pipeline applies modifiers in the order they were added
+type Pipeline struct {
+	modifiers []Modifier
+}
+
+func NewPipeline(modifiers ...Modifier) *Pipeline {
+	return &Pipeline{modifiers: modifiers}
+}
+
+// Add appends a modifier to the pipeline
+func (p *Pipeline) Add(modifier Modifier) {
+	p.modifiers = append(p.modifiers, modifier)
+}
+
+// Modifiers returns the modifiers in the pipeline
+func (p *Pipeline) Modifiers() []Modifier {
+	return p.modifiers
+}
+
+// Apply runs amount through every modifier and returns the final amount along with
+// the modifiers that changed it. Neutral modifiers (factor 1.0) are not recorded.
+func (p *Pipeline) Apply(amount int64) (int64, []Applied) {
+	var applied []Applied
+
+	for _, modifier := range p.modifiers {
+		if modifier.Factor == 1 || modifier.Factor <= 0 {
+			continue
+		}
+
+		before := amount
+		amount = int64(float64(amount) * modifier.Factor)
+
+		applied = append(applied, Applied{
+			Name:   modifier.Name,
+			Source: modifier.Source,
+			Factor: modifier.Factor,
+			Before: before,
+			After:  amount,
+		})
+	}
+
+	return amount, applied
+}
+
+// FromProto converts modifiers returned by the wizard service into a pipeline
+func FromProto(modifiers []*wizardpb.RewardModifier) *Pipeline {
+	pipeline := NewPipeline()
+	for _, modifier := range modifiers {
+		pipeline.Add(Modifier{
+			Name:   modifier.Name,
+			Source: modifier.Source,
+			Factor: modifier.Factor,
+		})
+	}
+	return pipeline
+}
+
+// ToProto converts modifiers into their wire form
+func ToProto(modifiers []Modifier) []*wizardpb.RewardModifier {
+	result := make([]*wizardpb.RewardModifier, 0, len(modifiers))
+	for _, modifier := range modifiers {
+		result = append(result, &wizardpb.RewardModifier{
+			Name:   modifier.Name,
+			Source: modifier.Source,
+			Factor: modifier.Factor,
+		})
+	}
+	return result
+}
+
+// AppliedToProto converts applied modifiers into their wire form
+func AppliedToProto(applied []Applied) []*wizardpb.AppliedRewardModifier {
+	result := make([]*wizardpb.AppliedRewardModifier, 0, len(applied))
+	for _, a := range applied {
+		result = append(result, &wizardpb.AppliedRewardModifier{
+			Name:         a.Name,
+			Source:       a.Source,
+			Factor:       a.Factor,
+			AmountBefore: a.Before,
+			AmountAfter:  a.After,
+		})
+	}
+	return result
+}
+
+// AppliedFromProto converts applied modifiers received over the wire
+func AppliedFromProto(applied []*wizardpb.AppliedRewardModifier) []Applied {
+	result := make([]Applied, 0, len(applied))
+	for _, a := range applied {
+		result = append(result, Applied{
+			Name:   a.Name,
+			Source: a.Source,
+			Factor: a.Factor,
+			Before: a.AmountBefore,
+			After:  a.AmountAfter,
+		})
+	}
+	return result
+}
@@ -0,0 +1,72 @@
+package rewards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipelineApply(t *testing.T) {
+	tests := []struct {
+		name        string
+		modifiers   []Modifier
+		amount      int64
+		expected    int64
+		appliedKeys []string
+	}{
+		{
+			name:     "no modifiers",
+			amount:   100,
+			expected: 100,
+		},
+		{
+			name:        "realm boost",
+			modifiers:   []Modifier{{Name: "Umbros", Source: SourceRealm, Factor: 1.2}},
+			amount:      150,
+			expected:    180,
+			appliedKeys: []string{"Umbros"},
+		},
+		{
+			name:      "neutral modifier is not recorded",
+			modifiers: []Modifier{{Name: "Terravine Hollow", Source: SourceRealm, Factor: 1.0}},
+			amount:    150,
+			expected:  150,
+		},
+		{
+			name: "modifiers compound in order",
+			modifiers: []Modifier{
+				{Name: "first", Source: SourceRealm, Factor: 1.5},
+				{Name: "second", Source: SourceRealm, Factor: 2},
+			},
+			amount:      10,
+			expected:    30,
+			appliedKeys: []string{"first", "second"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, applied := NewPipeline(tt.modifiers...).Apply(tt.amount)
+
+			assert.Equal(t, tt.expected, amount)
+			assert.Len(t, applied, len(tt.appliedKeys))
+			for i, key := range tt.appliedKeys {
+				assert.Equal(t, key, applied[i].Name)
+			}
+			if len(applied) > 0 {
+				assert.Equal(t, tt.amount, applied[0].Before)
+				assert.Equal(t, tt.expected, applied[len(applied)-1].After)
+			}
+		})
+	}
+}
+
+func TestProtoRoundTrip(t *testing.T) {
+	pipeline := NewPipeline(Modifier{Name: "Nyxthar", Source: SourceRealm, Factor: 1.25})
+
+	restored := FromProto(ToProto(pipeline.Modifiers()))
+	assert.Equal(t, pipeline.Modifiers(), restored.Modifiers())
+
+	_, applied := restored.Apply(400)
+	assert.Equal(t, applied, AppliedFromProto(AppliedToProto(applied)))
+}
@@ -0,0 +1,57 @@
+package rewards
+
+// Reward split rules for jobs worked by a party
+const (
+	// SplitEqual gives every member the same share
+	SplitEqual = "equal"
+	// SplitLevelWeighted gives each member a share in proportion to their level
+	SplitLevelWeighted = "level_weighted"
+)
+
+// Split divides total between shares in proportion to weights. Every share is
+// rounded down and the units left over go to the largest remainders, earliest
+// share first on ties, so the shares always add up to total. Weights that are
+// all zero or negative split total equally.
+func Split(total int64, weights []int64) []int64 {
+	shares := make([]int64, len(weights))
+	if len(weights) == 0 || total <= 0 {
+		return shares
+	}
+
+	var sum int64
+	for _, weight := range weights {
+		if weight > 0 {
+			sum += weight
+		}
+	}
+	if sum == 0 {
+		equal := make([]int64, len(weights))
+		for i := range equal {
+			equal[i] = 1
+		}
+		return Split(total, equal)
+	}
+
+	remainders := make([]int64, len(weights))
+	left := total
+	for i, weight := range weights {
+		if weight <= 0 {
+			continue
+		}
+		shares[i] = total * weight / sum
+		remainders[i] = total * weight % sum
+		left -= shares[i]
+	}
+
+	for ; left > 0; left-- {
+		largest := -1
+		for i, remainder := range remainders {
+			if weights[i] > 0 && (largest < 0 || remainder > remainders[largest]) {
+				largest = i
+			}
+		}
+		shares[largest]++
+		remainders[largest] = -1
+	}
+	return shares
+}
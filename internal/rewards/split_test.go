@@ -0,0 +1,43 @@
+package rewards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int64
+		weights  []int64
+		expected []int64
+	}{
+		{"equal", 300, []int64{1, 1, 1}, []int64{100, 100, 100}},
+		{"leftover goes to the earliest", 100, []int64{1, 1, 1}, []int64{34, 33, 33}},
+		{"level weighted", 100, []int64{5, 3, 2}, []int64{50, 30, 20}},
+		{"leftover goes to the largest remainder", 10, []int64{1, 2}, []int64{3, 7}},
+		{"zero weight gets nothing", 90, []int64{2, 0, 1}, []int64{60, 0, 30}},
+		{"no weights split equally", 10, []int64{0, 0}, []int64{5, 5}},
+		{"nothing to split", 0, []int64{1, 1}, []int64{0, 0}},
+		{"no shares", 100, nil, []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares := Split(tt.total, tt.weights)
+			assert.Equal(t, tt.expected, shares)
+		})
+	}
+}
+
+func TestSplitAddsUpToTotal(t *testing.T) {
+	weights := []int64{7, 13, 1, 29, 50}
+	for total := int64(0); total < 500; total += 7 {
+		var sum int64
+		for _, share := range Split(total, weights) {
+			sum += share
+		}
+		assert.Equal(t, total, sum, "total %d", total)
+	}
+}
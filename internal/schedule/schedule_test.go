@@ -0,0 +1,91 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronNext(t *testing.T) {
+	// A Wednesday
+	from := time.Date(2024, 1, 10, 9, 30, 20, 0, time.UTC)
+
+	tests := []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2024, 1, 10, 9, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 1, 10, 9, 45, 0, 0, time.UTC)},
+		{"0 */6 * * *", time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)},
+		{"30 9 * * *", time.Date(2024, 1, 11, 9, 30, 0, 0, time.UTC)},
+		{"0 8-10 * * 1-5", time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC)},
+		{"0 0 * * 0", time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 3 *", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// Either the 20th or a Friday
+		{"0 0 20 * 5", time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			cron, err := ParseCron(tt.expr)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expected, cron.Next(from))
+			}
+		})
+	}
+}
+
+func TestCronNextIsStrictlyAfter(t *testing.T) {
+	cron, err := ParseCron("0 * * * *")
+	assert.NoError(t, err)
+
+	onTheHour := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	assert.Equal(t, onTheHour.Add(time.Hour), cron.Next(onTheHour))
+}
+
+func TestParseCronRejectsInvalidExpressions(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"0 0 30 2 *",
+	} {
+		_, err := ParseCron(expr)
+		assert.Error(t, err, "expr %q", expr)
+	}
+}
+
+func TestNextAfterSkipsMissedRuns(t *testing.T) {
+	hourly, err := Every(time.Hour)
+	assert.NoError(t, err)
+
+	due := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+
+	// On time, the next run keeps to the schedule
+	assert.Equal(t, due.Add(time.Hour), NextAfter(hourly, due, due.Add(time.Minute)))
+	// Hours late, it runs again an hour from now rather than catching up
+	now := due.Add(5*time.Hour + 10*time.Minute)
+	assert.Equal(t, now.Add(time.Hour), NextAfter(hourly, due, now))
+
+	cron, err := ParseCron("0 * * * *")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 10, 15, 0, 0, 0, time.UTC), NextAfter(cron, due, now))
+}
+
+func TestEveryRejectsNonPositiveInterval(t *testing.T) {
+	_, err := Every(0)
+	assert.Error(t, err)
+}
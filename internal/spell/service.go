@@ -0,0 +1,554 @@
+package spell
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tectix/mysticfunds/internal/idempotency"
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/pkg/config"
+	"github.com/tectix/mysticfunds/pkg/logger"
+	pb "github.com/tectix/mysticfunds/proto/spell"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	// maxMasteryLevel matches the mastery_level CHECK constraint on wizard_spells
+	maxMasteryLevel = 10
+
+	// defaultMasteryThresholds are the times_cast counts needed to reach mastery levels 2 through 10
+	defaultMasteryThresholds = "5,15,30,50,75,105,140,180,225"
+)
+
+// levelRequirementPattern extracts the minimum level from requirement strings such as "Level 5+, Fire Affinity"
+var levelRequirementPattern = regexp.MustCompile(`(?i)level\s+(\d+)\+?`)
+
+var validRarities = map[string]bool{
+	"Common": true, "Uncommon": true, "Rare": true, "Epic": true, "Legendary": true, "Forbidden": true,
+}
+
+type SpellServiceImpl struct {
+	db                *sql.DB
+	idempotency       *idempotency.Store
+	cfg               *config.Config
+	logger            logger.Logger
+	masteryThresholds []int32
+	pb.UnimplementedSpellServiceServer
+}
+
+func NewSpellServiceImpl(db *sql.DB, cfg *config.Config, logger logger.Logger) *SpellServiceImpl {
+	thresholds, err := parseMasteryThresholds(cfg.GetString("SPELL_MASTERY_THRESHOLDS", defaultMasteryThresholds))
+	if err != nil {
+		logger.Warn("Invalid spell mastery thresholds, using defaults", "error", err)
+		thresholds, _ = parseMasteryThresholds(defaultMasteryThresholds)
+	}
+
+	return &SpellServiceImpl{
+		db:                db,
+		idempotency:       idempotency.NewStore(db, logger),
+		cfg:               cfg,
+		logger:            logger,
+		masteryThresholds: thresholds,
+	}
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+const spellColumns = `sp.id, sp.name, sp.description, sp.spell_school, sp.element, sp.power_level,
+	sp.mana_cost_to_learn, sp.mana_cost_to_cast, sp.requirements, sp.effects, sp.rarity, sp.created_at`
+
+func scanSpell(row rowScanner, extra ...interface{}) (*pb.Spell, error) {
+	var spell pb.Spell
+	var element, requirements sql.NullString
+	var createdAt sql.NullTime
+
+	dest := []interface{}{
+		&spell.Id, &spell.Name, &spell.Description, &spell.SpellSchool, &element, &spell.PowerLevel,
+		&spell.ManaCostToLearn, &spell.ManaCost, &requirements, &spell.Effects, &spell.Rarity, &createdAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	if element.Valid {
+		spell.Element = element.String
+	}
+	if requirements.Valid {
+		spell.Requirements = requirements.String
+	}
+	if createdAt.Valid {
+		spell.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+
+	return &spell, nil
+}
+
+func (s *SpellServiceImpl) CreateSpell(ctx context.Context, req *pb.CreateSpellRequest) (*pb.Spell, error) {
+	if req.Name == "" || req.SpellSchool == "" || req.Effects == "" {
+		return nil, status.Error(codes.InvalidArgument, "Name, spell school and effects are required")
+	}
+
+	if req.PowerLevel < 1 || req.PowerLevel > 10 {
+		return nil, status.Error(codes.InvalidArgument, "Power level must be between 1 and 10")
+	}
+
+	if req.ManaCost < 0 || req.ManaCostToLearn < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Mana costs cannot be negative")
+	}
+
+	if req.Rarity == "" {
+		req.Rarity = "Common"
+	}
+	if !validRarities[req.Rarity] {
+		return nil, status.Error(codes.InvalidArgument, "Invalid rarity")
+	}
+
+	var id int64
+	err := s.db.QueryRowContext(ctx,
+		`INSERT INTO spells (name, description, spell_school, element, power_level, mana_cost_to_learn,
+		                     mana_cost_to_cast, requirements, effects, rarity)
+		 VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $7, NULLIF($8, ''), $9, $10)
+		 RETURNING id`,
+		req.Name, req.Description, req.SpellSchool, req.Element, req.PowerLevel, req.ManaCostToLearn,
+		req.ManaCost, req.Requirements, req.Effects, req.Rarity).Scan(&id)
+	if err != nil {
+		s.logger.Error("Failed to create spell", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to create spell")
+	}
+
+	return s.getSpellByID(ctx, id)
+}
+
+func (s *SpellServiceImpl) GetSpell(ctx context.Context, req *pb.GetSpellRequest) (*pb.Spell, error) {
+	return s.getSpellByID(ctx, req.Id)
+}
+
+func (s *SpellServiceImpl) getSpellByID(ctx context.Context, id int64) (*pb.Spell, error) {
+	spell, err := scanSpell(s.db.QueryRowContext(ctx,
+		"SELECT "+spellColumns+" FROM spells sp WHERE sp.id = $1", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Spell not found")
+		}
+		s.logger.Error("Failed to get spell", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get spell")
+	}
+
+	return spell, nil
+}
+
+func (s *SpellServiceImpl) ListSpells(ctx context.Context, req *pb.ListSpellsRequest) (*pb.ListSpellsResponse, error) {
+	limit, offset := pagination(req.PageSize, req.PageNumber)
+
+	where := " WHERE 1=1"
+	args := []interface{}{}
+	argIndex := 1
+
+	if req.Element != "" {
+		where += fmt.Sprintf(" AND sp.element = $%d", argIndex)
+		args = append(args, req.Element)
+		argIndex++
+	}
+
+	if req.SpellSchool != "" {
+		where += fmt.Sprintf(" AND sp.spell_school = $%d", argIndex)
+		args = append(args, req.SpellSchool)
+		argIndex++
+	}
+
+	var totalCount int32
+	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM spells sp"+where, args...).Scan(&totalCount)
+	if err != nil {
+		s.logger.Error("Failed to count spells", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list spells")
+	}
+
+	query := "SELECT " + spellColumns + " FROM spells sp" + where +
+		fmt.Sprintf(" ORDER BY sp.power_level, sp.name LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	rows, err := s.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		s.logger.Error("Failed to list spells", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list spells")
+	}
+	defer rows.Close()
+
+	var spells []*pb.Spell
+	for rows.Next() {
+		spell, err := scanSpell(rows)
+		if err != nil {
+			s.logger.Error("Failed to scan spell row", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to list spells")
+		}
+		spells = append(spells, spell)
+	}
+
+	return &pb.ListSpellsResponse{
+		Spells:     spells,
+		TotalCount: totalCount,
+	}, nil
+}
+
+func (s *SpellServiceImpl) GetWizardSpells(ctx context.Context, req *pb.GetWizardSpellsRequest) (*pb.GetWizardSpellsResponse, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT `+spellColumns+`, ws.mastery_level, ws.times_cast, COALESCE(ws.learned_from_wizard_id, 0), ws.learned_at
+		 FROM wizard_spells ws
+		 JOIN spells sp ON ws.spell_id = sp.id
+		 WHERE ws.wizard_id = $1
+		 ORDER BY ws.learned_at`,
+		req.WizardId)
+	if err != nil {
+		s.logger.Error("Failed to get wizard spells", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get wizard spells")
+	}
+	defer rows.Close()
+
+	var spells []*pb.WizardSpell
+	for rows.Next() {
+		var wizardSpell pb.WizardSpell
+		var learnedAt sql.NullTime
+		spell, err := scanSpell(rows, &wizardSpell.MasteryLevel, &wizardSpell.TimesCast,
+			&wizardSpell.LearnedFromWizardId, &learnedAt)
+		if err != nil {
+			s.logger.Error("Failed to scan wizard spell row", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get wizard spells")
+		}
+
+		wizardSpell.Spell = spell
+		if learnedAt.Valid {
+			wizardSpell.LearnedAt = timestamppb.New(learnedAt.Time)
+		}
+		spells = append(spells, &wizardSpell)
+	}
+
+	return &pb.GetWizardSpellsResponse{Spells: spells}, nil
+}
+
+// LearnSpell teaches a spell directly, paying its learning cost to the system. Learning
+// from another wizard goes through the marketplace service instead.
+func (s *SpellServiceImpl) LearnSpell(ctx context.Context, req *pb.LearnSpellRequest) (*pb.LearnSpellResponse, error) {
+	return idempotency.DoTx(ctx, s.idempotency, "spell.LearnSpell", req.IdempotencyKey, req, func(tx *sql.Tx) (*pb.LearnSpellResponse, error) {
+		return s.learnSpell(ctx, tx, req)
+	})
+}
+
+func (s *SpellServiceImpl) learnSpell(ctx context.Context, tx *sql.Tx, req *pb.LearnSpellRequest) (*pb.LearnSpellResponse, error) {
+	var spellName string
+	var cost int64
+	var requirements sql.NullString
+	err := tx.QueryRowContext(ctx,
+		"SELECT name, mana_cost_to_learn, requirements FROM spells WHERE id = $1",
+		req.SpellId).Scan(&spellName, &cost, &requirements)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Spell not found")
+		}
+		s.logger.Error("Failed to get spell", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to learn spell")
+	}
+
+	var known bool
+	err = tx.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM wizard_spells WHERE wizard_id = $1 AND spell_id = $2)",
+		req.WizardId, req.SpellId).Scan(&known)
+	if err != nil {
+		s.logger.Error("Failed to check known spells", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to learn spell")
+	}
+
+	if known {
+		return nil, status.Error(codes.AlreadyExists, "Wizard already knows this spell")
+	}
+
+	remaining, err := s.debitWizard(ctx, tx, req.WizardId, requiredLevel(requirements.String),
+		ledger.Transfer("spell_learning", "Learned "+spellName, ledger.Wizard(req.WizardId), ledger.System(), cost))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO wizard_spells (wizard_id, spell_id) VALUES ($1, $2)",
+		req.WizardId, req.SpellId)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return nil, status.Error(codes.AlreadyExists, "Wizard already knows this spell")
+		}
+		s.logger.Error("Failed to record learned spell", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to learn spell")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO marketplace_transactions (buyer_wizard_id, transaction_type, item_id, mana_spent, notes)
+		 VALUES ($1, 'spell_learning', $2, $3, $4)`,
+		req.WizardId, req.SpellId, cost, "Learned "+spellName)
+	if err != nil {
+		s.logger.Error("Failed to record spell transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to learn spell")
+	}
+
+	err = s.logActivity(ctx, tx, req.WizardId, "spell_learned",
+		fmt.Sprintf("Learned spell: %s", spellName),
+		map[string]interface{}{
+			"spell_id":   req.SpellId,
+			"spell_name": spellName,
+			"mana_spent": cost,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LearnSpellResponse{
+		Success:       true,
+		Message:       fmt.Sprintf("Learned %s", spellName),
+		ManaSpent:     cost,
+		RemainingMana: remaining,
+	}, nil
+}
+
+// CastSpell spends the spell's casting cost, counts the cast and raises the wizard's
+// mastery of the spell once it crosses the next threshold.
+func (s *SpellServiceImpl) CastSpell(ctx context.Context, req *pb.CastSpellRequest) (*pb.CastSpellResponse, error) {
+	return idempotency.DoTx(ctx, s.idempotency, "spell.CastSpell", req.IdempotencyKey, req, func(tx *sql.Tx) (*pb.CastSpellResponse, error) {
+		return s.castSpell(ctx, tx, req)
+	})
+}
+
+func (s *SpellServiceImpl) castSpell(ctx context.Context, tx *sql.Tx, req *pb.CastSpellRequest) (*pb.CastSpellResponse, error) {
+	var spellName string
+	var cost int64
+	err := tx.QueryRowContext(ctx,
+		"SELECT name, mana_cost_to_cast FROM spells WHERE id = $1",
+		req.SpellId).Scan(&spellName, &cost)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Spell not found")
+		}
+		s.logger.Error("Failed to get spell", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to cast spell")
+	}
+
+	var masteryLevel, timesCast int32
+	err = tx.QueryRowContext(ctx,
+		`SELECT mastery_level, times_cast FROM wizard_spells
+		 WHERE wizard_id = $1 AND spell_id = $2 FOR UPDATE`,
+		req.WizardId, req.SpellId).Scan(&masteryLevel, &timesCast)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.FailedPrecondition, "Wizard does not know this spell")
+		}
+		s.logger.Error("Failed to get wizard spell", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to cast spell")
+	}
+
+	if req.TargetId > 0 {
+		var exists bool
+		err = tx.QueryRowContext(ctx,
+			"SELECT EXISTS(SELECT 1 FROM wizards WHERE id = $1)",
+			req.TargetId).Scan(&exists)
+		if err != nil {
+			s.logger.Error("Failed to check target wizard", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to cast spell")
+		}
+		if !exists {
+			return nil, status.Error(codes.NotFound, "Target wizard not found")
+		}
+	}
+
+	remaining, err := s.debitWizard(ctx, tx, req.WizardId, 0,
+		ledger.Transfer("spell_cast", "Cast "+spellName, ledger.Wizard(req.WizardId), ledger.System(), cost))
+	if err != nil {
+		return nil, err
+	}
+
+	timesCast++
+	newMastery := s.masteryLevel(timesCast)
+	if newMastery < masteryLevel {
+		// Never lower mastery that was granted some other way
+		newMastery = masteryLevel
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE wizard_spells SET times_cast = $1, mastery_level = $2
+		 WHERE wizard_id = $3 AND spell_id = $4`,
+		timesCast, newMastery, req.WizardId, req.SpellId)
+	if err != nil {
+		s.logger.Error("Failed to update wizard spell", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to cast spell")
+	}
+
+	metadata := map[string]interface{}{
+		"spell_id":      req.SpellId,
+		"spell_name":    spellName,
+		"mana_cost":     cost,
+		"times_cast":    timesCast,
+		"mastery_level": newMastery,
+	}
+	if req.TargetId > 0 {
+		metadata["target_id"] = req.TargetId
+	}
+	if newMastery > masteryLevel {
+		metadata["previous_mastery_level"] = masteryLevel
+	}
+
+	err = s.logActivity(ctx, tx, req.WizardId, "spell_cast",
+		fmt.Sprintf("Cast %s for %d mana", spellName, cost), metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Cast %s", spellName)
+	if newMastery > masteryLevel {
+		message = fmt.Sprintf("Cast %s - mastery increased to level %d", spellName, newMastery)
+	}
+
+	return &pb.CastSpellResponse{
+		Success:          true,
+		Message:          message,
+		ManaCost:         cost,
+		RemainingMana:    remaining,
+		TimesCast:        timesCast,
+		MasteryLevel:     newMastery,
+		MasteryIncreased: newMastery > masteryLevel,
+	}, nil
+}
+
+// masteryLevel returns the mastery level earned after timesCast casts
+func (s *SpellServiceImpl) masteryLevel(timesCast int32) int32 {
+	level := int32(1)
+	for _, threshold := range s.masteryThresholds {
+		if timesCast < threshold || level >= maxMasteryLevel {
+			break
+		}
+		level++
+	}
+
+	return level
+}
+
+// parseMasteryThresholds parses a comma separated, strictly increasing list of cast counts
+func parseMasteryThresholds(value string) ([]int32, error) {
+	var thresholds []int32
+	for _, part := range strings.Split(value, ",") {
+		threshold, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold %q: %w", part, err)
+		}
+
+		if threshold <= 0 {
+			return nil, fmt.Errorf("threshold %d must be positive", threshold)
+		}
+
+		if len(thresholds) > 0 && int32(threshold) <= thresholds[len(thresholds)-1] {
+			return nil, fmt.Errorf("thresholds must be strictly increasing")
+		}
+
+		thresholds = append(thresholds, int32(threshold))
+	}
+
+	if len(thresholds) > maxMasteryLevel-1 {
+		return nil, fmt.Errorf("at most %d thresholds are allowed", maxMasteryLevel-1)
+	}
+
+	return thresholds, nil
+}
+
+// debitWizard locks the wizard row, checks level and balance, and posts entry, which
+// must debit the wizard. It returns the remaining balance.
+func (s *SpellServiceImpl) debitWizard(ctx context.Context, tx *sql.Tx, wizardID int64, minLevel int32, entry ledger.Entry) (int64, error) {
+	amount := -entry.Amount(ledger.Wizard(wizardID))
+
+	var balance int64
+	var level int32
+	err := tx.QueryRowContext(ctx,
+		"SELECT mana_balance, level FROM wizards WHERE id = $1 FOR UPDATE",
+		wizardID).Scan(&balance, &level)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, status.Error(codes.NotFound, "Wizard not found")
+		}
+		s.logger.Error("Failed to lock wizard", "error", err)
+		return 0, status.Error(codes.Internal, "Failed to debit mana")
+	}
+
+	if level < minLevel {
+		return 0, status.Error(codes.FailedPrecondition,
+			fmt.Sprintf("Wizard level %d is below required level %d", level, minLevel))
+	}
+
+	if balance < amount {
+		return 0, status.Error(codes.FailedPrecondition, "Insufficient mana balance")
+	}
+
+	if amount == 0 {
+		return balance, nil
+	}
+
+	posted, err := ledger.Post(ctx, tx, entry)
+	if err != nil {
+		s.logger.Error("Failed to debit mana", "error", err)
+		return 0, status.Error(codes.Internal, "Failed to debit mana")
+	}
+
+	return posted.Balances[wizardID], nil
+}
+
+func (s *SpellServiceImpl) logActivity(ctx context.Context, tx *sql.Tx, wizardID int64, activityType, description string, metadata map[string]interface{}) error {
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return status.Error(codes.Internal, "Failed to encode activity metadata")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata)
+		 SELECT user_id, id, $2, $3, $4::jsonb FROM wizards WHERE id = $1`,
+		wizardID, activityType, description, string(metadataJSON))
+	if err != nil {
+		s.logger.Error("Failed to create activity log", "error", err)
+		return status.Error(codes.Internal, "Failed to create activity log")
+	}
+
+	return nil
+}
+
+func pagination(pageSize, pageNumber int32) (int32, int32) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	if pageNumber <= 0 {
+		pageNumber = 1
+	}
+
+	return pageSize, (pageNumber - 1) * pageSize
+}
+
+// requiredLevel returns the minimum wizard level named in a requirements string, or 0 if none
+func requiredLevel(requirements string) int32 {
+	match := levelRequirementPattern.FindStringSubmatch(requirements)
+	if match == nil {
+		return 0
+	}
+
+	level, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0
+	}
+
+	return int32(level)
+}
@@ -0,0 +1,206 @@
+package spell
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/internal/ledger/ledgertest"
+	"github.com/tectix/mysticfunds/pkg/config"
+	"github.com/tectix/mysticfunds/pkg/logger"
+	pb "github.com/tectix/mysticfunds/proto/spell"
+)
+
+func setupTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *SpellServiceImpl) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock database connection: %v", err)
+	}
+
+	cfg := &config.Config{
+		JWTSecret: "test_secret",
+	}
+	log := logger.NewLogger("debug")
+
+	return db, mock, NewSpellServiceImpl(db, cfg, log)
+}
+
+func TestCastSpell(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, mana_cost_to_cast FROM spells WHERE id = \\$1").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_cost_to_cast"}).AddRow("Flame Lance", 40))
+	mock.ExpectQuery("SELECT mastery_level, times_cast FROM wizard_spells").
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"mastery_level", "times_cast"}).AddRow(1, 2))
+	mock.ExpectQuery("SELECT mana_balance, level FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance", "level"}).AddRow(500, 3))
+	ledgertest.ExpectPost(mock, ledger.Transfer("spell_cast", "", ledger.Wizard(1), ledger.System(), 40),
+		map[int64]int64{1: 460})
+	mock.ExpectExec("UPDATE wizard_spells SET times_cast = \\$1, mastery_level = \\$2").
+		WithArgs(3, 1, 1, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(1, "spell_cast", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	resp, err := service.CastSpell(context.Background(), &pb.CastSpellRequest{
+		WizardId: 1,
+		SpellId:  3,
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(40), resp.ManaCost)
+	assert.Equal(t, int64(460), resp.RemainingMana)
+	assert.Equal(t, int32(3), resp.TimesCast)
+	assert.False(t, resp.MasteryIncreased)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCastSpellRaisesMastery(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, mana_cost_to_cast FROM spells WHERE id = \\$1").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_cost_to_cast"}).AddRow("Flame Lance", 40))
+	// The fifth cast crosses the first default threshold
+	mock.ExpectQuery("SELECT mastery_level, times_cast FROM wizard_spells").
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"mastery_level", "times_cast"}).AddRow(1, 4))
+	mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM wizards WHERE id = \\$1\\)").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery("SELECT mana_balance, level FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance", "level"}).AddRow(500, 3))
+	ledgertest.ExpectPost(mock, ledger.Transfer("spell_cast", "", ledger.Wizard(1), ledger.System(), 40),
+		map[int64]int64{1: 460})
+	mock.ExpectExec("UPDATE wizard_spells SET times_cast = \\$1, mastery_level = \\$2").
+		WithArgs(5, 2, 1, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(1, "spell_cast", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	resp, err := service.CastSpell(context.Background(), &pb.CastSpellRequest{
+		WizardId: 1,
+		SpellId:  3,
+		TargetId: 2,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), resp.MasteryLevel)
+	assert.True(t, resp.MasteryIncreased)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCastUnknownSpell(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, mana_cost_to_cast FROM spells WHERE id = \\$1").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_cost_to_cast"}).AddRow("Flame Lance", 40))
+	mock.ExpectQuery("SELECT mastery_level, times_cast FROM wizard_spells").
+		WithArgs(1, 3).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err := service.CastSpell(context.Background(), &pb.CastSpellRequest{
+		WizardId: 1,
+		SpellId:  3,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCastSpellInsufficientMana(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, mana_cost_to_cast FROM spells WHERE id = \\$1").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_cost_to_cast"}).AddRow("Flame Lance", 40))
+	mock.ExpectQuery("SELECT mastery_level, times_cast FROM wizard_spells").
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"mastery_level", "times_cast"}).AddRow(1, 0))
+	mock.ExpectQuery("SELECT mana_balance, level FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance", "level"}).AddRow(10, 1))
+	mock.ExpectRollback()
+
+	_, err := service.CastSpell(context.Background(), &pb.CastSpellRequest{
+		WizardId: 1,
+		SpellId:  3,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMasteryLevel(t *testing.T) {
+	thresholds, err := parseMasteryThresholds("2, 4, 8")
+	assert.NoError(t, err)
+
+	service := &SpellServiceImpl{masteryThresholds: thresholds}
+	tests := []struct {
+		timesCast int32
+		expected  int32
+	}{
+		{0, 1},
+		{1, 1},
+		{2, 2},
+		{7, 3},
+		{8, 4},
+		{1000, 4},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, service.masteryLevel(tt.timesCast), "times cast %d", tt.timesCast)
+	}
+
+	_, err = parseMasteryThresholds("5,3")
+	assert.Error(t, err)
+	_, err = parseMasteryThresholds("1,2,3,4,5,6,7,8,9,10")
+	assert.Error(t, err)
+}
+
+func TestLearnKnownSpell(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT name, mana_cost_to_learn, requirements FROM spells WHERE id = \\$1").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_cost_to_learn", "requirements"}).AddRow("Flame Lance", 1200, "Level 2+"))
+	mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM wizard_spells").
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	_, err := service.LearnSpell(context.Background(), &pb.LearnSpellRequest{
+		WizardId: 1,
+		SpellId:  3,
+	})
+
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
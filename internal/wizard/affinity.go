@@ -0,0 +1,109 @@
+package wizard
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tectix/mysticfunds/internal/rewards"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// elementAffinity returns how well a wizard of wizardElement works jobs of
+// jobElement. It reports false if the wizard cannot take such jobs at all.
+func (s *WizardServiceImpl) elementAffinity(ctx context.Context, q queryRower, wizardElement, jobElement string) (rewards.Affinity, bool, error) {
+	if wizardElement == jobElement {
+		return rewards.NeutralAffinity(), true, nil
+	}
+
+	var affinity rewards.Affinity
+	err := q.QueryRowContext(ctx,
+		"SELECT reward_factor, speed_factor FROM element_affinities WHERE wizard_element = $1 AND job_element = $2",
+		wizardElement, jobElement).Scan(&affinity.RewardFactor, &affinity.SpeedFactor)
+	if err == sql.ErrNoRows {
+		return affinity, false, nil
+	}
+	if err != nil {
+		return affinity, false, fmt.Errorf("get element affinity: %w", err)
+	}
+	return affinity, true, nil
+}
+
+// applyJobAffinities sets what each job would pay the wizard: how long it would
+// take them and the mana and experience it would pay in full, after the job's
+// realm boost and the wizard's element affinity
+func (s *WizardServiceImpl) applyJobAffinities(ctx context.Context, wizardId int64, jobs []*pb.Job) error {
+	var wizardElement string
+	err := s.db.QueryRowContext(ctx,
+		"SELECT element FROM wizards WHERE id = $1",
+		wizardId).Scan(&wizardElement)
+	if err == sql.ErrNoRows {
+		return status.Error(codes.NotFound, "Wizard not found")
+	}
+	if err != nil {
+		return fmt.Errorf("get wizard element: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT job_element, reward_factor, speed_factor FROM element_affinities WHERE wizard_element = $1",
+		wizardElement)
+	if err != nil {
+		return fmt.Errorf("load element affinities: %w", err)
+	}
+	defer rows.Close()
+
+	affinities := map[string]rewards.Affinity{wizardElement: rewards.NeutralAffinity()}
+	for rows.Next() {
+		var jobElement string
+		var affinity rewards.Affinity
+		if err := rows.Scan(&jobElement, &affinity.RewardFactor, &affinity.SpeedFactor); err != nil {
+			return fmt.Errorf("scan element affinity: %w", err)
+		}
+		affinities[jobElement] = affinity
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("load element affinities: %w", err)
+	}
+
+	realmModifiers := make(map[int64][]rewards.Modifier)
+	for _, job := range jobs {
+		jobAffinity := &pb.JobAffinity{
+			WizardId:      wizardId,
+			WizardElement: wizardElement,
+		}
+		job.Affinity = jobAffinity
+
+		affinity, ok := affinities[job.RequiredElement]
+		if !ok {
+			continue
+		}
+
+		modifiers, cached := realmModifiers[job.RealmId]
+		if !cached {
+			modifiers, err = s.rewardModifiers(ctx, s.db, wizardId, job.RealmId)
+			if err != nil {
+				return fmt.Errorf("get reward modifiers: %w", err)
+			}
+			realmModifiers[job.RealmId] = modifiers
+		}
+
+		planned := time.Duration(job.DurationMinutes) * time.Minute
+		baseMana, exp := jobBaseRewards(job.ManaRewardPerHour, job.ExpRewardPerHour, job.DurationMinutes, planned)
+		mana, _ := rewards.NewPipeline(modifiers...).Apply(int64(baseMana))
+		mana, _ = rewards.NewPipeline(affinity.Modifier()).Apply(mana)
+
+		jobAffinity.Eligible = true
+		jobAffinity.RewardFactor = affinity.RewardFactor
+		jobAffinity.SpeedFactor = affinity.SpeedFactor
+		jobAffinity.EffectiveDurationMinutes = int32(math.Ceil(affinity.Duration(planned).Minutes()))
+		jobAffinity.EffectiveManaReward = int32(mana)
+		jobAffinity.EffectiveExpReward = exp
+	}
+
+	return nil
+}
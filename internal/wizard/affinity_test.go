@@ -0,0 +1,195 @@
+package wizard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/internal/ledger/ledgertest"
+	"github.com/tectix/mysticfunds/pkg/sim"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// expectOpenJob sets up the availability and requirement reads of an hour-long
+// job for a wizard of element
+func expectOpenJob(mock sqlmock.Sqlmock, element, requiredElement string) {
+	mock.ExpectQuery("SELECT max_wizards, currently_assigned, duration_minutes").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"max_wizards", "currently_assigned", "duration_minutes", "party_job", "posted_job"}).AddRow(2, 0, 60, false, false))
+	mock.ExpectQuery("SELECT w.element, w.level FROM wizards w").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"element", "level"}).AddRow(element, 3))
+	mock.ExpectQuery("SELECT required_element, required_level, difficulty FROM jobs").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"required_element", "required_level", "difficulty"}).AddRow(requiredElement, 1, "Easy"))
+}
+
+func TestAssignWizardToJobWithElementAffinity(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch)
+
+	mock.ExpectBegin()
+	expectOpenJob(mock, "Fire", "Metal")
+	mock.ExpectQuery("SELECT reward_factor, speed_factor FROM element_affinities").
+		WithArgs("Fire", "Metal").
+		WillReturnRows(sqlmock.NewRows([]string{"reward_factor", "speed_factor"}).AddRow(0.85, 0.8))
+	mock.ExpectQuery("SELECT level FROM level_rewards WHERE reward_type = 'job_tier'").
+		WithArgs("Easy").
+		WillReturnRows(sqlmock.NewRows([]string{"level"}))
+	mock.ExpectQuery("INSERT INTO job_assignments").
+		WithArgs(9, 1, jobEpoch, 0.85, 0.8).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectExec("UPDATE jobs SET currently_assigned = currently_assigned \\+ 1").
+		WithArgs(9).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// At 0.8 speed the hour-long job takes 75 minutes
+	mock.ExpectExec("INSERT INTO job_progress").
+		WithArgs(5, jobEpoch, jobEpoch.Add(75*time.Minute)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(5, 9, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAssignmentReadBack(mock, 5, "in_progress", 0, jobEpoch)
+
+	_, err := service.AssignWizardToJob(context.Background(), &pb.AssignWizardToJobRequest{WizardId: 1, JobId: 9})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAssignWizardToJobWithoutElementAffinity(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	expectOpenJob(mock, "Water", "Fire")
+	mock.ExpectQuery("SELECT reward_factor, speed_factor FROM element_affinities").
+		WithArgs("Water", "Fire").
+		WillReturnRows(sqlmock.NewRows([]string{"reward_factor", "speed_factor"}))
+	mock.ExpectRollback()
+
+	_, err := service.AssignWizardToJob(context.Background(), &pb.AssignWizardToJobRequest{WizardId: 1, JobId: 9})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "Wizard element Water has no affinity with required element Fire")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCompleteJobAssignmentWithElementAffinity(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	now := jobEpoch.Add(75 * time.Minute)
+	service.clock = sim.NewFakeClock(now)
+
+	mock.ExpectBegin()
+	expectNoParty(mock, 5)
+	mock.ExpectQuery("SELECT ja.job_id, ja.wizard_id, j.realm_id").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"job_id", "wizard_id", "realm_id", "mana_reward_per_hour",
+			"exp_reward_per_hour", "duration_minutes", "experience_points", "level", "actual_start_time", "expected_end_time",
+			"reward_factor", "speed_factor", "poster_wizard_id", "reward_per_wizard"}).
+			AddRow(2, 1, 7, 600, 120, 60, 0, 1, jobEpoch, now, 0.85, 0.8, nil, nil))
+	mock.ExpectQuery("SELECT name, mana_boost_factor FROM realms WHERE id = \\$1").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows(nil))
+	// 75 minutes at 0.8 speed is the hour of work the job pays for, and the
+	// affinity takes 15% off the mana
+	mock.ExpectExec("UPDATE job_assignments SET status = 'completed'").
+		WithArgs(510, 120, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	ledgertest.ExpectPost(mock, ledger.Transfer("job_reward", "", ledger.System(), ledger.Wizard(1), 510),
+		map[int64]int64{1: 510})
+	mock.ExpectExec("UPDATE wizards SET experience_points = \\$1, level = \\$2").
+		WithArgs(120, 2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT level, reward_type, amount").
+		WithArgs(1, 2).
+		WillReturnRows(levelRewardRows())
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(1, 1, 2, 120, "[]").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE jobs SET currently_assigned = currently_assigned - 1").
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_progress SET progress_percentage = 100").
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(5, 510, 120, 600,
+			`[{"name":"Element affinity","source":"element","factor":0.85,"amount_before":600,"amount_after":510}]`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAssignmentReadBack(mock, 5, "completed", 510, now)
+
+	_, err := service.CompleteJobAssignment(context.Background(), &pb.CompleteJobAssignmentRequest{AssignmentId: 5})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListJobsShowsEffectiveRewardsForWizard(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	now := time.Now()
+	jobRows := sqlmock.NewRows([]string{"id", "realm_id", "realm_name", "title", "description",
+		"required_element", "required_level", "mana_reward_per_hour", "exp_reward_per_hour",
+		"duration_minutes", "max_wizards", "currently_assigned", "difficulty", "job_type",
+		"location", "special_requirements", "created_by_wizard_id", "created_at", "updated_at", "is_active",
+		"min_size", "composition", "reward_split", "poster_wizard_id", "reward_per_wizard", "escrow_remaining",
+		"posting_status", "expires_at", "closed_at", "template_id", "job_expires_at"})
+	for _, job := range []struct {
+		id, realmId int64
+		element     string
+	}{{1, 1, "Fire"}, {2, 10, "Metal"}, {3, 4, "Water"}} {
+		jobRows.AddRow(job.id, job.realmId, "Realm", "Job", "", job.element, 1, 600, 120,
+			60, 2, 0, "Easy", "Combat", nil, nil, nil, now, now, true, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	}
+	mock.ExpectQuery("SELECT j.id, j.realm_id").
+		WithArgs(10, 0).
+		WillReturnRows(jobRows)
+	mock.ExpectQuery("SELECT element FROM wizards WHERE id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"element"}).AddRow("Fire"))
+	mock.ExpectQuery("SELECT job_element, reward_factor, speed_factor FROM element_affinities").
+		WithArgs("Fire").
+		WillReturnRows(sqlmock.NewRows([]string{"job_element", "reward_factor", "speed_factor"}).AddRow("Metal", 0.85, 0.8))
+	mock.ExpectQuery("SELECT name, mana_boost_factor FROM realms WHERE id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_boost_factor"}).AddRow("Pyrrhian Flame", 1.0))
+	mock.ExpectQuery("SELECT name, mana_boost_factor FROM realms WHERE id = \\$1").
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_boost_factor"}).AddRow("Technarok", 1.2))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM jobs").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	resp, err := service.ListJobs(context.Background(), &pb.ListJobsRequest{PageSize: 10, PageNumber: 1, WizardId: 1})
+
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Jobs, 3) {
+		own := resp.Jobs[0].Affinity
+		assert.True(t, own.Eligible)
+		assert.Equal(t, int32(60), own.EffectiveDurationMinutes)
+		assert.Equal(t, int32(600), own.EffectiveManaReward)
+
+		// Boosted by the realm, then scaled by the affinity
+		kindred := resp.Jobs[1].Affinity
+		assert.True(t, kindred.Eligible)
+		assert.Equal(t, int32(75), kindred.EffectiveDurationMinutes)
+		assert.Equal(t, int32(612), kindred.EffectiveManaReward)
+		assert.Equal(t, int32(120), kindred.EffectiveExpReward)
+
+		assert.False(t, resp.Jobs[2].Affinity.Eligible)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
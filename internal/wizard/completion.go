@@ -0,0 +1,320 @@
+package wizard
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tectix/mysticfunds/pkg/logger"
+	"github.com/tectix/mysticfunds/pkg/sim"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// JobCompletionScheduler completes job assignments when they are due. Progress is
+// not stored while a job runs: it is a function of the assignment's start and
+// expected end time, worked out whenever it is read.
+type JobCompletionScheduler struct {
+	db           *sql.DB
+	logger       logger.Logger
+	clock        sim.Clock
+	service      *WizardServiceImpl
+	pollInterval time.Duration
+	batchSize    int
+	retryBackoff time.Duration
+	maxBackoff   time.Duration
+	runMutex     sync.Mutex
+	running      bool
+	done         chan struct{}
+	wake         chan struct{}
+	mutex        sync.Mutex
+	active       map[int64]sim.Timer
+}
+
+// NewJobCompletionScheduler creates a scheduler that reads the time from clock
+func NewJobCompletionScheduler(db *sql.DB, logger logger.Logger, service *WizardServiceImpl, clock sim.Clock) *JobCompletionScheduler {
+	return &JobCompletionScheduler{
+		db:           db,
+		logger:       logger,
+		clock:        clock,
+		service:      service,
+		pollInterval: time.Minute,
+		batchSize:    100,
+		retryBackoff: 30 * time.Second,
+		maxBackoff:   30 * time.Minute,
+		done:         make(chan struct{}),
+		wake:         make(chan struct{}, 1),
+		active:       make(map[int64]sim.Timer),
+	}
+}
+
+// Start begins completing due assignments. job_progress is the queue, ordered by
+// expected_end_time. Only the leading replica runs the scheduler, and completing
+// an assignment locks it, so each is still completed once during a failover.
+func (js *JobCompletionScheduler) Start() {
+	js.runMutex.Lock()
+	defer js.runMutex.Unlock()
+
+	if js.running {
+		js.logger.Info("Job completion scheduler already running")
+		return
+	}
+
+	js.running = true
+	js.done = make(chan struct{})
+	js.logger.Info("Starting job completion scheduler")
+
+	go js.workRoutine(js.done)
+}
+
+// Stop halts the scheduler and drops its wake-up timers
+func (js *JobCompletionScheduler) Stop() {
+	js.runMutex.Lock()
+	defer js.runMutex.Unlock()
+
+	if !js.running {
+		return
+	}
+
+	js.running = false
+	close(js.done)
+
+	js.mutex.Lock()
+	defer js.mutex.Unlock()
+
+	for _, timer := range js.active {
+		timer.Stop()
+	}
+	js.active = make(map[int64]sim.Timer)
+	js.logger.Info("Job completion scheduler stopped")
+}
+
+// IsRunning returns whether the scheduler is currently running
+func (js *JobCompletionScheduler) IsRunning() bool {
+	js.runMutex.Lock()
+	defer js.runMutex.Unlock()
+	return js.running
+}
+
+// ScheduleJobCompletion wakes the worker as soon as the assignment is due. The
+// timer only saves waiting for the next poll; the poll finds the assignment
+// either way, even if another replica created it.
+func (js *JobCompletionScheduler) ScheduleJobCompletion(assignmentId int64, endTime time.Time) {
+	js.mutex.Lock()
+	defer js.mutex.Unlock()
+
+	if timer, exists := js.active[assignmentId]; exists {
+		timer.Stop()
+		delete(js.active, assignmentId)
+	}
+
+	duration := endTime.Sub(js.clock.Now())
+	if duration <= 0 {
+		js.notify()
+		return
+	}
+
+	js.active[assignmentId] = js.clock.AfterFunc(duration, func() {
+		js.mutex.Lock()
+		delete(js.active, assignmentId)
+		js.mutex.Unlock()
+		js.notify()
+	})
+}
+
+// CancelJobCompletion drops the wake-up timer of an assignment that has been
+// completed or cancelled before it was due
+func (js *JobCompletionScheduler) CancelJobCompletion(assignmentId int64) {
+	js.mutex.Lock()
+	defer js.mutex.Unlock()
+
+	if timer, exists := js.active[assignmentId]; exists {
+		timer.Stop()
+		delete(js.active, assignmentId)
+	}
+}
+
+// notify asks the worker to poll now. A wake-up that is already queued covers
+// this one too.
+func (js *JobCompletionScheduler) notify() {
+	select {
+	case js.wake <- struct{}{}:
+	default:
+	}
+}
+
+// workRoutine runs until done is closed. A restarted scheduler gets a new done, so
+// a routine that is still finishing a batch after Stop exits on its own.
+func (js *JobCompletionScheduler) workRoutine(done chan struct{}) {
+	ticker := js.clock.NewTicker(js.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		if err := js.processDue(ctx); err != nil {
+			js.logger.Error("Failed to complete due job assignments", "error", err)
+		}
+		cancel()
+
+		select {
+		case <-ticker.C():
+		case <-js.wake:
+		case <-done:
+			js.logger.Info("Job completion scheduler terminated")
+			return
+		}
+	}
+}
+
+// dueAssignment is a running assignment whose expected end time has passed
+type dueAssignment struct {
+	id       int64
+	attempts int
+}
+
+// processDue completes the assignments whose expected end time has passed, a
+// batch at a time, until none are left. An assignment that fails to complete is
+// retried with backoff rather than holding up the rest of the queue.
+func (js *JobCompletionScheduler) processDue(ctx context.Context) error {
+	for {
+		assignments, err := js.dueAssignments(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, assignment := range assignments {
+			js.logger.Info("Auto-completing finished job", "assignment_id", assignment.id)
+
+			_, err := js.service.CompleteJobAssignment(ctx, &pb.CompleteJobAssignmentRequest{
+				AssignmentId: assignment.id,
+			})
+			if status.Code(err) == codes.NotFound {
+				// Completed or cancelled since it was read, possibly by another replica
+				continue
+			}
+			if err != nil {
+				if recordErr := js.recordFailure(ctx, assignment, err); recordErr != nil {
+					// Without the backoff it would be picked straight up again
+					return fmt.Errorf("record failed completion of assignment %d: %w", assignment.id, recordErr)
+				}
+			}
+		}
+
+		if len(assignments) < js.batchSize {
+			return nil
+		}
+	}
+}
+
+// recordFailure pushes the assignment's next completion attempt back with
+// exponential backoff. Nothing has been paid for the assignment yet, so it is
+// retried for as long as it keeps failing rather than given up on. Resuming the
+// assignment resets the attempts.
+func (js *JobCompletionScheduler) recordFailure(ctx context.Context, assignment dueAssignment, cause error) error {
+	attempts := assignment.attempts + 1
+	delay := js.backoff(attempts)
+	js.logger.Warn("Failed to auto-complete job, will retry",
+		"error", cause, "assignment_id", assignment.id, "attempts", attempts, "retryIn", delay)
+
+	_, err := js.db.ExecContext(ctx, `
+		UPDATE job_progress
+		SET completion_attempts = $1, last_completion_error = $2, next_completion_at = $3
+		WHERE assignment_id = $4 AND is_active = true`,
+		attempts, cause.Error(), js.clock.Now().Add(delay), assignment.id)
+	return err
+}
+
+// backoff doubles the retry delay with every attempt, up to maxBackoff
+func (js *JobCompletionScheduler) backoff(attempts int) time.Duration {
+	delay := js.retryBackoff
+	for i := 1; i < attempts && delay < js.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > js.maxBackoff {
+		delay = js.maxBackoff
+	}
+	return delay
+}
+
+// dueAssignments returns the next batch of running assignments that are due and
+// not waiting out a retry, earliest first
+func (js *JobCompletionScheduler) dueAssignments(ctx context.Context) ([]dueAssignment, error) {
+	rows, err := js.db.QueryContext(ctx, `
+		SELECT jp.assignment_id, jp.completion_attempts
+		FROM job_progress jp
+		JOIN job_assignments ja ON jp.assignment_id = ja.id
+		WHERE jp.is_active = true
+		AND jp.expected_end_time <= $1
+		AND (jp.next_completion_at IS NULL OR jp.next_completion_at <= $1)
+		AND ja.status IN ('assigned', 'in_progress')
+		ORDER BY jp.expected_end_time
+		LIMIT $2`,
+		js.clock.Now(), js.batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("load due assignments: %w", err)
+	}
+	defer rows.Close()
+
+	var assignments []dueAssignment
+	for rows.Next() {
+		var assignment dueAssignment
+		if err := rows.Scan(&assignment.id, &assignment.attempts); err != nil {
+			return nil, fmt.Errorf("scan due assignment: %w", err)
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, rows.Err()
+}
+
+// progressAt is how far a job that runs from start to end has got at now, and
+// how many minutes have been worked on it
+func progressAt(start, end, now time.Time) (int32, int32) {
+	elapsed := now.Sub(start)
+	if elapsed <= 0 {
+		return 0, 0
+	}
+
+	total := end.Sub(start)
+	if elapsed >= total {
+		return 100, int32(total.Minutes())
+	}
+	return int32(float64(elapsed) / float64(total) * 100), int32(elapsed.Minutes())
+}
+
+// workedAt is how long a job that runs from start to end has been worked on at
+// now. Time after the end is not counted.
+func workedAt(start, end, now time.Time) time.Duration {
+	if now.After(end) {
+		now = end
+	}
+	if worked := now.Sub(start); worked > 0 {
+		return worked
+	}
+	return 0
+}
+
+// applyProgress fills in the progress of a job as of now. Only a running job moves;
+// a paused or finished one keeps what was stored. Stored progress is only ahead of
+// the clock if it was reported through UpdateJobProgress.
+func applyProgress(progress *pb.JobProgress, actualStartTime, expectedEndTime, pausedAt sql.NullTime, now time.Time) {
+	if expectedEndTime.Valid {
+		progress.ExpectedEndTime = timestamppb.New(expectedEndTime.Time)
+	}
+	if pausedAt.Valid {
+		progress.PausedAt = timestamppb.New(pausedAt.Time)
+	}
+	if !progress.IsActive || !actualStartTime.Valid || !expectedEndTime.Valid {
+		return
+	}
+
+	percentage, timeWorked := progressAt(actualStartTime.Time, expectedEndTime.Time, now)
+	if percentage > progress.ProgressPercentage {
+		progress.ProgressPercentage = percentage
+	}
+	progress.TimeWorkedMinutes = timeWorked
+}
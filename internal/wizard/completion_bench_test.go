@@ -0,0 +1,190 @@
+package wizard
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tectix/mysticfunds/pkg/logger"
+	"github.com/tectix/mysticfunds/pkg/sim"
+)
+
+// activeAssignments is how many jobs are running in the load benchmarks
+const activeAssignments = 10000
+
+// loadDB is a database/sql driver that answers every query from fixed rows and
+// counts the statements and rows that pass through it, standing in for the load
+// the same work puts on Postgres
+type loadDB struct {
+	rows       func(query string) ([]string, [][]driver.Value)
+	statements atomic.Int64
+	rowsRead   atomic.Int64
+}
+
+func (l *loadDB) Connect(context.Context) (driver.Conn, error) { return loadConn{l}, nil }
+func (l *loadDB) Driver() driver.Driver                        { return loadDriver{l} }
+
+type loadDriver struct{ db *loadDB }
+
+func (d loadDriver) Open(string) (driver.Conn, error) { return loadConn{d.db}, nil }
+
+type loadConn struct{ db *loadDB }
+
+func (c loadConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c loadConn) Close() error                        { return nil }
+func (c loadConn) Begin() (driver.Tx, error)           { return c, nil }
+func (c loadConn) Commit() error                       { return nil }
+func (c loadConn) Rollback() error                     { return nil }
+
+func (c loadConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.db.statements.Add(1)
+	columns, values := c.db.rows(query)
+	c.db.rowsRead.Add(int64(len(values)))
+	return &loadRows{columns: columns, values: values}, nil
+}
+
+func (c loadConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	c.db.statements.Add(1)
+	return driver.RowsAffected(1), nil
+}
+
+type loadRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *loadRows) Columns() []string { return r.columns }
+func (r *loadRows) Close() error      { return nil }
+
+func (r *loadRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func (l *loadDB) report(b *testing.B) {
+	b.ReportMetric(float64(l.statements.Load())/float64(b.N), "statements/op")
+	b.ReportMetric(float64(l.rowsRead.Load())/float64(b.N), "rows/op")
+}
+
+// runningJobs returns the progress rows of activeAssignments one-hour jobs started
+// over the last hour, none of them due yet, as the ticker stored them one tick ago
+func runningJobs(now time.Time) [][]driver.Value {
+	values := make([][]driver.Value, activeAssignments)
+	for i := range values {
+		start := now.Add(-time.Duration(i) * time.Hour / activeAssignments)
+		stored, _ := progressAt(start, start.Add(time.Hour), now.Add(-5*time.Second))
+		values[i] = []driver.Value{int64(i + 1), int64(i + 1), start, start.Add(time.Hour),
+			int64(stored), true, int64(60), "in_progress"}
+	}
+	return values
+}
+
+// BenchmarkJobProgressPolling is the load of one tick of the polling ticker this
+// scheduler replaced: every five seconds it read every running job and wrote back
+// the progress of each one that had moved, then looked for finished jobs.
+func BenchmarkJobProgressPolling(b *testing.B) {
+	now := jobEpoch
+	jobs := runningJobs(now)
+	load := &loadDB{rows: func(query string) ([]string, [][]driver.Value) {
+		if strings.Contains(query, "jp.actual_start_time") {
+			return []string{"id", "assignment_id", "actual_start_time", "expected_end_time",
+				"progress_percentage", "is_active", "duration_minutes", "status"}, jobs
+		}
+		return []string{"assignment_id"}, nil
+	}}
+	db := sql.OpenDB(load)
+	defer db.Close()
+
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := pollJobProgress(ctx, db, now); err != nil {
+			b.Fatal(err)
+		}
+	}
+	load.report(b)
+}
+
+// pollJobProgress issues the statements of one tick of the old ticker
+func pollJobProgress(ctx context.Context, db *sql.DB, now time.Time) error {
+	rows, err := db.QueryContext(ctx, `SELECT jp.id, jp.assignment_id, jp.actual_start_time, jp.expected_end_time,
+		jp.progress_percentage, jp.is_active, j.duration_minutes, ja.status FROM job_progress jp`)
+	if err != nil {
+		return err
+	}
+
+	type update struct {
+		progressID   int64
+		progress     int32
+		timeWorked   int32
+		assignmentID int64
+	}
+	var updates []update
+	for rows.Next() {
+		var u update
+		var start, end time.Time
+		var stored, duration int32
+		var isActive bool
+		var assignmentStatus string
+		if err := rows.Scan(&u.progressID, &u.assignmentID, &start, &end, &stored, &isActive, &duration, &assignmentStatus); err != nil {
+			rows.Close()
+			return err
+		}
+		if u.progress, u.timeWorked = progressAt(start, end, now); u.progress > stored {
+			updates = append(updates, u)
+		}
+	}
+	rows.Close()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, u := range updates {
+		if _, err := tx.ExecContext(ctx, "UPDATE job_progress SET progress_percentage = $1, time_worked_minutes = $2 WHERE id = $3",
+			u.progress, u.timeWorked, u.progressID); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	finished, err := db.QueryContext(ctx, "SELECT jp.assignment_id FROM job_progress jp WHERE jp.progress_percentage >= 100")
+	if err != nil {
+		return err
+	}
+	return finished.Close()
+}
+
+// BenchmarkJobCompletionQueue is the load of one poll of the completion scheduler
+// with the same jobs running. Only due rows are read, so with none due the poll
+// is a single indexed lookup. Completing a due job costs the same either way.
+func BenchmarkJobCompletionQueue(b *testing.B) {
+	load := &loadDB{rows: func(string) ([]string, [][]driver.Value) {
+		return []string{"assignment_id"}, nil
+	}}
+	db := sql.OpenDB(load)
+	defer db.Close()
+
+	scheduler := NewJobCompletionScheduler(db, logger.NewLogger("error"), nil, sim.NewFakeClock(jobEpoch))
+
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := scheduler.processDue(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+	load.report(b)
+}
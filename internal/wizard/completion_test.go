@@ -0,0 +1,217 @@
+package wizard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tectix/mysticfunds/pkg/sim"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+var jobEpoch = time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+func TestProgressAt(t *testing.T) {
+	end := jobEpoch.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		now        time.Time
+		percentage int32
+		timeWorked int32
+	}{
+		{"not started", jobEpoch.Add(-time.Minute), 0, 0},
+		{"just started", jobEpoch, 0, 0},
+		{"half way", jobEpoch.Add(30 * time.Minute), 50, 30},
+		{"due", end, 100, 60},
+		{"overdue", end.Add(time.Hour), 100, 60},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			percentage, timeWorked := progressAt(jobEpoch, end, tt.now)
+			assert.Equal(t, tt.percentage, percentage)
+			assert.Equal(t, tt.timeWorked, timeWorked)
+		})
+	}
+}
+
+func TestGetJobProgressFollowsClock(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	clock := sim.NewFakeClock(jobEpoch)
+	service.clock = clock
+
+	progressRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "assignment_id", "started_at", "last_updated_at",
+			"progress_percentage", "time_worked_minutes", "is_active", "created_at",
+			"actual_start_time", "expected_end_time", "paused_at", "paused_minutes"}).
+			AddRow(7, 3, jobEpoch, jobEpoch, 0, 0, true, jobEpoch, jobEpoch, jobEpoch.Add(time.Hour), nil, 0)
+	}
+
+	// Nothing is written while the job runs; progress is worked out on read
+	clock.Advance(30 * time.Minute)
+	mock.ExpectQuery("SELECT (.+) FROM job_progress jp WHERE jp.assignment_id = \\$1").
+		WithArgs(3).
+		WillReturnRows(progressRows())
+
+	progress, err := service.GetJobProgress(context.Background(), &pb.GetJobProgressRequest{AssignmentId: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(50), progress.ProgressPercentage)
+	assert.Equal(t, int32(30), progress.TimeWorkedMinutes)
+
+	clock.Advance(45 * time.Minute)
+	mock.ExpectQuery("SELECT (.+) FROM job_progress jp WHERE jp.assignment_id = \\$1").
+		WithArgs(3).
+		WillReturnRows(progressRows())
+
+	progress, err = service.GetJobProgress(context.Background(), &pb.GetJobProgressRequest{AssignmentId: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(100), progress.ProgressPercentage)
+	assert.Equal(t, int32(60), progress.TimeWorkedMinutes)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProcessDueSkipsAssignmentsCompletedElsewhere(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	clock := sim.NewFakeClock(jobEpoch)
+	scheduler := NewJobCompletionScheduler(db, service.logger, service, clock)
+
+	mock.ExpectQuery("SELECT jp.assignment_id, jp.completion_attempts FROM job_progress jp").
+		WithArgs(jobEpoch, scheduler.batchSize).
+		WillReturnRows(sqlmock.NewRows([]string{"assignment_id", "completion_attempts"}).AddRow(3, 0))
+	// Another replica completed it after it was read
+	mock.ExpectBegin()
+	expectNoParty(mock, 3)
+	mock.ExpectQuery("SELECT ja.job_id, ja.wizard_id, j.realm_id(.+)FOR UPDATE OF ja").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows(nil))
+	mock.ExpectRollback()
+
+	assert.NoError(t, scheduler.processDue(context.Background()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProcessDueRetriesFailedAssignmentsWithBackoff(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	clock := sim.NewFakeClock(jobEpoch)
+	scheduler := NewJobCompletionScheduler(db, service.logger, service, clock)
+
+	mock.ExpectQuery("SELECT jp.assignment_id, jp.completion_attempts FROM job_progress jp").
+		WithArgs(jobEpoch, scheduler.batchSize).
+		WillReturnRows(sqlmock.NewRows([]string{"assignment_id", "completion_attempts"}).
+			AddRow(3, 1).
+			AddRow(4, 0))
+
+	// The first fails on its second attempt and backs off for a minute
+	mock.ExpectBegin()
+	expectNoParty(mock, 3)
+	mock.ExpectQuery("SELECT ja.job_id, ja.wizard_id, j.realm_id(.+)FOR UPDATE OF ja").
+		WithArgs(3).
+		WillReturnError(assert.AnError)
+	mock.ExpectRollback()
+	mock.ExpectExec("UPDATE job_progress SET completion_attempts = \\$1").
+		WithArgs(2, sqlmock.AnyArg(), jobEpoch.Add(time.Minute), 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// The one behind it is still reached
+	mock.ExpectBegin()
+	expectNoParty(mock, 4)
+	mock.ExpectQuery("SELECT ja.job_id, ja.wizard_id, j.realm_id(.+)FOR UPDATE OF ja").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows(nil))
+	mock.ExpectRollback()
+
+	assert.NoError(t, scheduler.processDue(context.Background()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResumedAssignmentBacksOffFromTheStart(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	// Paused after several failed completions, resumed 30 minutes later
+	pausedAt := jobEpoch.Add(20 * time.Minute)
+	now := pausedAt.Add(30 * time.Minute)
+	clock := sim.NewFakeClock(now)
+	service.clock = clock
+	scheduler := NewJobCompletionScheduler(db, service.logger, service, clock)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT ja.status, jp.actual_start_time, jp.expected_end_time, jp.paused_at").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "actual_start_time", "expected_end_time", "paused_at"}).
+			AddRow("paused", jobEpoch, jobEpoch, pausedAt))
+	mock.ExpectExec("UPDATE job_progress SET (.+)completion_attempts = 0, last_completion_error = NULL, next_completion_at = NULL").
+		WithArgs(jobEpoch.Add(30*time.Minute), jobEpoch.Add(30*time.Minute), 30, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_assignments SET status = 'in_progress'").
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAssignmentReadBack(mock, 5, "in_progress", 0, jobEpoch)
+
+	_, err := service.ResumeJobAssignment(context.Background(), &pb.ResumeJobAssignmentRequest{AssignmentId: 5})
+	assert.NoError(t, err)
+
+	// The reset attempts mean the next failure waits the first backoff, not the last
+	mock.ExpectQuery("SELECT jp.assignment_id, jp.completion_attempts FROM job_progress jp").
+		WithArgs(now, scheduler.batchSize).
+		WillReturnRows(sqlmock.NewRows([]string{"assignment_id", "completion_attempts"}).AddRow(5, 0))
+	mock.ExpectBegin()
+	expectNoParty(mock, 5)
+	mock.ExpectQuery("SELECT ja.job_id, ja.wizard_id, j.realm_id(.+)FOR UPDATE OF ja").
+		WithArgs(5).
+		WillReturnError(assert.AnError)
+	mock.ExpectRollback()
+	mock.ExpectExec("UPDATE job_progress SET completion_attempts = \\$1").
+		WithArgs(1, sqlmock.AnyArg(), now.Add(scheduler.retryBackoff), 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, scheduler.processDue(context.Background()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestJobCompletionBackoffIsCapped(t *testing.T) {
+	scheduler := NewJobCompletionScheduler(nil, nil, nil, sim.NewFakeClock(jobEpoch))
+
+	assert.Equal(t, 30*time.Second, scheduler.backoff(1))
+	assert.Equal(t, time.Minute, scheduler.backoff(2))
+	assert.Equal(t, 4*time.Minute, scheduler.backoff(4))
+	assert.Equal(t, 30*time.Minute, scheduler.backoff(20))
+}
+
+func TestScheduleJobCompletionWakesWhenDue(t *testing.T) {
+	db, _, service := setupTest(t)
+	defer db.Close()
+
+	clock := sim.NewFakeClock(jobEpoch)
+	scheduler := NewJobCompletionScheduler(db, service.logger, service, clock)
+
+	scheduler.ScheduleJobCompletion(3, jobEpoch.Add(time.Hour))
+
+	clock.Advance(59 * time.Minute)
+	assert.Len(t, scheduler.wake, 0)
+
+	clock.Advance(time.Minute)
+	assert.Len(t, scheduler.wake, 1)
+	assert.Empty(t, scheduler.active)
+
+	// A cancelled assignment never wakes the worker
+	<-scheduler.wake
+	scheduler.ScheduleJobCompletion(4, jobEpoch.Add(2*time.Hour))
+	scheduler.CancelJobCompletion(4)
+	clock.Advance(2 * time.Hour)
+	assert.Len(t, scheduler.wake, 0)
+}
@@ -0,0 +1,198 @@
+package wizard
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tectix/mysticfunds/internal/idempotency"
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/internal/ledger/ledgertest"
+	"github.com/tectix/mysticfunds/pkg/config"
+	"github.com/tectix/mysticfunds/pkg/logger"
+	"github.com/tectix/mysticfunds/pkg/sim"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// expectBalanceLock expects a wizard's row to be locked, leaving it out if
+// balance is nil
+func expectBalanceLock(mock sqlmock.Sqlmock, wizardId int64, balance interface{}) {
+	rows := sqlmock.NewRows([]string{"mana_balance"})
+	if balance != nil {
+		rows.AddRow(balance)
+	}
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(wizardId).
+		WillReturnRows(rows)
+}
+
+func TestLockBalancesLocksEachWizardOnceInIDOrder(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	expectBalanceLock(mock, 1, 100)
+	expectBalanceLock(mock, 4, nil)
+	expectBalanceLock(mock, 7, 300)
+	mock.ExpectCommit()
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	balances, err := service.lockBalances(context.Background(), tx, 7, 1, 4, 7, 1)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	// Wizard 4 does not exist, so it is left out
+	assert.Equal(t, map[int64]int64{1: 100, 7: 300}, balances)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestOpposingTransfersLockInTheSameOrder checks the ordering that keeps two
+// transfers between the same wizards, in opposite directions, from deadlocking:
+// both lock the lower id first, so whichever locks it first goes through and
+// the other waits without holding anything.
+func TestOpposingTransfersLockInTheSameOrder(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	for _, transfer := range []struct {
+		from, to int64
+		balances map[int64]int64
+	}{
+		{3, 8, map[int64]int64{3: 400, 8: 600}},
+		{8, 3, map[int64]int64{3: 500, 8: 500}},
+	} {
+		mock.ExpectBegin()
+		expectBalanceLock(mock, 3, 500)
+		expectBalanceLock(mock, 8, 500)
+		ledgertest.ExpectPost(mock, ledger.Transfer("transfer", "", ledger.Wizard(transfer.from), ledger.Wizard(transfer.to), 100),
+			transfer.balances)
+		mock.ExpectExec("INSERT INTO activity_logs").
+			WithArgs(transfer.from, sqlmock.AnyArg(), 100, transfer.to).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO activity_logs").
+			WithArgs(transfer.to, sqlmock.AnyArg(), 100, transfer.from).
+			WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectCommit()
+	}
+
+	for _, transfer := range [][2]int64{{3, 8}, {8, 3}} {
+		resp, err := service.TransferMana(context.Background(), &pb.TransferManaRequest{
+			FromWizardId: transfer[0],
+			ToWizardId:   transfer[1],
+			Amount:       100,
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Success)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestConcurrentTransfers hammers TransferMana against a real wizard database.
+// It only runs when WIZARD_TEST_DATABASE_URL points at a migrated database.
+func TestConcurrentTransfers(t *testing.T) {
+	dsn := os.Getenv("WIZARD_TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("WIZARD_TEST_DATABASE_URL not set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(20)
+
+	log := logger.NewLogger("error")
+	service := &WizardServiceImpl{
+		db:          db,
+		idempotency: idempotency.NewStore(db, log),
+		cfg:         &config.Config{},
+		logger:      log,
+		clock:       sim.RealClock(),
+	}
+	ctx := context.Background()
+
+	const (
+		wizards   = 10
+		transfers = 500
+		funding   = 1000
+	)
+
+	// Use fresh user ids so the two-wizards-per-user limit never gets in the way
+	userBase := time.Now().UnixNano() % 1_000_000_000
+	ids := make([]int64, 0, wizards)
+	for i := 0; i < wizards; i++ {
+		w, err := service.CreateWizard(ctx, &pb.CreateWizardRequest{
+			UserId:  userBase + int64(i),
+			Name:    fmt.Sprintf("StressWizard%d", i),
+			Realm:   "Stress",
+			Element: "Fire",
+		})
+		require.NoError(t, err)
+		ids = append(ids, w.Id)
+
+		_, err = service.UpdateManaBalance(ctx, &pb.UpdateManaBalanceRequest{
+			WizardId: w.Id,
+			Amount:   funding,
+			Reason:   "Stress test funding",
+		})
+		require.NoError(t, err)
+	}
+	defer func() {
+		for _, id := range ids {
+			_, _ = service.DeleteWizard(ctx, &pb.DeleteWizardRequest{Id: id})
+		}
+	}()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, transfers)
+	for i := 0; i < transfers; i++ {
+		rng := rand.New(rand.NewSource(int64(i)))
+		from := ids[rng.Intn(len(ids))]
+		to := ids[rng.Intn(len(ids))]
+		for to == from {
+			to = ids[rng.Intn(len(ids))]
+		}
+		amount := rng.Int63n(funding/2) + 1
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Refused transfers are fine; errors such as deadlocks are not
+			if _, err := service.TransferMana(ctx, &pb.TransferManaRequest{
+				FromWizardId: from,
+				ToWizardId:   to,
+				Amount:       amount,
+			}); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("Transfer failed: %v", err)
+	}
+
+	var total int64
+	for _, id := range ids {
+		balance, err := service.GetManaBalance(ctx, &pb.GetManaBalanceRequest{WizardId: id})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, balance.Balance, int64(0), "wizard %d went negative", id)
+		total += balance.Balance
+	}
+	assert.Equal(t, int64(wizards*funding), total, "transfers must conserve mana")
+
+	verify, err := service.VerifyLedger(ctx, &pb.VerifyLedgerRequest{})
+	require.NoError(t, err)
+	assert.True(t, verify.Balanced, "ledger mismatches: %v", verify.Mismatches)
+}
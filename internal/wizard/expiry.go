@@ -0,0 +1,136 @@
+package wizard
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tectix/mysticfunds/pkg/logger"
+	"github.com/tectix/mysticfunds/pkg/sim"
+)
+
+// JobPostingExpirer closes job postings once they pass their expiry, refunding
+// the escrow of the places nobody was accepted for
+type JobPostingExpirer struct {
+	db           *sql.DB
+	logger       logger.Logger
+	clock        sim.Clock
+	service      *WizardServiceImpl
+	pollInterval time.Duration
+	batchSize    int
+	runMutex     sync.Mutex
+	running      bool
+	done         chan struct{}
+}
+
+// NewJobPostingExpirer creates an expirer that reads the time from clock
+func NewJobPostingExpirer(db *sql.DB, logger logger.Logger, service *WizardServiceImpl, clock sim.Clock) *JobPostingExpirer {
+	return &JobPostingExpirer{
+		db:           db,
+		logger:       logger,
+		clock:        clock,
+		service:      service,
+		pollInterval: time.Minute,
+		batchSize:    100,
+		done:         make(chan struct{}),
+	}
+}
+
+// Start begins expiring postings. Only the leading replica runs the expirer, and
+// expiring a posting locks it, so each is still expired once during a failover.
+func (e *JobPostingExpirer) Start() {
+	e.runMutex.Lock()
+	defer e.runMutex.Unlock()
+
+	if e.running {
+		return
+	}
+
+	e.running = true
+	e.done = make(chan struct{})
+	e.logger.Info("Starting job posting expirer")
+
+	go e.workRoutine(e.done)
+}
+
+// Stop halts the expirer
+func (e *JobPostingExpirer) Stop() {
+	e.runMutex.Lock()
+	defer e.runMutex.Unlock()
+
+	if !e.running {
+		return
+	}
+
+	e.running = false
+	close(e.done)
+	e.logger.Info("Job posting expirer stopped")
+}
+
+func (e *JobPostingExpirer) workRoutine(done chan struct{}) {
+	ticker := e.clock.NewTicker(e.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		if err := e.processExpired(ctx); err != nil {
+			e.logger.Error("Failed to expire job postings", "error", err)
+		}
+		cancel()
+
+		select {
+		case <-ticker.C():
+		case <-done:
+			return
+		}
+	}
+}
+
+// processExpired expires the open postings past their expiry, a batch at a
+// time, until none are left
+func (e *JobPostingExpirer) processExpired(ctx context.Context) error {
+	for {
+		jobIDs, err := e.expiredPostings(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, jobID := range jobIDs {
+			e.logger.Info("Expiring job posting", "job_id", jobID)
+			if err := e.service.expireJobPosting(ctx, jobID); err != nil {
+				return fmt.Errorf("expire job posting %d: %w", jobID, err)
+			}
+		}
+
+		if len(jobIDs) < e.batchSize {
+			return nil
+		}
+	}
+}
+
+// expiredPostings returns the next batch of open postings past their expiry,
+// earliest first
+func (e *JobPostingExpirer) expiredPostings(ctx context.Context) ([]int64, error) {
+	rows, err := e.db.QueryContext(ctx, `
+		SELECT job_id FROM job_postings
+		WHERE status = 'open' AND expires_at <= $1
+		ORDER BY expires_at
+		LIMIT $2`,
+		e.clock.Now(), e.batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("load expired job postings: %w", err)
+	}
+	defer rows.Close()
+
+	var jobIDs []int64
+	for rows.Next() {
+		var jobID int64
+		if err := rows.Scan(&jobID); err != nil {
+			return nil, fmt.Errorf("scan expired job posting: %w", err)
+		}
+		jobIDs = append(jobIDs, jobID)
+	}
+	return jobIDs, rows.Err()
+}
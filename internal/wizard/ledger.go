@@ -0,0 +1,200 @@
+package wizard
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tectix/mysticfunds/internal/ledger"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// GetLedgerEntries returns the journal entries that touch any of a wizard's accounts,
+// newest first
+func (s *WizardServiceImpl) GetLedgerEntries(ctx context.Context, req *pb.GetLedgerEntriesRequest) (*pb.GetLedgerEntriesResponse, error) {
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	pageNumber := req.PageNumber
+	if pageNumber <= 0 {
+		pageNumber = 1
+	}
+	offset := (pageNumber - 1) * pageSize
+
+	const wizardEntries = `SELECT p.entry_id FROM ledger_postings p
+	                       JOIN ledger_accounts a ON p.account_id = a.id
+	                       WHERE a.wizard_id = $1`
+
+	var totalCount int32
+	err := s.db.QueryRowContext(ctx,
+		"SELECT COUNT(DISTINCT entry_id) FROM ("+wizardEntries+") e",
+		req.WizardId).Scan(&totalCount)
+	if err != nil {
+		s.logger.Error("Failed to count ledger entries", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get ledger entries")
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, entry_type, description, created_at FROM ledger_entries
+		 WHERE id IN (`+wizardEntries+`)
+		 ORDER BY id DESC LIMIT $2 OFFSET $3`,
+		req.WizardId, pageSize, offset)
+	if err != nil {
+		s.logger.Error("Failed to get ledger entries", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get ledger entries")
+	}
+	defer rows.Close()
+
+	var entries []*pb.LedgerEntry
+	var entryIDs []int64
+	byID := make(map[int64]*pb.LedgerEntry)
+	for rows.Next() {
+		var entry pb.LedgerEntry
+		var createdAt sql.NullTime
+		if err := rows.Scan(&entry.Id, &entry.EntryType, &entry.Description, &createdAt); err != nil {
+			s.logger.Error("Failed to scan ledger entry", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get ledger entries")
+		}
+		if createdAt.Valid {
+			entry.CreatedAt = timestamppb.New(createdAt.Time)
+		}
+
+		entries = append(entries, &entry)
+		entryIDs = append(entryIDs, entry.Id)
+		byID[entry.Id] = &entry
+	}
+
+	if len(entryIDs) == 0 {
+		return &pb.GetLedgerEntriesResponse{TotalCount: totalCount}, nil
+	}
+
+	postingRows, err := s.db.QueryContext(ctx,
+		`SELECT p.entry_id, a.account_type, COALESCE(a.wizard_id, 0), p.amount
+		 FROM ledger_postings p
+		 JOIN ledger_accounts a ON p.account_id = a.id
+		 WHERE p.entry_id = ANY($1)
+		 ORDER BY p.id`,
+		pq.Array(entryIDs))
+	if err != nil {
+		s.logger.Error("Failed to get ledger postings", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get ledger entries")
+	}
+	defer postingRows.Close()
+
+	for postingRows.Next() {
+		var entryID int64
+		var posting pb.LedgerPosting
+		if err := postingRows.Scan(&entryID, &posting.AccountType, &posting.WizardId, &posting.Amount); err != nil {
+			s.logger.Error("Failed to scan ledger posting", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get ledger entries")
+		}
+		if entry, ok := byID[entryID]; ok {
+			entry.Postings = append(entry.Postings, &posting)
+		}
+	}
+
+	return &pb.GetLedgerEntriesResponse{
+		Entries:    entries,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// VerifyLedger reports wizards whose cached mana balance disagrees with the ledger
+func (s *WizardServiceImpl) VerifyLedger(ctx context.Context, req *pb.VerifyLedgerRequest) (*pb.VerifyLedgerResponse, error) {
+	mismatches, err := ledger.Verify(ctx, s.db)
+	if err != nil {
+		s.logger.Error("Failed to verify ledger", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to verify ledger")
+	}
+
+	resp := &pb.VerifyLedgerResponse{Balanced: len(mismatches) == 0}
+	for _, m := range mismatches {
+		resp.Mismatches = append(resp.Mismatches, &pb.LedgerMismatch{
+			WizardId:      m.WizardID,
+			CachedBalance: m.CachedBalance,
+			LedgerBalance: m.LedgerBalance,
+		})
+	}
+
+	return resp, nil
+}
+
+// manaUpdateEntry builds the ledger entry for an UpdateManaBalance request. Counter
+// postings may only use the system account or the wizard's own escrow and investment
+// accounts; moving mana between wizards goes through TransferMana. A request with a
+// zero amount moves mana between those accounts without touching the balance.
+func manaUpdateEntry(req *pb.UpdateManaBalanceRequest) (ledger.Entry, error) {
+	description := req.Reason
+	if description == "" {
+		description = "Mana balance update"
+	}
+
+	entry := ledger.Entry{
+		Type:        "mana_update",
+		Description: description,
+		Reference:   req.Reference,
+	}
+	if req.Amount != 0 {
+		entry.Postings = append(entry.Postings, ledger.Posting{Account: ledger.Wizard(req.WizardId), Amount: req.Amount})
+	}
+
+	if len(req.CounterPostings) == 0 {
+		entry.Postings = append(entry.Postings, ledger.Posting{Account: ledger.System(), Amount: -req.Amount})
+		return entry, entry.Validate()
+	}
+
+	for _, posting := range req.CounterPostings {
+		account := ledger.Account{Type: posting.AccountType, WizardID: posting.WizardId}
+		switch posting.AccountType {
+		case ledger.AccountWizard:
+			return entry, status.Error(codes.InvalidArgument, "Use TransferMana to move mana between wizards")
+		case ledger.AccountEscrow, ledger.AccountInvestment:
+			if account.WizardID == 0 {
+				account.WizardID = req.WizardId
+			}
+			if account.WizardID != req.WizardId {
+				return entry, status.Error(codes.InvalidArgument, "Counter postings must use the wizard's own accounts")
+			}
+		}
+
+		entry.Postings = append(entry.Postings, ledger.Posting{Account: account, Amount: posting.Amount})
+	}
+
+	return entry, entry.Validate()
+}
+
+// lockBalances locks the given wizards' rows in ascending id order and returns their
+// mana balances. Wizards that do not exist are left out of the result. Every update
+// that touches more than one wizard locks through here, so two of them can never
+// wait on each other's rows.
+func (s *WizardServiceImpl) lockBalances(ctx context.Context, tx *sql.Tx, wizardIDs ...int64) (map[int64]int64, error) {
+	ids := append([]int64(nil), wizardIDs...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	balances := make(map[int64]int64, len(ids))
+	for _, id := range ids {
+		if _, locked := balances[id]; locked {
+			continue
+		}
+
+		var balance int64
+		err := tx.QueryRowContext(ctx,
+			"SELECT mana_balance FROM wizards WHERE id = $1 FOR UPDATE",
+			id).Scan(&balance)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		balances[id] = balance
+	}
+
+	return balances, nil
+}
@@ -0,0 +1,567 @@
+package wizard
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/internal/rewards"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// partyRuleColumns holds a job's party rule as read through a LEFT JOIN, so
+// every column is NULL for a job that does not take parties
+type partyRuleColumns struct {
+	minSize     sql.NullInt32
+	composition []byte
+	rewardSplit sql.NullString
+}
+
+// rule returns the job's party rule, or nil if it has none
+func (c partyRuleColumns) rule() *pb.PartyRule {
+	if !c.minSize.Valid {
+		return nil
+	}
+
+	rule := &pb.PartyRule{
+		MinSize:     c.minSize.Int32,
+		Composition: map[string]int32{},
+		RewardSplit: c.rewardSplit.String,
+	}
+	if len(c.composition) > 0 {
+		if err := json.Unmarshal(c.composition, &rule.Composition); err != nil {
+			rule.Composition = map[string]int32{}
+		}
+	}
+	return rule
+}
+
+// partyRewardSplit returns the rule's reward split, equal unless set
+func partyRewardSplit(rule *pb.PartyRule) string {
+	if rule.RewardSplit == "" {
+		return rewards.SplitEqual
+	}
+	return rule.RewardSplit
+}
+
+// validatePartyRule checks a party rule for a job that takes up to maxWizards
+// and returns its composition encoded for storage
+func validatePartyRule(rule *pb.PartyRule, maxWizards int32) ([]byte, error) {
+	if rule.MinSize < 2 {
+		return nil, fmt.Errorf("A party needs at least 2 wizards")
+	}
+	if rule.MinSize > maxWizards {
+		return nil, fmt.Errorf("Party size %d is more than the job's %d wizards", rule.MinSize, maxWizards)
+	}
+
+	split := partyRewardSplit(rule)
+	if split != rewards.SplitEqual && split != rewards.SplitLevelWeighted {
+		return nil, fmt.Errorf("Unknown reward split %q", rule.RewardSplit)
+	}
+
+	var required int32
+	for element, count := range rule.Composition {
+		if strings.TrimSpace(element) == "" || count < 1 {
+			return nil, fmt.Errorf("Composition needs an element and at least 1 wizard of it")
+		}
+		required += count
+	}
+	if required > maxWizards {
+		return nil, fmt.Errorf("Composition needs %d wizards, more than the job's %d", required, maxWizards)
+	}
+
+	composition := rule.Composition
+	if composition == nil {
+		composition = map[string]int32{}
+	}
+	return json.Marshal(composition)
+}
+
+// partyCandidate is a wizard applying to a job as part of a party
+type partyCandidate struct {
+	id           int64
+	element      string
+	level        int32
+	rewardFactor float64
+}
+
+// AssignPartyToJob assigns a group of wizards to a party job together. The
+// party must be at least the job's party size and fit in its free slots, and
+// every member must meet the job's level and tier. A job with a composition
+// needs enough members of each element it lists. Members of the job's element
+// or a listed one work at full reward; anyone else needs an affinity with the
+// job's element and is paid at its reward factor.
+func (s *WizardServiceImpl) AssignPartyToJob(ctx context.Context, req *pb.AssignPartyToJobRequest) (*pb.JobParty, error) {
+	if len(req.WizardIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "A party needs wizards")
+	}
+	listed := make(map[int64]bool, len(req.WizardIds))
+	for _, wizardId := range req.WizardIds {
+		if listed[wizardId] {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Wizard %d is listed more than once", wizardId))
+		}
+		listed[wizardId] = true
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	// The job is locked so parties applying at once cannot overfill it
+	var maxWizards, currentlyAssigned, durationMinutes, requiredLevel int32
+	var requiredElement, difficulty string
+	var columns partyRuleColumns
+	err = tx.QueryRowContext(ctx,
+		`SELECT j.max_wizards, j.currently_assigned, j.duration_minutes, j.required_element, j.required_level, j.difficulty,
+		        pr.min_size, pr.composition, pr.reward_split
+		 FROM jobs j
+		 LEFT JOIN job_party_rules pr ON pr.job_id = j.id
+		 WHERE j.id = $1 AND j.is_active = true
+		 FOR UPDATE OF j`,
+		req.JobId).Scan(&maxWizards, &currentlyAssigned, &durationMinutes, &requiredElement, &requiredLevel, &difficulty,
+		&columns.minSize, &columns.composition, &columns.rewardSplit)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Job not found or inactive")
+		}
+		s.logger.Error("Failed to check job availability", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to assign party to job")
+	}
+
+	rule := columns.rule()
+	if rule == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Job does not take parties")
+	}
+
+	size := int32(len(req.WizardIds))
+	if size < rule.MinSize {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Job needs a party of at least %d wizards", rule.MinSize))
+	}
+	if currentlyAssigned+size > maxWizards {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Job has room for %d more wizards", maxWizards-currentlyAssigned))
+	}
+
+	members, err := s.partyCandidates(ctx, tx, req.WizardIds)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get wizard info", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to assign party to job")
+	}
+
+	tierLevel, err := s.jobTierLevel(ctx, tx, difficulty)
+	if err != nil {
+		s.logger.Error("Failed to get job tier", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to assign party to job")
+	}
+
+	elements := make(map[string]int32)
+	for i, member := range members {
+		if member.level < requiredLevel {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Wizard %d level %d is below required level %d", member.id, member.level, requiredLevel))
+		}
+		if member.level < tierLevel {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("%s jobs unlock at level %d", difficulty, tierLevel))
+		}
+
+		affinity := rewards.NeutralAffinity()
+		if _, listed := rule.Composition[member.element]; !listed {
+			var ok bool
+			affinity, ok, err = s.elementAffinity(ctx, tx, member.element, requiredElement)
+			if err != nil {
+				s.logger.Error("Failed to get element affinity", "error", err)
+				return nil, status.Error(codes.Internal, "Failed to assign party to job")
+			}
+			if !ok {
+				return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Wizard %d element %s has no affinity with required element %s", member.id, member.element, requiredElement))
+			}
+		}
+		// The party works to one clock, so only the reward follows the affinity
+		members[i].rewardFactor = affinity.RewardFactor
+		elements[member.element]++
+	}
+	for element, count := range rule.Composition {
+		if elements[element] < count {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Party needs at least %d %s wizards", count, element))
+		}
+	}
+
+	var partyId int64
+	err = tx.QueryRowContext(ctx,
+		"INSERT INTO job_parties (job_id, reward_split) VALUES ($1, $2) RETURNING id",
+		req.JobId, rule.RewardSplit).Scan(&partyId)
+	if err != nil {
+		s.logger.Error("Failed to create job party", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to assign party to job")
+	}
+
+	// The whole party starts together and works to the same end time
+	startTime := s.clock.Now()
+	endTime := startTime.Add(time.Duration(durationMinutes) * time.Minute)
+
+	assignmentIds := make([]int64, 0, len(members))
+	for _, member := range members {
+		var assignmentId int64
+		err = tx.QueryRowContext(ctx,
+			`INSERT INTO job_assignments (job_id, wizard_id, status, started_at, party_id, reward_factor)
+			 VALUES ($1, $2, 'in_progress', $3, $4, $5) RETURNING id`,
+			req.JobId, member.id, startTime, partyId, member.rewardFactor).Scan(&assignmentId)
+		if err != nil {
+			if strings.Contains(err.Error(), "job_assignments_active_unique") {
+				return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Wizard %d is already assigned to this job", member.id))
+			}
+			s.logger.Error("Failed to create job assignment", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to assign party to job")
+		}
+		assignmentIds = append(assignmentIds, assignmentId)
+
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO job_progress (assignment_id, started_at, actual_start_time, expected_end_time, progress_percentage, time_worked_minutes, is_active, last_tick_time)
+			 VALUES ($1, $2, $2, $3, 0, 0, true, $2)`,
+			assignmentId, startTime, endTime)
+		if err != nil {
+			s.logger.Error("Failed to create job progress record", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to assign party to job")
+		}
+
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata)
+			 SELECT w.user_id, w.id, 'job_assigned',
+			        'Started working on job with a party: ' || j.title,
+			        json_build_object('job_id', j.id, 'assignment_id', $1::bigint, 'job_title', j.title, 'party_id', $4::bigint)
+			 FROM wizards w
+			 JOIN jobs j ON j.id = $2
+			 WHERE w.id = $3`,
+			assignmentId, req.JobId, member.id, partyId)
+		if err != nil {
+			s.logger.Error("Failed to create activity log", "error", err)
+			// Don't fail the transaction for activity log issues
+		}
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE jobs SET currently_assigned = currently_assigned + $1 WHERE id = $2",
+		size, req.JobId)
+	if err != nil {
+		s.logger.Error("Failed to update job assignment count", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to assign party to job")
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to assign party to job")
+	}
+
+	for _, assignmentId := range assignmentIds {
+		s.completions.ScheduleJobCompletion(assignmentId, endTime)
+	}
+
+	return s.GetJobParty(ctx, &pb.GetJobPartyRequest{PartyId: partyId})
+}
+
+// partyCandidates returns the element and level of each wizard, in the order
+// they are listed
+func (s *WizardServiceImpl) partyCandidates(ctx context.Context, q queryer, wizardIds []int64) ([]partyCandidate, error) {
+	rows, err := q.QueryContext(ctx,
+		"SELECT id, element, level FROM wizards WHERE id = ANY($1)",
+		pq.Array(wizardIds))
+	if err != nil {
+		return nil, fmt.Errorf("load party wizards: %w", err)
+	}
+	defer rows.Close()
+
+	found := make(map[int64]partyCandidate, len(wizardIds))
+	for rows.Next() {
+		var candidate partyCandidate
+		if err := rows.Scan(&candidate.id, &candidate.element, &candidate.level); err != nil {
+			return nil, fmt.Errorf("scan party wizard: %w", err)
+		}
+		found[candidate.id] = candidate
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("load party wizards: %w", err)
+	}
+
+	candidates := make([]partyCandidate, 0, len(wizardIds))
+	for _, wizardId := range wizardIds {
+		candidate, ok := found[wizardId]
+		if !ok {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("Wizard %d not found", wizardId))
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
+
+// GetJobParty returns a party and the assignments of its members
+func (s *WizardServiceImpl) GetJobParty(ctx context.Context, req *pb.GetJobPartyRequest) (*pb.JobParty, error) {
+	var party pb.JobParty
+	var createdAt, completedAt sql.NullTime
+	err := s.db.QueryRowContext(ctx,
+		"SELECT id, job_id, status, reward_split, created_at, completed_at FROM job_parties WHERE id = $1",
+		req.PartyId).Scan(&party.Id, &party.JobId, &party.Status, &party.RewardSplit, &createdAt, &completedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Job party not found")
+		}
+		s.logger.Error("Failed to get job party", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get job party")
+	}
+	if createdAt.Valid {
+		party.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if completedAt.Valid {
+		party.CompletedAt = timestamppb.New(completedAt.Time)
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT id FROM job_assignments WHERE party_id = $1 ORDER BY id",
+		req.PartyId)
+	if err != nil {
+		s.logger.Error("Failed to get job party members", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get job party")
+	}
+	defer rows.Close()
+
+	var assignmentIds []int64
+	for rows.Next() {
+		var assignmentId int64
+		if err := rows.Scan(&assignmentId); err != nil {
+			s.logger.Error("Failed to scan job party member", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to get job party")
+		}
+		assignmentIds = append(assignmentIds, assignmentId)
+	}
+	if err := rows.Err(); err != nil {
+		s.logger.Error("Failed to get job party members", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get job party")
+	}
+
+	for _, assignmentId := range assignmentIds {
+		assignment, err := s.getJobAssignmentByID(ctx, assignmentId)
+		if err != nil {
+			return nil, err
+		}
+		party.Assignments = append(party.Assignments, assignment)
+	}
+
+	return &party, nil
+}
+
+// lockAssignmentParty locks the party an assignment belongs to. It reports an
+// invalid id if the assignment is not in a party.
+func (s *WizardServiceImpl) lockAssignmentParty(ctx context.Context, tx *sql.Tx, assignmentId int64) (sql.NullInt64, error) {
+	var partyId sql.NullInt64
+	err := tx.QueryRowContext(ctx,
+		`SELECT p.id FROM job_parties p
+		 JOIN job_assignments ja ON ja.party_id = p.id
+		 WHERE ja.id = $1
+		 FOR UPDATE OF p`,
+		assignmentId).Scan(&partyId)
+	if err != nil && err != sql.ErrNoRows {
+		return partyId, fmt.Errorf("lock job party: %w", err)
+	}
+	return partyId, nil
+}
+
+// partyMember is a running member of a party that is being completed
+type partyMember struct {
+	assignmentId    int64
+	wizardId        int64
+	currentExp      int32
+	currentLevel    int32
+	rewardFactor    float64
+	actualStartTime sql.NullTime
+	expectedEndTime sql.NullTime
+}
+
+// completeJobParty completes the job of every running member of a party once all
+// of them have finished it. What the members earned for their time is pooled and
+// split between them by the party's reward split, before each member's own
+// reward modifiers and element affinity. Members who left early were paid when they left and have no
+// share of the pool.
+func (s *WizardServiceImpl) completeJobParty(ctx context.Context, tx *sql.Tx, assignmentId, partyId int64) (*pb.JobAssignment, error) {
+	var jobId, realmId int64
+	var rewardSplit string
+	var manaRewardPerHour, expRewardPerHour, durationMinutes int32
+	err := tx.QueryRowContext(ctx,
+		`SELECT p.job_id, p.reward_split, j.realm_id, j.mana_reward_per_hour, j.exp_reward_per_hour, j.duration_minutes
+		 FROM job_parties p
+		 JOIN jobs j ON p.job_id = j.id
+		 WHERE p.id = $1 AND p.status = 'in_progress'`,
+		partyId).Scan(&jobId, &rewardSplit, &realmId, &manaRewardPerHour, &expRewardPerHour, &durationMinutes)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Assignment not found or already completed")
+		}
+		s.logger.Error("Failed to get job party", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to complete job assignment")
+	}
+
+	members, err := s.runningPartyMembers(ctx, tx, partyId)
+	if err != nil {
+		s.logger.Error("Failed to get job party members", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to complete job assignment")
+	}
+
+	now := s.clock.Now()
+	var poolMana, poolExp int64
+	weights := make([]int64, len(members))
+	for i, member := range members {
+		if member.expectedEndTime.Valid && now.Before(member.expectedEndTime.Time) {
+			return nil, status.Error(codes.FailedPrecondition, "The party has not finished the job yet")
+		}
+
+		worked, tracked := jobTimeWorked(member.actualStartTime, member.expectedEndTime, sql.NullTime{}, now)
+		if !tracked {
+			worked = time.Duration(durationMinutes) * time.Minute
+		}
+		mana, exp := jobBaseRewards(manaRewardPerHour, expRewardPerHour, durationMinutes, worked)
+		poolMana += int64(mana)
+		poolExp += int64(exp)
+
+		weights[i] = 1
+		if rewardSplit == rewards.SplitLevelWeighted {
+			weights[i] = int64(member.currentLevel)
+		}
+	}
+
+	manaShares := rewards.Split(poolMana, weights)
+	expShares := rewards.Split(poolExp, weights)
+
+	for i, member := range members {
+		baseMana, totalExp := int32(manaShares[i]), int32(expShares[i])
+		affinity := rewards.Affinity{RewardFactor: member.rewardFactor, SpeedFactor: 1}
+		totalMana, appliedModifiers, err := s.jobManaReward(ctx, tx, member.wizardId, realmId, baseMana, affinity)
+		if err != nil {
+			s.logger.Error("Failed to get reward modifiers", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to complete job assignment")
+		}
+
+		_, err = tx.ExecContext(ctx,
+			`UPDATE job_assignments SET status = 'completed', completed_at = CURRENT_TIMESTAMP,
+			 mana_earned = $1, exp_earned = $2 WHERE id = $3`,
+			totalMana, totalExp, member.assignmentId)
+		if err != nil {
+			s.logger.Error("Failed to update assignment", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to complete job assignment")
+		}
+
+		err = s.creditJobReward(ctx, tx, fmt.Sprintf("Job assignment %d (party %d)", member.assignmentId, partyId), ledger.System(),
+			member.wizardId, totalMana, totalExp, member.currentExp, member.currentLevel)
+		if err != nil {
+			s.logger.Error("Failed to pay job reward", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to complete job assignment")
+		}
+
+		_, err = tx.ExecContext(ctx,
+			`UPDATE job_progress SET progress_percentage = 100, is_active = false,
+			 last_updated_at = CURRENT_TIMESTAMP WHERE assignment_id = $1`,
+			member.assignmentId)
+		if err != nil {
+			s.logger.Error("Failed to update job progress", "error", err)
+			// Don't fail the transaction for progress update issues
+		}
+
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata)
+			 SELECT w.user_id, w.id, 'job_completed',
+			        'Completed job with a party: ' || j.title || ' - Earned ' || $2::text || ' mana and ' || $3::text || ' EXP',
+			        json_build_object('job_id', j.id, 'assignment_id', $1::bigint, 'job_title', j.title, 'mana_earned', $2::integer, 'exp_earned', $3::integer,
+			                          'base_mana', $4::integer, 'modifiers', $5::jsonb, 'party_id', $6::bigint, 'reward_split', $7::text)
+			 FROM job_assignments ja
+			 JOIN wizards w ON ja.wizard_id = w.id
+			 JOIN jobs j ON ja.job_id = j.id
+			 WHERE ja.id = $1::bigint`,
+			member.assignmentId, totalMana, totalExp, baseMana, modifiersJSON(appliedModifiers), partyId, rewardSplit)
+		if err != nil {
+			s.logger.Error("Failed to create activity log", "error", err)
+			// Don't fail the transaction for activity log issues
+		}
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE jobs SET currently_assigned = currently_assigned - $1 WHERE id = $2",
+		len(members), jobId)
+	if err != nil {
+		s.logger.Error("Failed to update job assignment count", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to complete job assignment")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE job_parties SET status = 'completed', completed_at = CURRENT_TIMESTAMP WHERE id = $1",
+		partyId)
+	if err != nil {
+		s.logger.Error("Failed to update job party", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to complete job assignment")
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to complete job assignment")
+	}
+
+	for _, member := range members {
+		s.completions.CancelJobCompletion(member.assignmentId)
+	}
+
+	return s.getJobAssignmentByID(ctx, assignmentId)
+}
+
+// runningPartyMembers locks and returns the members of a party still working
+// its job
+func (s *WizardServiceImpl) runningPartyMembers(ctx context.Context, tx *sql.Tx, partyId int64) ([]partyMember, error) {
+	rows, err := tx.QueryContext(ctx,
+		`SELECT ja.id, ja.wizard_id, w.experience_points, w.level, ja.reward_factor, jp.actual_start_time, jp.expected_end_time
+		 FROM job_assignments ja
+		 JOIN wizards w ON ja.wizard_id = w.id
+		 LEFT JOIN job_progress jp ON ja.id = jp.assignment_id
+		 WHERE ja.party_id = $1 AND ja.status IN ('assigned', 'in_progress')
+		 ORDER BY ja.id
+		 FOR UPDATE OF ja`,
+		partyId)
+	if err != nil {
+		return nil, fmt.Errorf("load party members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []partyMember
+	for rows.Next() {
+		var member partyMember
+		if err := rows.Scan(&member.assignmentId, &member.wizardId, &member.currentExp, &member.currentLevel, &member.rewardFactor,
+			&member.actualStartTime, &member.expectedEndTime); err != nil {
+			return nil, fmt.Errorf("scan party member: %w", err)
+		}
+		members = append(members, member)
+	}
+	return members, rows.Err()
+}
+
+// cancelAbandonedParty cancels a party that no member is working for any more
+func (s *WizardServiceImpl) cancelAbandonedParty(ctx context.Context, tx *sql.Tx, partyId int64) error {
+	_, err := tx.ExecContext(ctx,
+		`UPDATE job_parties SET status = 'cancelled', completed_at = CURRENT_TIMESTAMP
+		 WHERE id = $1 AND status = 'in_progress'
+		 AND NOT EXISTS (SELECT 1 FROM job_assignments WHERE party_id = $1 AND status IN ('assigned', 'in_progress'))`,
+		partyId)
+	if err != nil {
+		return fmt.Errorf("cancel abandoned party: %w", err)
+	}
+	return nil
+}
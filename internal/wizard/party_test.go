@@ -0,0 +1,256 @@
+package wizard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/internal/ledger/ledgertest"
+	"github.com/tectix/mysticfunds/pkg/sim"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// expectPartyJob sets up the locked read of an hour-long party job for up to 4
+// wizards
+func expectPartyJob(mock sqlmock.Sqlmock, minSize interface{}, composition string) {
+	mock.ExpectQuery("SELECT j.max_wizards, j.currently_assigned(.+)FOR UPDATE OF j").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"max_wizards", "currently_assigned", "duration_minutes", "required_element",
+			"required_level", "difficulty", "min_size", "composition", "reward_split"}).
+			AddRow(4, 0, 60, "Fire", 2, "Medium", minSize, composition, "equal"))
+}
+
+func expectPartyWizards(mock sqlmock.Sqlmock, ids []int64, rows *sqlmock.Rows) {
+	mock.ExpectQuery("SELECT id, element, level FROM wizards WHERE id = ANY\\(\\$1\\)").
+		WithArgs(pq.Array(ids)).
+		WillReturnRows(rows)
+}
+
+func partyWizardRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "element", "level"})
+}
+
+func TestAssignPartyToJob(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch)
+
+	mock.ExpectBegin()
+	expectPartyJob(mock, 2, `{"Fire": 1, "Water": 1}`)
+	expectPartyWizards(mock, []int64{1, 2}, partyWizardRows().
+		AddRow(2, "Water", 3).
+		AddRow(1, "Fire", 2))
+	mock.ExpectQuery("SELECT level FROM level_rewards WHERE reward_type = 'job_tier'").
+		WithArgs("Medium").
+		WillReturnRows(sqlmock.NewRows([]string{"level"}).AddRow(2))
+	mock.ExpectQuery("INSERT INTO job_parties").
+		WithArgs(9, "equal").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	for i, wizardId := range []int64{1, 2} {
+		assignmentId := int64(10 + i)
+		mock.ExpectQuery("INSERT INTO job_assignments").
+			WithArgs(9, wizardId, jobEpoch, 4, 1.0).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(assignmentId))
+		mock.ExpectExec("INSERT INTO job_progress").
+			WithArgs(assignmentId, jobEpoch, jobEpoch.Add(time.Hour)).
+			WillReturnResult(sqlmock.NewResult(assignmentId, 1))
+		mock.ExpectExec("INSERT INTO activity_logs").
+			WithArgs(assignmentId, 9, wizardId, 4).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectExec("UPDATE jobs SET currently_assigned = currently_assigned \\+ \\$1").
+		WithArgs(2, 9).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT id, job_id, status, reward_split, created_at, completed_at FROM job_parties").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "job_id", "status", "reward_split", "created_at", "completed_at"}).
+			AddRow(4, 9, "in_progress", "equal", jobEpoch, nil))
+	mock.ExpectQuery("SELECT id FROM job_assignments WHERE party_id = \\$1").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10).AddRow(11))
+	expectAssignmentReadBack(mock, 10, "in_progress", 0, jobEpoch)
+	expectAssignmentReadBack(mock, 11, "in_progress", 0, jobEpoch)
+
+	resp, err := service.AssignPartyToJob(context.Background(), &pb.AssignPartyToJobRequest{JobId: 9, WizardIds: []int64{1, 2}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), resp.Id)
+	assert.Equal(t, "in_progress", resp.Status)
+	assert.Len(t, resp.Assignments, 2)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAssignPartyToJobChecksParty(t *testing.T) {
+	tests := []struct {
+		name        string
+		minSize     interface{}
+		composition string
+		wizards     *sqlmock.Rows
+		affinity    bool
+		expected    string
+	}{
+		{"not a party job", nil, "", nil, false, "Job does not take parties"},
+		{"too small", 3, "{}", nil, false, "Job needs a party of at least 3 wizards"},
+		{"missing element", 2, `{"Fire": 1, "Water": 1}`,
+			partyWizardRows().AddRow(1, "Fire", 2).AddRow(2, "Fire", 2), false, "Party needs at least 1 Water wizards"},
+		{"off element without affinity", 2, "{}",
+			partyWizardRows().AddRow(1, "Fire", 2).AddRow(2, "Water", 2), true, "Wizard 2 element Water has no affinity with required element Fire"},
+		{"member below required level", 2, `{"Fire": 1}`,
+			partyWizardRows().AddRow(1, "Fire", 2).AddRow(2, "Earth", 1), false, "Wizard 2 level 1 is below required level 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, service := setupTest(t)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			expectPartyJob(mock, tt.minSize, tt.composition)
+			if tt.wizards != nil {
+				expectPartyWizards(mock, []int64{1, 2}, tt.wizards)
+				mock.ExpectQuery("SELECT level FROM level_rewards WHERE reward_type = 'job_tier'").
+					WithArgs("Medium").
+					WillReturnRows(sqlmock.NewRows([]string{"level"}))
+			}
+			if tt.affinity {
+				mock.ExpectQuery("SELECT reward_factor, speed_factor FROM element_affinities").
+					WithArgs("Water", "Fire").
+					WillReturnRows(sqlmock.NewRows([]string{"reward_factor", "speed_factor"}))
+			}
+			mock.ExpectRollback()
+
+			_, err := service.AssignPartyToJob(context.Background(), &pb.AssignPartyToJobRequest{JobId: 9, WizardIds: []int64{1, 2}})
+
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			assert.Contains(t, err.Error(), tt.expected)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAssignPartyToJobRejectsRepeatedWizards(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	_, err := service.AssignPartyToJob(context.Background(), &pb.AssignPartyToJobRequest{JobId: 9, WizardIds: []int64{1, 2, 1}})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestValidatePartyRule(t *testing.T) {
+	_, err := validatePartyRule(&pb.PartyRule{MinSize: 2, Composition: map[string]int32{"Fire": 1, "Water": 2}}, 4)
+	assert.NoError(t, err)
+
+	for name, rule := range map[string]*pb.PartyRule{
+		"party of one":          {MinSize: 1},
+		"larger than the job":   {MinSize: 5},
+		"unknown split":         {MinSize: 2, RewardSplit: "winner_takes_all"},
+		"empty element":         {MinSize: 2, Composition: map[string]int32{"": 1}},
+		"composition too large": {MinSize: 2, Composition: map[string]int32{"Fire": 3, "Water": 2}},
+	} {
+		_, err := validatePartyRule(rule, 4)
+		assert.Error(t, err, name)
+	}
+}
+
+// expectPartyCompletion sets up completing assignment 10 of party 4, a
+// level-weighted party whose two members started an hour-long job at jobEpoch
+func expectPartyCompletion(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT p.id FROM job_parties p(.+)FOR UPDATE OF p").
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectQuery("SELECT ja.job_id, ja.wizard_id, j.realm_id").
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"job_id", "wizard_id", "realm_id", "mana_reward_per_hour",
+			"exp_reward_per_hour", "duration_minutes", "experience_points", "level", "actual_start_time", "expected_end_time",
+			"reward_factor", "speed_factor", "poster_wizard_id", "reward_per_wizard"}).
+			AddRow(9, 1, 7, 600, 30, 60, 100, 2, jobEpoch, jobEpoch.Add(time.Hour), 1.0, 1.0, nil, nil))
+	mock.ExpectQuery("SELECT p.job_id, p.reward_split").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"job_id", "reward_split", "realm_id", "mana_reward_per_hour",
+			"exp_reward_per_hour", "duration_minutes"}).
+			AddRow(9, "level_weighted", 7, 600, 30, 60))
+	mock.ExpectQuery("SELECT ja.id, ja.wizard_id, w.experience_points(.+)FOR UPDATE OF ja").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "wizard_id", "experience_points", "level", "reward_factor", "actual_start_time", "expected_end_time"}).
+			AddRow(10, 1, 100, 2, 1.0, jobEpoch, jobEpoch.Add(time.Hour)).
+			AddRow(11, 2, 0, 1, 1.0, jobEpoch, jobEpoch.Add(time.Hour)))
+}
+
+func TestCompleteJobPartySplitsRewards(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	now := jobEpoch.Add(time.Hour)
+	service.clock = sim.NewFakeClock(now)
+
+	mock.ExpectBegin()
+	expectPartyCompletion(mock)
+	// Two members pool 1200 mana and 60 EXP, split 2:1 by level
+	members := []struct {
+		assignmentId, wizardId      int64
+		mana, exp, newExp, newLevel int
+	}{
+		{10, 1, 800, 40, 140, 2},
+		{11, 2, 400, 20, 20, 1},
+	}
+	for _, member := range members {
+		mock.ExpectQuery("SELECT name, mana_boost_factor FROM realms WHERE id = \\$1").
+			WithArgs(7).
+			WillReturnRows(sqlmock.NewRows(nil))
+		mock.ExpectExec("UPDATE job_assignments SET status = 'completed'").
+			WithArgs(member.mana, member.exp, member.assignmentId).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		ledgertest.ExpectPost(mock, ledger.Transfer("job_reward", "", ledger.System(), ledger.Wizard(member.wizardId), int64(member.mana)),
+			map[int64]int64{member.wizardId: int64(member.mana)})
+		mock.ExpectExec("UPDATE wizards SET experience_points = \\$1, level = \\$2").
+			WithArgs(member.newExp, member.newLevel, member.wizardId).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE job_progress SET progress_percentage = 100").
+			WithArgs(member.assignmentId).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO activity_logs").
+			WithArgs(member.assignmentId, member.mana, member.exp, member.mana, "[]", 4, "level_weighted").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectExec("UPDATE jobs SET currently_assigned = currently_assigned - \\$1").
+		WithArgs(2, 9).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_parties SET status = 'completed'").
+		WithArgs(4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectAssignmentReadBack(mock, 10, "completed", 800, now)
+
+	resp, err := service.CompleteJobAssignment(context.Background(), &pb.CompleteJobAssignmentRequest{AssignmentId: 10})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(800), resp.ManaEarned)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCompleteJobPartyWaitsForWholeParty(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch.Add(30 * time.Minute))
+
+	mock.ExpectBegin()
+	expectPartyCompletion(mock)
+	mock.ExpectRollback()
+
+	_, err := service.CompleteJobAssignment(context.Background(), &pb.CompleteJobAssignmentRequest{AssignmentId: 10})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
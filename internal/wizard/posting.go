@@ -0,0 +1,628 @@
+package wizard
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tectix/mysticfunds/internal/ledger"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// defaultPostingTTLHours is used when JOB_POSTING_TTL_HOURS is not set
+const defaultPostingTTLHours = 72
+
+// parsePostingTTL reads JOB_POSTING_TTL_HOURS, a whole number of hours
+func parsePostingTTL(value string) (time.Duration, error) {
+	hours, err := strconv.Atoi(value)
+	if err != nil || hours < 1 {
+		return 0, fmt.Errorf("invalid JOB_POSTING_TTL_HOURS %q: must be a positive number of hours", value)
+	}
+	return time.Duration(hours) * time.Hour, nil
+}
+
+// jobPostingColumns holds a job's posting as read through a LEFT JOIN, so every
+// column is NULL for a job no wizard posted
+type jobPostingColumns struct {
+	posterWizardId  sql.NullInt64
+	rewardPerWizard sql.NullInt32
+	escrowRemaining sql.NullInt64
+	status          sql.NullString
+	expiresAt       sql.NullTime
+	closedAt        sql.NullTime
+}
+
+// posting returns the job's posting, or nil if it has none
+func (c jobPostingColumns) posting() *pb.JobPosting {
+	if !c.posterWizardId.Valid {
+		return nil
+	}
+
+	posting := &pb.JobPosting{
+		PosterWizardId:  c.posterWizardId.Int64,
+		RewardPerWizard: c.rewardPerWizard.Int32,
+		EscrowRemaining: c.escrowRemaining.Int64,
+		Status:          c.status.String,
+	}
+	if c.expiresAt.Valid {
+		posting.ExpiresAt = timestamppb.New(c.expiresAt.Time)
+	}
+	if c.closedAt.Valid {
+		posting.ClosedAt = timestamppb.New(c.closedAt.Time)
+	}
+	return posting
+}
+
+// postingBudget is the most a posted job can pay one wizard: its mana for the
+// whole planned duration, before modifiers
+func postingBudget(manaRewardPerHour, durationMinutes int32) int32 {
+	mana, _ := jobBaseRewards(manaRewardPerHour, 0, durationMinutes, time.Duration(durationMinutes)*time.Minute)
+	return mana
+}
+
+// validateJobPosting checks a job a wizard is posting and returns when it stops
+// taking applications
+func (s *WizardServiceImpl) validateJobPosting(req *pb.CreateJobRequest) (time.Time, error) {
+	if req.PartyRule != nil {
+		return time.Time{}, fmt.Errorf("Posted jobs cannot take parties")
+	}
+	if req.MaxWizards < 1 {
+		return time.Time{}, fmt.Errorf("A posted job needs at least 1 wizard")
+	}
+
+	now := s.clock.Now()
+	if req.ExpiresAt == nil {
+		return now.Add(s.postingTTL), nil
+	}
+	expiresAt := req.ExpiresAt.AsTime()
+	if !expiresAt.After(now) {
+		return time.Time{}, fmt.Errorf("A posting must expire in the future")
+	}
+	return expiresAt, nil
+}
+
+// openJobPosting records a job as posted by its creator and moves escrow from
+// their mana into their escrow account. The poster's row must be locked and
+// their balance checked.
+func (s *WizardServiceImpl) openJobPosting(ctx context.Context, tx *sql.Tx, jobId int64, req *pb.CreateJobRequest, escrow int64, expiresAt time.Time) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO job_postings (job_id, poster_wizard_id, reward_per_wizard, escrow_remaining, expires_at)
+		 VALUES ($1, $2, $3, $4, $5)`,
+		jobId, req.CreatedByWizardId, postingBudget(req.ManaRewardPerHour, req.DurationMinutes), escrow, expiresAt)
+	if err != nil {
+		return fmt.Errorf("insert job posting: %w", err)
+	}
+
+	if escrow > 0 {
+		_, err = ledger.Post(ctx, tx, ledger.Transfer("job_escrow", fmt.Sprintf("Escrow for job %d", jobId),
+			ledger.Wizard(req.CreatedByWizardId), ledger.Escrow(req.CreatedByWizardId), escrow))
+		if err != nil {
+			return fmt.Errorf("post job escrow: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// lockJobRewardSource returns the account an assignment's job pays from: the
+// poster's escrow for a posted job, otherwise the system. A posting is locked
+// before the wizards, in the order expiring it does, and the worker and poster
+// in id order, so jobs paying in opposite directions cannot deadlock.
+func (s *WizardServiceImpl) lockJobRewardSource(ctx context.Context, tx *sql.Tx, jobId, wizardId int64, posterId sql.NullInt64) (ledger.Account, error) {
+	if !posterId.Valid {
+		return ledger.System(), nil
+	}
+
+	var locked int64
+	err := tx.QueryRowContext(ctx,
+		"SELECT job_id FROM job_postings WHERE job_id = $1 FOR UPDATE",
+		jobId).Scan(&locked)
+	if err != nil {
+		return ledger.Account{}, fmt.Errorf("lock job posting: %w", err)
+	}
+
+	if _, err := s.lockBalances(ctx, tx, wizardId, posterId.Int64); err != nil {
+		return ledger.Account{}, fmt.Errorf("lock wizard balances: %w", err)
+	}
+	return ledger.Escrow(posterId.Int64), nil
+}
+
+// settleJobPosting releases the escrow held for a finished assignment on a
+// posted job. What the wizard was not paid of the place's budget goes back to
+// the poster.
+func (s *WizardServiceImpl) settleJobPosting(ctx context.Context, tx *sql.Tx, jobId, posterId int64, budget, paid int32) error {
+	if refund := budget - paid; refund > 0 {
+		_, err := ledger.Post(ctx, tx, ledger.Transfer("job_escrow_refund", fmt.Sprintf("Unspent escrow for job %d", jobId),
+			ledger.Escrow(posterId), ledger.Wizard(posterId), int64(refund)))
+		if err != nil {
+			return fmt.Errorf("refund job escrow: %w", err)
+		}
+	}
+
+	_, err := tx.ExecContext(ctx,
+		"UPDATE job_postings SET escrow_remaining = escrow_remaining - $1 WHERE job_id = $2",
+		budget, jobId)
+	if err != nil {
+		return fmt.Errorf("update job escrow: %w", err)
+	}
+	return nil
+}
+
+// ApplyToJob applies a wizard to a job another wizard posted. The wizard must
+// be able to take the job; the poster then accepts or rejects them.
+func (s *WizardServiceImpl) ApplyToJob(ctx context.Context, req *pb.ApplyToJobRequest) (*pb.JobApplication, error) {
+	var posterId int64
+	var postingStatus string
+	var expiresAt time.Time
+	err := s.db.QueryRowContext(ctx,
+		"SELECT poster_wizard_id, status, expires_at FROM job_postings WHERE job_id = $1",
+		req.JobId).Scan(&posterId, &postingStatus, &expiresAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Posted job not found")
+		}
+		s.logger.Error("Failed to get job posting", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to apply to job")
+	}
+
+	if postingStatus != "open" || !s.clock.Now().Before(expiresAt) {
+		return nil, status.Error(codes.FailedPrecondition, "Job is no longer taking applications")
+	}
+	if posterId == req.WizardId {
+		return nil, status.Error(codes.FailedPrecondition, "Wizards cannot apply to their own jobs")
+	}
+
+	if _, err := s.checkJobEligibility(ctx, s.db, req.JobId, req.WizardId); err != nil {
+		return nil, err
+	}
+
+	var applicationId int64
+	err = s.db.QueryRowContext(ctx,
+		"INSERT INTO job_applications (job_id, wizard_id, message) VALUES ($1, $2, $3) RETURNING id",
+		req.JobId, req.WizardId, sql.NullString{String: req.Message, Valid: req.Message != ""}).Scan(&applicationId)
+	if err != nil {
+		if strings.Contains(err.Error(), "job_applications_pending_unique") {
+			return nil, status.Error(codes.FailedPrecondition, "Wizard has already applied to this job")
+		}
+		s.logger.Error("Failed to create job application", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to apply to job")
+	}
+
+	_, err = s.db.ExecContext(ctx,
+		`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata)
+		 SELECT w.user_id, w.id, 'job_applied',
+		        'Applied for job: ' || j.title,
+		        json_build_object('job_id', j.id, 'application_id', $1::bigint, 'job_title', j.title)
+		 FROM wizards w
+		 JOIN jobs j ON j.id = $2
+		 WHERE w.id = $3`,
+		applicationId, req.JobId, req.WizardId)
+	if err != nil {
+		s.logger.Error("Failed to create activity log", "error", err)
+		// Don't fail the application for activity log issues
+	}
+
+	return s.getJobApplication(ctx, applicationId)
+}
+
+// jobApplicationQuery selects job applications for scanJobApplication
+const jobApplicationQuery = `SELECT a.id, a.job_id, a.wizard_id, w.name, a.status, a.message, a.assignment_id,
+	        a.created_at, a.decided_at
+	 FROM job_applications a
+	 JOIN wizards w ON a.wizard_id = w.id`
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanJobApplication(row rowScanner) (*pb.JobApplication, error) {
+	var application pb.JobApplication
+	var message sql.NullString
+	var assignmentId sql.NullInt64
+	var createdAt, decidedAt sql.NullTime
+	err := row.Scan(&application.Id, &application.JobId, &application.WizardId, &application.WizardName,
+		&application.Status, &message, &assignmentId, &createdAt, &decidedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	application.Message = message.String
+	application.AssignmentId = assignmentId.Int64
+	if createdAt.Valid {
+		application.CreatedAt = timestamppb.New(createdAt.Time)
+	}
+	if decidedAt.Valid {
+		application.DecidedAt = timestamppb.New(decidedAt.Time)
+	}
+	return &application, nil
+}
+
+func (s *WizardServiceImpl) getJobApplication(ctx context.Context, id int64) (*pb.JobApplication, error) {
+	application, err := scanJobApplication(s.db.QueryRowContext(ctx, jobApplicationQuery+" WHERE a.id = $1", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Job application not found")
+		}
+		s.logger.Error("Failed to get job application", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get job application")
+	}
+	return application, nil
+}
+
+// ListJobApplications lists the applications to a job or those of a wizard,
+// oldest first
+func (s *WizardServiceImpl) ListJobApplications(ctx context.Context, req *pb.ListJobApplicationsRequest) (*pb.ListJobApplicationsResponse, error) {
+	if req.JobId == 0 && req.WizardId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "A job or wizard is required")
+	}
+
+	query := jobApplicationQuery + " WHERE 1=1"
+	args := []interface{}{}
+	if req.JobId > 0 {
+		args = append(args, req.JobId)
+		query += fmt.Sprintf(" AND a.job_id = $%d", len(args))
+	}
+	if req.WizardId > 0 {
+		args = append(args, req.WizardId)
+		query += fmt.Sprintf(" AND a.wizard_id = $%d", len(args))
+	}
+	if req.Status != "" {
+		args = append(args, req.Status)
+		query += fmt.Sprintf(" AND a.status = $%d", len(args))
+	}
+	query += " ORDER BY a.created_at, a.id"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		s.logger.Error("Failed to list job applications", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list job applications")
+	}
+	defer rows.Close()
+
+	var applications []*pb.JobApplication
+	for rows.Next() {
+		application, err := scanJobApplication(rows)
+		if err != nil {
+			s.logger.Error("Failed to scan job application", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to list job applications")
+		}
+		applications = append(applications, application)
+	}
+	if err := rows.Err(); err != nil {
+		s.logger.Error("Failed to list job applications", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list job applications")
+	}
+
+	return &pb.ListJobApplicationsResponse{Applications: applications}, nil
+}
+
+// AcceptJobApplication assigns an applicant to the posted job they applied to,
+// taking one of its places. Once every place is taken the posting is filled and
+// the applicants still waiting are rejected.
+func (s *WizardServiceImpl) AcceptJobApplication(ctx context.Context, req *pb.AcceptJobApplicationRequest) (*pb.JobApplication, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	var jobId int64
+	err = tx.QueryRowContext(ctx,
+		"SELECT job_id FROM job_applications WHERE id = $1",
+		req.ApplicationId).Scan(&jobId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Job application not found")
+		}
+		s.logger.Error("Failed to get job application", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to accept job application")
+	}
+
+	// The posting is locked before its applications, as expiring it does, so
+	// accepts on the same job queue up and cannot overfill it
+	var posterId int64
+	var postingStatus string
+	var expiresAt time.Time
+	var maxWizards int32
+	err = tx.QueryRowContext(ctx,
+		`SELECT jpo.poster_wizard_id, jpo.status, jpo.expires_at, j.max_wizards
+		 FROM job_postings jpo
+		 JOIN jobs j ON j.id = jpo.job_id
+		 WHERE jpo.job_id = $1
+		 FOR UPDATE OF jpo`,
+		jobId).Scan(&posterId, &postingStatus, &expiresAt, &maxWizards)
+	if err != nil {
+		s.logger.Error("Failed to lock job posting", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to accept job application")
+	}
+	if posterId != req.PosterWizardId {
+		return nil, status.Error(codes.PermissionDenied, "Only the job's poster can decide its applications")
+	}
+
+	var wizardId int64
+	var applicationStatus string
+	err = tx.QueryRowContext(ctx,
+		"SELECT wizard_id, status FROM job_applications WHERE id = $1 FOR UPDATE",
+		req.ApplicationId).Scan(&wizardId, &applicationStatus)
+	if err != nil {
+		s.logger.Error("Failed to lock job application", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to accept job application")
+	}
+
+	if applicationStatus != "pending" {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Application is already %s", applicationStatus))
+	}
+	now := s.clock.Now()
+	if postingStatus != "open" || !now.Before(expiresAt) {
+		return nil, status.Error(codes.FailedPrecondition, "Job is no longer taking applications")
+	}
+
+	var accepted int32
+	err = tx.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM job_applications WHERE job_id = $1 AND status = 'accepted'",
+		jobId).Scan(&accepted)
+	if err != nil {
+		s.logger.Error("Failed to count accepted applications", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to accept job application")
+	}
+	if accepted >= maxWizards {
+		return nil, status.Error(codes.FailedPrecondition, "Job is full")
+	}
+
+	assignmentId, endTime, err := s.startJobAssignment(ctx, tx, jobId, wizardId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE job_applications SET status = 'accepted', assignment_id = $1, decided_at = $2 WHERE id = $3",
+		assignmentId, now, req.ApplicationId)
+	if err != nil {
+		s.logger.Error("Failed to accept job application", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to accept job application")
+	}
+
+	if accepted+1 >= maxWizards {
+		_, err = tx.ExecContext(ctx,
+			"UPDATE job_postings SET status = 'filled', closed_at = $1 WHERE job_id = $2",
+			now, jobId)
+		if err != nil {
+			s.logger.Error("Failed to fill job posting", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to accept job application")
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"UPDATE job_applications SET status = 'rejected', decided_at = $1 WHERE job_id = $2 AND status = 'pending'",
+			now, jobId)
+		if err != nil {
+			s.logger.Error("Failed to reject remaining job applications", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to accept job application")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to accept job application")
+	}
+
+	s.completions.ScheduleJobCompletion(assignmentId, endTime)
+
+	return s.getJobApplication(ctx, req.ApplicationId)
+}
+
+// RejectJobApplication turns down an applicant to a posted job
+func (s *WizardServiceImpl) RejectJobApplication(ctx context.Context, req *pb.RejectJobApplicationRequest) (*pb.JobApplication, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	var posterId int64
+	var applicationStatus string
+	err = tx.QueryRowContext(ctx,
+		`SELECT jpo.poster_wizard_id, a.status
+		 FROM job_applications a
+		 JOIN job_postings jpo ON jpo.job_id = a.job_id
+		 WHERE a.id = $1
+		 FOR UPDATE OF a`,
+		req.ApplicationId).Scan(&posterId, &applicationStatus)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Job application not found")
+		}
+		s.logger.Error("Failed to lock job application", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to reject job application")
+	}
+	if posterId != req.PosterWizardId {
+		return nil, status.Error(codes.PermissionDenied, "Only the job's poster can decide its applications")
+	}
+	if applicationStatus != "pending" {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Application is already %s", applicationStatus))
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE job_applications SET status = 'rejected', decided_at = $1 WHERE id = $2",
+		s.clock.Now(), req.ApplicationId)
+	if err != nil {
+		s.logger.Error("Failed to reject job application", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to reject job application")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata)
+		 SELECT w.user_id, w.id, 'job_application_rejected',
+		        'Application rejected for job: ' || j.title,
+		        json_build_object('job_id', j.id, 'application_id', a.id, 'job_title', j.title)
+		 FROM job_applications a
+		 JOIN wizards w ON a.wizard_id = w.id
+		 JOIN jobs j ON a.job_id = j.id
+		 WHERE a.id = $1`,
+		req.ApplicationId)
+	if err != nil {
+		s.logger.Error("Failed to create activity log", "error", err)
+		// Don't fail the transaction for activity log issues
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to reject job application")
+	}
+
+	return s.getJobApplication(ctx, req.ApplicationId)
+}
+
+// workingPostedPlaces counts the wizards still working a posted job. The budget
+// of each of their places stays in escrow until they finish.
+func workingPostedPlaces(ctx context.Context, tx *sql.Tx, jobId int64) (int64, error) {
+	var working int64
+	err := tx.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM job_assignments WHERE job_id = $1 AND status IN ('assigned', 'in_progress', 'paused')",
+		jobId).Scan(&working)
+	if err != nil {
+		return 0, fmt.Errorf("count working wizards: %w", err)
+	}
+	return working, nil
+}
+
+// releaseJobPosting refunds what a posted job still holds in escrow to its
+// poster before the job is deleted. A job wizards are still working cannot be
+// deleted. Errors are gRPC statuses.
+func (s *WizardServiceImpl) releaseJobPosting(ctx context.Context, tx *sql.Tx, jobId int64) error {
+	var posterId, escrow int64
+	err := tx.QueryRowContext(ctx,
+		"SELECT poster_wizard_id, escrow_remaining FROM job_postings WHERE job_id = $1 FOR UPDATE",
+		jobId).Scan(&posterId, &escrow)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		s.logger.Error("Failed to lock job posting", "error", err)
+		return status.Error(codes.Internal, "Failed to delete job")
+	}
+
+	working, err := workingPostedPlaces(ctx, tx, jobId)
+	if err != nil {
+		s.logger.Error("Failed to count working wizards", "error", err)
+		return status.Error(codes.Internal, "Failed to delete job")
+	}
+	if working > 0 {
+		return status.Error(codes.FailedPrecondition, "Wizards are still working this job")
+	}
+
+	if escrow > 0 {
+		_, err = ledger.Post(ctx, tx, ledger.Transfer("job_escrow_refund", fmt.Sprintf("Job %d deleted", jobId),
+			ledger.Escrow(posterId), ledger.Wizard(posterId), escrow))
+		if err != nil {
+			s.logger.Error("Failed to refund job escrow", "error", err)
+			return status.Error(codes.Internal, "Failed to delete job")
+		}
+	}
+	return nil
+}
+
+// expireJobPosting closes an open posting that has passed its expiry. The
+// escrow not held for wizards still working the job goes back to the poster,
+// waiting applications expire and the job leaves the board. Wizards already
+// working it carry on and are paid from escrow as usual.
+func (s *WizardServiceImpl) expireJobPosting(ctx context.Context, jobId int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	now := s.clock.Now()
+	var posterId, escrow int64
+	var budget int32
+	err = tx.QueryRowContext(ctx,
+		`SELECT poster_wizard_id, reward_per_wizard, escrow_remaining
+		 FROM job_postings
+		 WHERE job_id = $1 AND status = 'open' AND expires_at <= $2
+		 FOR UPDATE`,
+		jobId, now).Scan(&posterId, &budget, &escrow)
+	if err == sql.ErrNoRows {
+		// Filled or expired since it was read, possibly by another replica
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("lock job posting: %w", err)
+	}
+
+	working, err := workingPostedPlaces(ctx, tx, jobId)
+	if err != nil {
+		return err
+	}
+
+	refund := escrow - int64(budget)*working
+	if refund < 0 {
+		refund = 0
+	}
+	if refund > 0 {
+		_, err = ledger.Post(ctx, tx, ledger.Transfer("job_escrow_refund", fmt.Sprintf("Job %d posting expired", jobId),
+			ledger.Escrow(posterId), ledger.Wizard(posterId), refund))
+		if err != nil {
+			return fmt.Errorf("refund job escrow: %w", err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE job_postings SET status = 'expired', escrow_remaining = escrow_remaining - $1, closed_at = $2 WHERE job_id = $3",
+		refund, now, jobId)
+	if err != nil {
+		return fmt.Errorf("expire job posting: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE job_applications SET status = 'expired', decided_at = $1 WHERE job_id = $2 AND status = 'pending'",
+		now, jobId)
+	if err != nil {
+		return fmt.Errorf("expire job applications: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE jobs SET is_active = false, updated_at = CURRENT_TIMESTAMP WHERE id = $1",
+		jobId)
+	if err != nil {
+		return fmt.Errorf("deactivate job: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata)
+		 SELECT w.user_id, w.id, 'job_posting_expired',
+		        'Job posting expired: ' || j.title || ' - Refunded ' || $3::text || ' mana',
+		        json_build_object('job_id', j.id, 'job_title', j.title, 'refunded', $3::bigint)
+		 FROM wizards w
+		 JOIN jobs j ON j.id = $1
+		 WHERE w.id = $2`,
+		jobId, posterId, refund)
+	if err != nil {
+		s.logger.Error("Failed to create activity log", "error", err)
+		// Don't fail the transaction for activity log issues
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
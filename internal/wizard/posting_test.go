@@ -0,0 +1,457 @@
+package wizard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/internal/ledger/ledgertest"
+	"github.com/tectix/mysticfunds/pkg/sim"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// postedJobRequest is a two-hour job for two wizards at 300 mana an hour, so
+// each place is funded with 600 mana
+func postedJobRequest() *pb.CreateJobRequest {
+	return &pb.CreateJobRequest{
+		RealmName:         "Nyxthar",
+		Title:             "Guard the archive",
+		RequiredElement:   "Fire",
+		RequiredLevel:     1,
+		ManaRewardPerHour: 300,
+		ExpRewardPerHour:  60,
+		DurationMinutes:   120,
+		MaxWizards:        2,
+		Difficulty:        "Easy",
+		JobType:           "Guard",
+		CreatedByWizardId: 3,
+	}
+}
+
+func TestCreateJobFundsPostingEscrow(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch)
+	service.postingTTL = 72 * time.Hour
+	expiresAt := jobEpoch.Add(72 * time.Hour)
+
+	mock.ExpectQuery("SELECT id FROM realms WHERE name = \\$1").
+		WithArgs("Nyxthar").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(5000))
+	mock.ExpectQuery("INSERT INTO jobs").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(9))
+	mock.ExpectExec("INSERT INTO job_postings").
+		WithArgs(9, 3, 600, 1200, expiresAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	ledgertest.ExpectPost(mock, ledger.Transfer("job_escrow", "", ledger.Wizard(3), ledger.Escrow(3), 1200),
+		map[int64]int64{3: 3800})
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT j.id, j.realm_id").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "realm_id", "realm_name", "title", "description",
+			"required_element", "required_level", "mana_reward_per_hour", "exp_reward_per_hour",
+			"duration_minutes", "max_wizards", "currently_assigned", "difficulty", "job_type",
+			"location", "special_requirements", "created_by_wizard_id", "created_at", "updated_at", "is_active",
+			"min_size", "composition", "reward_split", "poster_wizard_id", "reward_per_wizard", "escrow_remaining",
+			"posting_status", "expires_at", "closed_at", "template_id", "job_expires_at"}).
+			AddRow(9, 7, "Nyxthar", "Guard the archive", "", "Fire", 1, 300, 60,
+				120, 2, 0, "Easy", "Guard", nil, nil, 3, jobEpoch, jobEpoch, true,
+				nil, nil, nil, 3, 600, 1200, "open", expiresAt, nil, nil, nil))
+
+	job, err := service.CreateJob(context.Background(), postedJobRequest())
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, job.Posting) {
+		assert.Equal(t, int64(3), job.Posting.PosterWizardId)
+		assert.Equal(t, int32(600), job.Posting.RewardPerWizard)
+		assert.Equal(t, int64(1200), job.Posting.EscrowRemaining)
+		assert.Equal(t, "open", job.Posting.Status)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateJobPostingNeedsEscrow(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT id FROM realms WHERE name = \\$1").
+		WithArgs("Nyxthar").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(1000))
+	mock.ExpectRollback()
+
+	_, err := service.CreateJob(context.Background(), postedJobRequest())
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "Job needs 1200 mana in escrow, wizard has 1000")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAssignWizardToJobRejectsPostedJobs(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT max_wizards, currently_assigned, duration_minutes").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"max_wizards", "currently_assigned", "duration_minutes", "party_job", "posted_job"}).AddRow(2, 0, 60, false, true))
+	mock.ExpectRollback()
+
+	_, err := service.AssignWizardToJob(context.Background(), &pb.AssignWizardToJobRequest{WizardId: 1, JobId: 9})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplyToJobRejectsOwnJob(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch)
+
+	mock.ExpectQuery("SELECT poster_wizard_id, status, expires_at FROM job_postings").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"poster_wizard_id", "status", "expires_at"}).AddRow(3, "open", jobEpoch.Add(time.Hour)))
+
+	_, err := service.ApplyToJob(context.Background(), &pb.ApplyToJobRequest{JobId: 9, WizardId: 3})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAcceptJobApplicationFillsPosting(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT job_id FROM job_applications WHERE id = \\$1").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"job_id"}).AddRow(9))
+	mock.ExpectQuery("SELECT jpo.poster_wizard_id, jpo.status, jpo.expires_at, j.max_wizards(.+)FOR UPDATE OF jpo").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"poster_wizard_id", "status", "expires_at", "max_wizards"}).AddRow(3, "open", jobEpoch.Add(time.Hour), 2))
+	mock.ExpectQuery("SELECT wizard_id, status FROM job_applications WHERE id = \\$1 FOR UPDATE").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"wizard_id", "status"}).AddRow(1, "pending"))
+	// One place is already taken, so this takes the last
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM job_applications").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery("SELECT max_wizards, currently_assigned, duration_minutes").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"max_wizards", "currently_assigned", "duration_minutes", "party_job", "posted_job"}).AddRow(2, 1, 60, false, true))
+	mock.ExpectQuery("SELECT w.element, w.level FROM wizards w").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"element", "level"}).AddRow("Fire", 3))
+	mock.ExpectQuery("SELECT required_element, required_level, difficulty FROM jobs").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"required_element", "required_level", "difficulty"}).AddRow("Fire", 1, "Easy"))
+	mock.ExpectQuery("SELECT level FROM level_rewards WHERE reward_type = 'job_tier'").
+		WithArgs("Easy").
+		WillReturnRows(sqlmock.NewRows([]string{"level"}))
+	mock.ExpectQuery("INSERT INTO job_assignments").
+		WithArgs(9, 1, jobEpoch, 1.0, 1.0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectExec("UPDATE jobs SET currently_assigned = currently_assigned \\+ 1").
+		WithArgs(9).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO job_progress").
+		WithArgs(5, jobEpoch, jobEpoch.Add(time.Hour)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(5, 9, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE job_applications SET status = 'accepted'").
+		WithArgs(5, jobEpoch, 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_postings SET status = 'filled'").
+		WithArgs(jobEpoch, 9).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_applications SET status = 'rejected'(.+)status = 'pending'").
+		WithArgs(jobEpoch, 9).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT a.id, a.job_id, a.wizard_id").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "job_id", "wizard_id", "name", "status", "message",
+			"assignment_id", "created_at", "decided_at"}).
+			AddRow(4, 9, 1, "Merlin", "accepted", nil, 5, jobEpoch, jobEpoch))
+
+	application, err := service.AcceptJobApplication(context.Background(), &pb.AcceptJobApplicationRequest{ApplicationId: 4, PosterWizardId: 3})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "accepted", application.Status)
+	assert.Equal(t, int64(5), application.AssignmentId)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAcceptJobApplicationAfterExpiry(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch.Add(2 * time.Hour))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT job_id FROM job_applications WHERE id = \\$1").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"job_id"}).AddRow(9))
+	mock.ExpectQuery("SELECT jpo.poster_wizard_id, jpo.status, jpo.expires_at, j.max_wizards(.+)FOR UPDATE OF jpo").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"poster_wizard_id", "status", "expires_at", "max_wizards"}).AddRow(3, "open", jobEpoch.Add(time.Hour), 2))
+	mock.ExpectQuery("SELECT wizard_id, status FROM job_applications WHERE id = \\$1 FOR UPDATE").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"wizard_id", "status"}).AddRow(1, "pending"))
+	mock.ExpectRollback()
+
+	_, err := service.AcceptJobApplication(context.Background(), &pb.AcceptJobApplicationRequest{ApplicationId: 4, PosterWizardId: 3})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// expectPostedJobLock sets up locking the posting of job 2, posted by wizard 3,
+// and the balances of its poster and worker
+func expectPostedJobLock(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT job_id FROM job_postings WHERE job_id = \\$1 FOR UPDATE").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"job_id"}).AddRow(2))
+	for _, wizardId := range []int64{1, 3} {
+		mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1 FOR UPDATE").
+			WithArgs(wizardId).
+			WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(0))
+	}
+}
+
+func TestRejectJobApplicationOnlyByPoster(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT jpo.poster_wizard_id, a.status(.+)FOR UPDATE OF a").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"poster_wizard_id", "status"}).AddRow(3, "pending"))
+	mock.ExpectRollback()
+
+	_, err := service.RejectJobApplication(context.Background(), &pb.RejectJobApplicationRequest{ApplicationId: 4, PosterWizardId: 1})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCompleteJobAssignmentPaysFromEscrow(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	now := jobEpoch.Add(time.Hour)
+	service.clock = sim.NewFakeClock(now)
+
+	mock.ExpectBegin()
+	expectNoParty(mock, 5)
+	mock.ExpectQuery("SELECT ja.job_id, ja.wizard_id, j.realm_id").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"job_id", "wizard_id", "realm_id", "mana_reward_per_hour",
+			"exp_reward_per_hour", "duration_minutes", "experience_points", "level", "actual_start_time", "expected_end_time",
+			"reward_factor", "speed_factor", "poster_wizard_id", "reward_per_wizard"}).
+			AddRow(2, 1, 7, 600, 120, 60, 0, 1, jobEpoch, now, 1.0, 1.0, 3, 600))
+	mock.ExpectQuery("SELECT name, mana_boost_factor FROM realms WHERE id = \\$1").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_boost_factor"}).AddRow("Nyxthar", 1.25))
+	expectPostedJobLock(mock)
+	// The realm boost would make 750, but escrow only holds 600 for the place
+	mock.ExpectExec("UPDATE job_assignments SET status = 'completed'").
+		WithArgs(600, 120, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	ledgertest.ExpectPost(mock, ledger.Transfer("job_reward", "", ledger.Escrow(3), ledger.Wizard(1), 600),
+		map[int64]int64{1: 600})
+	mock.ExpectExec("UPDATE wizards SET experience_points = \\$1, level = \\$2").
+		WithArgs(120, 2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT level, reward_type, amount").
+		WithArgs(1, 2).
+		WillReturnRows(levelRewardRows())
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(1, 1, 2, 120, "[]").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	// Nothing is left over to refund
+	mock.ExpectExec("UPDATE job_postings SET escrow_remaining = escrow_remaining - \\$1").
+		WithArgs(600, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE jobs SET currently_assigned = currently_assigned - 1").
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_progress SET progress_percentage = 100").
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(5, 600, 120, 600, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAssignmentReadBack(mock, 5, "completed", 600, now)
+
+	_, err := service.CompleteJobAssignment(context.Background(), &pb.CompleteJobAssignmentRequest{AssignmentId: 5})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCancelJobAssignmentRefundsEscrow(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch.Add(15 * time.Minute))
+
+	mock.ExpectBegin()
+	expectNoParty(mock, 5)
+	mock.ExpectQuery("SELECT ja.job_id, ja.wizard_id, j.realm_id(.+)FOR UPDATE OF ja").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"job_id", "wizard_id", "realm_id", "mana_reward_per_hour",
+			"exp_reward_per_hour", "duration_minutes", "experience_points", "level", "progress_percentage",
+			"time_worked_minutes", "is_active", "actual_start_time", "expected_end_time", "paused_at", "reward_factor", "speed_factor",
+			"poster_wizard_id", "reward_per_wizard"}).
+			AddRow(2, 1, 7, 600, 120, 60, 0, 1, 0, 0, true, jobEpoch, jobEpoch.Add(time.Hour), nil, 1.0, 1.0, 3, 600))
+	expectPostedJobLock(mock)
+	mock.ExpectExec("UPDATE job_assignments SET status = 'cancelled'").
+		WithArgs("", 0, 0, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// Abandoned below the payout threshold, the whole place goes back to the poster
+	ledgertest.ExpectPost(mock, ledger.Transfer("job_escrow_refund", "", ledger.Escrow(3), ledger.Wizard(3), 600),
+		map[int64]int64{3: 600})
+	mock.ExpectExec("UPDATE job_postings SET escrow_remaining = escrow_remaining - \\$1").
+		WithArgs(600, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE jobs SET currently_assigned = currently_assigned - 1").
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_progress SET progress_percentage = \\$1").
+		WithArgs(25, 15, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(5, 25, 0, 0, "", "[]").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAssignmentReadBack(mock, 5, "cancelled", 0, jobEpoch)
+
+	_, err := service.CancelJobAssignment(context.Background(), &pb.CancelJobAssignmentRequest{AssignmentId: 5})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpireJobPostingRefundsOpenPlaces(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	now := jobEpoch.Add(72 * time.Hour)
+	service.clock = sim.NewFakeClock(now)
+
+	mock.ExpectBegin()
+	// One of three places was taken and its wizard is still working
+	mock.ExpectQuery("SELECT poster_wizard_id, reward_per_wizard, escrow_remaining(.+)FOR UPDATE").
+		WithArgs(9, now).
+		WillReturnRows(sqlmock.NewRows([]string{"poster_wizard_id", "reward_per_wizard", "escrow_remaining"}).AddRow(3, 600, 1800))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM job_assignments").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	// Their place stays in escrow and the rest goes back
+	ledgertest.ExpectPost(mock, ledger.Transfer("job_escrow_refund", "", ledger.Escrow(3), ledger.Wizard(3), 1200),
+		map[int64]int64{3: 1200})
+	mock.ExpectExec("UPDATE job_postings SET status = 'expired'").
+		WithArgs(1200, now, 9).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_applications SET status = 'expired'").
+		WithArgs(now, 9).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE jobs SET is_active = false").
+		WithArgs(9).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(9, 3, 1200).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err := service.expireJobPosting(context.Background(), 9)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateJobKeepsPostedJobRewardAndPlaces(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT mana_reward_per_hour, max_wizards, EXISTS(.+)FROM jobs WHERE id = \\$1").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_reward_per_hour", "max_wizards", "exists"}).AddRow(300, 3, true))
+
+	// Another place would take a wizard escrow was never funded for
+	_, err := service.UpdateJob(context.Background(), &pb.UpdateJobRequest{
+		Id:                9,
+		Title:             "Guard the caravan",
+		ManaRewardPerHour: 300,
+		MaxWizards:        4,
+		IsActive:          true,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteJobRefundsPostedJobEscrow(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT poster_wizard_id, escrow_remaining FROM job_postings WHERE job_id = \\$1 FOR UPDATE").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"poster_wizard_id", "escrow_remaining"}).AddRow(3, 1200))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM job_assignments").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	ledgertest.ExpectPost(mock, ledger.Transfer("job_escrow_refund", "", ledger.Escrow(3), ledger.Wizard(3), 1200),
+		map[int64]int64{3: 1200})
+	mock.ExpectExec("DELETE FROM jobs WHERE id = \\$1").
+		WithArgs(9).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	resp, err := service.DeleteJob(context.Background(), &pb.DeleteJobRequest{Id: 9})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteJobRefusesPostedJobBeingWorked(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT poster_wizard_id, escrow_remaining FROM job_postings WHERE job_id = \\$1 FOR UPDATE").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"poster_wizard_id", "escrow_remaining"}).AddRow(3, 600))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM job_assignments").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectRollback()
+
+	_, err := service.DeleteJob(context.Background(), &pb.DeleteJobRequest{Id: 9})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
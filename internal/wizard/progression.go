@@ -0,0 +1,199 @@
+package wizard
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/internal/progression"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// baseWizardSlots is how many wizards a user can create before any level has
+// unlocked more
+const baseWizardSlots = 2
+
+// upcomingUnlocks is how many rewards above the current level GetProgression lists
+const upcomingUnlocks = 10
+
+// queryer is satisfied by both *sql.DB and *sql.Tx
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// GetProgression returns a wizard's level on the configured curve, the
+// experience left to the next level and the rewards of the levels ahead
+func (s *WizardServiceImpl) GetProgression(ctx context.Context, req *pb.GetProgressionRequest) (*pb.Progression, error) {
+	var exp int32
+	err := s.db.QueryRowContext(ctx,
+		"SELECT experience_points FROM wizards WHERE id = $1",
+		req.WizardId).Scan(&exp)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Wizard not found")
+		}
+		s.logger.Error("Failed to get wizard experience", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get progression")
+	}
+
+	levelRewards, err := s.levelRewards(ctx, s.db, 0, s.curve.MaxLevel())
+	if err != nil {
+		s.logger.Error("Failed to get level rewards", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get progression")
+	}
+
+	// The level follows the curve in force, even before the stored level catches
+	// up with a change to it
+	progress := s.curve.Progress(exp)
+	resp := &pb.Progression{
+		WizardId:                req.WizardId,
+		Curve:                   s.curve.Name(),
+		Level:                   progress.Level,
+		MaxLevel:                progress.MaxLevel,
+		ExperiencePoints:        exp,
+		LevelExp:                progress.LevelExp,
+		NextLevelExp:            progress.NextLevelExp,
+		UnlockedJobDifficulties: []string{},
+		UpcomingUnlocks:         []*pb.LevelReward{},
+	}
+	if progress.NextLevelExp > 0 {
+		resp.ExpToNextLevel = progress.NextLevelExp - exp
+	}
+
+	for _, reward := range levelRewards {
+		if reward.Level > progress.Level {
+			if len(resp.UpcomingUnlocks) < upcomingUnlocks {
+				resp.UpcomingUnlocks = append(resp.UpcomingUnlocks, levelRewardToProto(reward))
+			}
+			continue
+		}
+
+		switch reward.Type {
+		case progression.RewardJobTier:
+			resp.UnlockedJobDifficulties = append(resp.UnlockedJobDifficulties, reward.JobDifficulty)
+		case progression.RewardWizardSlot:
+			resp.BonusWizardSlots += int32(reward.Amount)
+		}
+	}
+
+	return resp, nil
+}
+
+// levelRewards returns the rewards of the levels above after up to and
+// including upTo, lowest level first
+func (s *WizardServiceImpl) levelRewards(ctx context.Context, q queryer, after, upTo int32) ([]progression.Reward, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT level, reward_type, amount, COALESCE(job_difficulty, ''), description
+		 FROM level_rewards
+		 WHERE level > $1 AND level <= $2
+		 ORDER BY level, id`,
+		after, upTo)
+	if err != nil {
+		return nil, fmt.Errorf("load level rewards: %w", err)
+	}
+	defer rows.Close()
+
+	var levelRewards []progression.Reward
+	for rows.Next() {
+		var reward progression.Reward
+		if err := rows.Scan(&reward.Level, &reward.Type, &reward.Amount, &reward.JobDifficulty, &reward.Description); err != nil {
+			return nil, fmt.Errorf("scan level reward: %w", err)
+		}
+		levelRewards = append(levelRewards, reward)
+	}
+	return levelRewards, rows.Err()
+}
+
+// grantLevelRewards grants the rewards of the levels a wizard has just reached.
+// Mana bonuses are paid through the ledger once per wizard and level, so a level
+// that is lost to a curve change and reached again pays nothing more. Job tiers
+// and wizard slots follow the level and need nothing written.
+func (s *WizardServiceImpl) grantLevelRewards(ctx context.Context, tx *sql.Tx, wizardId int64, fromLevel, toLevel int32) ([]progression.Reward, error) {
+	levelRewards, err := s.levelRewards(ctx, tx, fromLevel, toLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reward := range levelRewards {
+		if reward.Type != progression.RewardManaBonus || reward.Amount <= 0 {
+			continue
+		}
+
+		reference := fmt.Sprintf("wizard:%d:level:%d", wizardId, reward.Level)
+		recorded, err := ledger.Recorded(ctx, tx, reference)
+		if err != nil {
+			return nil, err
+		}
+		if recorded {
+			continue
+		}
+
+		entry := ledger.Transfer("level_reward", fmt.Sprintf("Level %d reward: %s", reward.Level, reward.Description),
+			ledger.System(), ledger.Wizard(wizardId), reward.Amount)
+		entry.Reference = reference
+		if _, err := ledger.Post(ctx, tx, entry); err != nil {
+			return nil, fmt.Errorf("post level %d reward: %w", reward.Level, err)
+		}
+	}
+
+	return levelRewards, nil
+}
+
+// jobTierLevel returns the level that unlocks jobs of difficulty, or 0 if jobs
+// of that difficulty are not gated by level
+func (s *WizardServiceImpl) jobTierLevel(ctx context.Context, q queryRower, difficulty string) (int32, error) {
+	var level int32
+	err := q.QueryRowContext(ctx,
+		"SELECT level FROM level_rewards WHERE reward_type = 'job_tier' AND job_difficulty = $1",
+		difficulty).Scan(&level)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("get job tier level: %w", err)
+	}
+	return level, nil
+}
+
+// bonusWizardSlots returns the extra wizards a user may create, unlocked by the
+// level of their highest-level wizard
+func (s *WizardServiceImpl) bonusWizardSlots(ctx context.Context, userId int64) (int, error) {
+	var slots int
+	err := s.db.QueryRowContext(ctx,
+		`SELECT COALESCE(SUM(amount), 0) FROM level_rewards
+		 WHERE reward_type = 'wizard_slot'
+		 AND level <= (SELECT COALESCE(MAX(level), 0) FROM wizards WHERE user_id = $1)`,
+		userId).Scan(&slots)
+	if err != nil {
+		return 0, fmt.Errorf("get bonus wizard slots: %w", err)
+	}
+	return slots, nil
+}
+
+func levelRewardToProto(reward progression.Reward) *pb.LevelReward {
+	return &pb.LevelReward{
+		Level:         reward.Level,
+		RewardType:    reward.Type,
+		Amount:        reward.Amount,
+		JobDifficulty: reward.JobDifficulty,
+		Description:   reward.Description,
+	}
+}
+
+// levelRewardsJSON encodes granted level rewards for activity log metadata
+func levelRewardsJSON(granted []progression.Reward) string {
+	if len(granted) == 0 {
+		return "[]"
+	}
+
+	encoded, err := json.Marshal(granted)
+	if err != nil {
+		return "[]"
+	}
+	return string(encoded)
+}
@@ -0,0 +1,178 @@
+package wizard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/internal/ledger/ledgertest"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+func levelRewardRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"level", "reward_type", "amount", "job_difficulty", "description"})
+}
+
+func TestGetProgression(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT experience_points FROM wizards WHERE id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"experience_points"}).AddRow(250))
+	mock.ExpectQuery("SELECT level, reward_type, amount").
+		WithArgs(0, 50).
+		WillReturnRows(levelRewardRows().
+			AddRow(1, "job_tier", 0, "Easy", "Easy jobs").
+			AddRow(2, "job_tier", 0, "Medium", "Medium jobs").
+			AddRow(2, "mana_bonus", 100, "", "Apprentice's purse").
+			AddRow(3, "job_tier", 0, "Hard", "Hard jobs").
+			AddRow(4, "job_tier", 0, "Expert", "Expert jobs").
+			AddRow(10, "wizard_slot", 1, "", "Take on an apprentice"))
+
+	resp, err := service.GetProgression(context.Background(), &pb.GetProgressionRequest{WizardId: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "linear", resp.Curve)
+	assert.Equal(t, int32(3), resp.Level)
+	assert.Equal(t, int32(200), resp.LevelExp)
+	assert.Equal(t, int32(300), resp.NextLevelExp)
+	assert.Equal(t, int32(50), resp.ExpToNextLevel)
+	assert.Equal(t, []string{"Easy", "Medium", "Hard"}, resp.UnlockedJobDifficulties)
+	assert.Equal(t, int32(0), resp.BonusWizardSlots)
+	if assert.Len(t, resp.UpcomingUnlocks, 2) {
+		assert.Equal(t, "Expert", resp.UpcomingUnlocks[0].JobDifficulty)
+		assert.Equal(t, "wizard_slot", resp.UpcomingUnlocks[1].RewardType)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetProgressionWizardNotFound(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT experience_points FROM wizards WHERE id = \\$1").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"experience_points"}))
+
+	_, err := service.GetProgression(context.Background(), &pb.GetProgressionRequest{WizardId: 9})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreditJobRewardGrantsLevelRewards(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	mock.ExpectBegin()
+	tx, err := db.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+
+	// 80 + 150 experience takes the wizard from level 1 to 3
+	mock.ExpectExec("UPDATE wizards SET experience_points = \\$1, level = \\$2").
+		WithArgs(230, 3, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT level, reward_type, amount").
+		WithArgs(1, 3).
+		WillReturnRows(levelRewardRows().
+			AddRow(2, "job_tier", 0, "Medium", "Medium jobs").
+			AddRow(2, "mana_bonus", 100, "", "Apprentice's purse").
+			AddRow(3, "mana_bonus", 150, "", "Adept's stipend"))
+	mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM ledger_entries WHERE reference = \\$1\\)").
+		WithArgs("wizard:1:level:2").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	ledgertest.ExpectPost(mock, ledger.Transfer("level_reward", "", ledger.System(), ledger.Wizard(1), 100),
+		map[int64]int64{1: 100})
+	// Level 3 was reached before a curve change and has been paid already
+	mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM ledger_entries WHERE reference = \\$1\\)").
+		WithArgs("wizard:1:level:3").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(1, 1, 3, 150, `[{"level":2,"type":"job_tier","amount":0,"job_difficulty":"Medium","description":"Medium jobs"},`+
+			`{"level":2,"type":"mana_bonus","amount":100,"description":"Apprentice's purse"},`+
+			`{"level":3,"type":"mana_bonus","amount":150,"description":"Adept's stipend"}]`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = service.creditJobReward(ctx, tx, "Job assignment 5", ledger.System(), 1, 0, 150, 80, 1)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateWizardUsesUnlockedSlots(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM wizards WHERE user_id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery("SELECT COALESCE\\(SUM\\(amount\\), 0\\) FROM level_rewards").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"slots"}).AddRow(1))
+	mock.ExpectQuery("INSERT INTO wizards").
+		WithArgs(1, "Apprentice", "Umbros", "Shadow").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectQuery("SELECT (.+) FROM wizards").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name", "realm", "element", "mana_balance", "created_at", "updated_at", "experience_points", "level", "guild_id", "guild_name"}).
+			AddRow(3, 1, "Apprentice", "Umbros", "Shadow", 0, time.Now(), time.Now(), 0, 1, nil, nil))
+
+	_, err := service.CreateWizard(context.Background(), &pb.CreateWizardRequest{
+		UserId:  1,
+		Name:    "Apprentice",
+		Realm:   "Umbros",
+		Element: "Shadow",
+	})
+	assert.NoError(t, err)
+
+	// Every unlocked slot is taken
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM wizards WHERE user_id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery("SELECT COALESCE\\(SUM\\(amount\\), 0\\) FROM level_rewards").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"slots"}).AddRow(1))
+
+	_, err = service.CreateWizard(context.Background(), &pb.CreateWizardRequest{
+		UserId:  1,
+		Name:    "Second Apprentice",
+		Realm:   "Umbros",
+		Element: "Shadow",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAssignWizardToJobRequiresJobTier(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT max_wizards, currently_assigned, duration_minutes").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"max_wizards", "currently_assigned", "duration_minutes", "party_job", "posted_job"}).AddRow(2, 0, 60, false, false))
+	mock.ExpectQuery("SELECT w.element, w.level FROM wizards w").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"element", "level"}).AddRow("Time", 5))
+	mock.ExpectQuery("SELECT required_element, required_level, difficulty FROM jobs").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"required_element", "required_level", "difficulty"}).AddRow("Time", 5, "Legendary"))
+	mock.ExpectQuery("SELECT level FROM level_rewards WHERE reward_type = 'job_tier'").
+		WithArgs("Legendary").
+		WillReturnRows(sqlmock.NewRows([]string{"level"}).AddRow(6))
+	mock.ExpectRollback()
+
+	_, err := service.AssignWizardToJob(context.Background(), &pb.AssignWizardToJobRequest{WizardId: 1, JobId: 9})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "Legendary jobs unlock at level 6")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,89 @@
+package wizard
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tectix/mysticfunds/internal/rewards"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// queryRower is satisfied by both *sql.DB and *sql.Tx
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// GetRewardModifiers returns the modifiers that apply to a wizard's rewards. Job rewards
+// use the realm the job is in; investment returns use the wizard's home realm.
+func (s *WizardServiceImpl) GetRewardModifiers(ctx context.Context, req *pb.GetRewardModifiersRequest) (*pb.GetRewardModifiersResponse, error) {
+	var exists bool
+	err := s.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM wizards WHERE id = $1)", req.WizardId).Scan(&exists)
+	if err != nil {
+		s.logger.Error("Failed to check wizard", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get reward modifiers")
+	}
+
+	if !exists {
+		return nil, status.Error(codes.NotFound, "Wizard not found")
+	}
+
+	modifiers, err := s.rewardModifiers(ctx, s.db, req.WizardId, req.RealmId)
+	if err != nil {
+		s.logger.Error("Failed to get reward modifiers", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get reward modifiers")
+	}
+
+	return &pb.GetRewardModifiersResponse{
+		Modifiers: rewards.ToProto(modifiers),
+	}, nil
+}
+
+// rewardModifiers collects the modifiers for a reward earned by wizardID. When realmID is 0
+// the wizard's home realm is used.
+func (s *WizardServiceImpl) rewardModifiers(ctx context.Context, q queryRower, wizardID, realmID int64) ([]rewards.Modifier, error) {
+	var realmName string
+	var boost float64
+	var err error
+
+	if realmID > 0 {
+		err = q.QueryRowContext(ctx,
+			"SELECT name, mana_boost_factor FROM realms WHERE id = $1",
+			realmID).Scan(&realmName, &boost)
+	} else {
+		err = q.QueryRowContext(ctx,
+			`SELECT r.name, r.mana_boost_factor FROM wizards w
+			 JOIN realms r ON r.name = w.realm
+			 WHERE w.id = $1`,
+			wizardID).Scan(&realmName, &boost)
+	}
+	if err == sql.ErrNoRows {
+		// Wizards from realms that no longer exist earn unmodified rewards
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return []rewards.Modifier{{
+		Name:   realmName,
+		Source: rewards.SourceRealm,
+		Factor: boost,
+	}}, nil
+}
+
+// modifiersJSON encodes applied modifiers for activity log metadata
+func modifiersJSON(applied []rewards.Applied) string {
+	if len(applied) == 0 {
+		return "[]"
+	}
+
+	encoded, err := json.Marshal(applied)
+	if err != nil {
+		return "[]"
+	}
+	return string(encoded)
+}
@@ -0,0 +1,222 @@
+package wizard
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tectix/mysticfunds/pkg/logger"
+	"github.com/tectix/mysticfunds/pkg/sim"
+)
+
+// JobBoardRotator keeps realm job boards fresh: it posts jobs from templates as
+// they fall due and takes templated jobs off the board once they expire
+type JobBoardRotator struct {
+	db           *sql.DB
+	logger       logger.Logger
+	clock        sim.Clock
+	service      *WizardServiceImpl
+	pollInterval time.Duration
+	batchSize    int
+	retryBackoff time.Duration
+	maxBackoff   time.Duration
+	maxFailures  int
+	runMutex     sync.Mutex
+	running      bool
+	done         chan struct{}
+}
+
+// NewJobBoardRotator creates a rotator that reads the time from clock
+func NewJobBoardRotator(db *sql.DB, logger logger.Logger, service *WizardServiceImpl, clock sim.Clock) *JobBoardRotator {
+	return &JobBoardRotator{
+		db:           db,
+		logger:       logger,
+		clock:        clock,
+		service:      service,
+		pollInterval: time.Minute,
+		batchSize:    100,
+		retryBackoff: 30 * time.Second,
+		maxBackoff:   30 * time.Minute,
+		maxFailures:  5,
+		done:         make(chan struct{}),
+	}
+}
+
+// Start begins rotating job boards. Only the leading replica runs the rotator,
+// and running a template locks it and checks it is still due, so each run posts
+// its jobs once even during a failover.
+func (r *JobBoardRotator) Start() {
+	r.runMutex.Lock()
+	defer r.runMutex.Unlock()
+
+	if r.running {
+		return
+	}
+
+	r.running = true
+	r.done = make(chan struct{})
+	r.logger.Info("Starting job board rotator")
+
+	go r.workRoutine(r.done)
+}
+
+// Stop halts the rotator
+func (r *JobBoardRotator) Stop() {
+	r.runMutex.Lock()
+	defer r.runMutex.Unlock()
+
+	if !r.running {
+		return
+	}
+
+	r.running = false
+	close(r.done)
+	r.logger.Info("Job board rotator stopped")
+}
+
+func (r *JobBoardRotator) workRoutine(done chan struct{}) {
+	ticker := r.clock.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		if err := r.expireStaleJobs(ctx); err != nil {
+			r.logger.Error("Failed to expire templated jobs", "error", err)
+		}
+		if err := r.processDue(ctx); err != nil {
+			r.logger.Error("Failed to run job templates", "error", err)
+		}
+		cancel()
+
+		select {
+		case <-ticker.C():
+		case <-done:
+			return
+		}
+	}
+}
+
+// dueTemplate is an active template whose next run has come round
+type dueTemplate struct {
+	id         int64
+	failedRuns int
+}
+
+// processDue runs the active templates that are due, a batch at a time, until
+// none are left. A template that fails to run is retried with backoff rather
+// than holding up the rest.
+func (r *JobBoardRotator) processDue(ctx context.Context) error {
+	for {
+		templates, err := r.dueTemplates(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, template := range templates {
+			if err := r.service.runJobTemplate(ctx, template.id); err != nil {
+				if recordErr := r.recordFailure(ctx, template, err); recordErr != nil {
+					// Without the backoff it would be picked straight up again
+					return fmt.Errorf("record failed run of job template %d: %w", template.id, recordErr)
+				}
+			}
+		}
+
+		if len(templates) < r.batchSize {
+			return nil
+		}
+	}
+}
+
+// recordFailure pushes the template's next run back with exponential backoff,
+// or takes it off the schedule once it has failed maxFailures times in a row
+func (r *JobBoardRotator) recordFailure(ctx context.Context, template dueTemplate, cause error) error {
+	failures := template.failedRuns + 1
+	if failures >= r.maxFailures {
+		r.logger.Error("Job template keeps failing, deactivating it",
+			"error", cause, "template_id", template.id, "failed_runs", failures)
+		_, err := r.db.ExecContext(ctx, `
+			UPDATE job_templates
+			SET is_active = false, failed_runs = $1, last_error = $2, updated_at = CURRENT_TIMESTAMP
+			WHERE id = $3 AND is_active = true`,
+			failures, cause.Error(), template.id)
+		return err
+	}
+
+	delay := r.backoff(failures)
+	r.logger.Warn("Failed to run job template, will retry",
+		"error", cause, "template_id", template.id, "failed_runs", failures, "retryIn", delay)
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE job_templates
+		SET failed_runs = $1, last_error = $2, next_run_at = $3
+		WHERE id = $4 AND is_active = true`,
+		failures, cause.Error(), r.clock.Now().Add(delay), template.id)
+	return err
+}
+
+// backoff doubles the retry delay with every failure, up to maxBackoff
+func (r *JobBoardRotator) backoff(failures int) time.Duration {
+	delay := r.retryBackoff
+	for i := 1; i < failures && delay < r.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > r.maxBackoff {
+		delay = r.maxBackoff
+	}
+	return delay
+}
+
+// dueTemplates returns the next batch of active templates due to run, earliest
+// first
+func (r *JobBoardRotator) dueTemplates(ctx context.Context) ([]dueTemplate, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, failed_runs FROM job_templates
+		WHERE is_active = true AND next_run_at <= $1
+		ORDER BY next_run_at
+		LIMIT $2`,
+		r.clock.Now(), r.batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("load due job templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []dueTemplate
+	for rows.Next() {
+		var template dueTemplate
+		if err := rows.Scan(&template.id, &template.failedRuns); err != nil {
+			return nil, fmt.Errorf("scan due job template: %w", err)
+		}
+		templates = append(templates, template)
+	}
+	return templates, rows.Err()
+}
+
+// expireStaleJobs takes active jobs past their expiry off the board, a batch at
+// a time. Wizards already working them carry on.
+func (r *JobBoardRotator) expireStaleJobs(ctx context.Context) error {
+	for {
+		result, err := r.db.ExecContext(ctx, `
+			UPDATE jobs SET is_active = false, updated_at = CURRENT_TIMESTAMP
+			WHERE id IN (
+				SELECT id FROM jobs
+				WHERE is_active = true AND expires_at <= $1
+				ORDER BY expires_at
+				LIMIT $2)`,
+			r.clock.Now(), r.batchSize)
+		if err != nil {
+			return fmt.Errorf("expire stale jobs: %w", err)
+		}
+
+		expired, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("expire stale jobs: %w", err)
+		}
+		if expired > 0 {
+			r.logger.Info("Expired stale jobs", "count", expired)
+		}
+		if expired < int64(r.batchSize) {
+			return nil
+		}
+	}
+}
@@ -3,7 +3,9 @@ package wizard
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,37 +13,101 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/tectix/mysticfunds/internal/idempotency"
+	"github.com/tectix/mysticfunds/internal/leader"
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/internal/progression"
+	"github.com/tectix/mysticfunds/internal/rewards"
 	"github.com/tectix/mysticfunds/pkg/config"
 	"github.com/tectix/mysticfunds/pkg/logger"
+	"github.com/tectix/mysticfunds/pkg/sim"
 	pb "github.com/tectix/mysticfunds/proto/wizard"
 )
 
 type WizardServiceImpl struct {
-	db     *sql.DB
-	cfg    *config.Config
-	logger logger.Logger
-	ticker *JobTicker
+	db          *sql.DB
+	idempotency *idempotency.Store
+	cfg         *config.Config
+	logger      logger.Logger
+	clock       sim.Clock
+	completions *JobCompletionScheduler
+	leader      *leader.Elector
+	// partialPayoutPercent is the progress from which a cancelled assignment is
+	// paid its share of the job's rewards
+	partialPayoutPercent int32
+	// curve turns experience into levels
+	curve *progression.Curve
+	// postingTTL is how long a job posted by a wizard takes applications unless
+	// the poster says otherwise
+	postingTTL    time.Duration
+	postingExpiry *JobPostingExpirer
+	rotator       *JobBoardRotator
 	pb.UnimplementedWizardServiceServer
 }
 
+// defaultPartialPayoutPercent is used when JOB_PARTIAL_PAYOUT_PERCENT is not set
+const defaultPartialPayoutPercent = 50
+
+// parsePartialPayoutPercent reads JOB_PARTIAL_PAYOUT_PERCENT, a whole percentage
+func parsePartialPayoutPercent(value string) (int32, error) {
+	percent, err := strconv.Atoi(value)
+	if err != nil || percent < 0 || percent > 100 {
+		return 0, fmt.Errorf("invalid JOB_PARTIAL_PAYOUT_PERCENT %q: must be between 0 and 100", value)
+	}
+	return int32(percent), nil
+}
+
 func NewWizardServiceImpl(db *sql.DB, cfg *config.Config, logger logger.Logger) *WizardServiceImpl {
-	service := &WizardServiceImpl{
-		db:     db,
-		cfg:    cfg,
-		logger: logger,
+	clock, _, err := sim.FromConfig(cfg)
+	if err != nil {
+		logger.Warn("Invalid simulation settings, using the system clock", "error", err)
+		clock = sim.RealClock()
+	}
+
+	partialPayout, err := parsePartialPayoutPercent(cfg.GetString("JOB_PARTIAL_PAYOUT_PERCENT", strconv.Itoa(defaultPartialPayoutPercent)))
+	if err != nil {
+		logger.Warn("Invalid partial payout setting, using the default", "error", err)
+		partialPayout = defaultPartialPayoutPercent
+	}
+
+	curve, err := progression.FromConfig(cfg)
+	if err != nil {
+		logger.Warn("Invalid leveling curve, using the default", "error", err)
+		curve = progression.DefaultCurve()
 	}
 
-	// Initialize the job ticker
-	service.ticker = NewJobTicker(db, logger, service)
+	postingTTL, err := parsePostingTTL(cfg.GetString("JOB_POSTING_TTL_HOURS", strconv.Itoa(defaultPostingTTLHours)))
+	if err != nil {
+		logger.Warn("Invalid job posting TTL, using the default", "error", err)
+		postingTTL = defaultPostingTTLHours * time.Hour
+	}
 
-	// Start the ticker automatically
-	service.ticker.Start()
+	service := &WizardServiceImpl{
+		db:                   db,
+		idempotency:          idempotency.NewStore(db, logger),
+		cfg:                  cfg,
+		logger:               logger,
+		clock:                clock,
+		partialPayoutPercent: partialPayout,
+		curve:                curve,
+		postingTTL:           postingTTL,
+	}
+
+	// Complete job assignments as they fall due, expire job postings and rotate
+	// job boards, on whichever replica is leading. Elections run on wall time,
+	// whatever the simulation speed.
+	service.completions = NewJobCompletionScheduler(db, logger, service, clock)
+	service.postingExpiry = NewJobPostingExpirer(db, logger, service, clock)
+	service.rotator = NewJobBoardRotator(db, logger, service, clock)
+	service.leader = leader.NewElector(db, logger, sim.RealClock(), workersElection,
+		leader.InstanceID(cfg), service.completions, service.postingExpiry, service.rotator)
+	service.leader.Start()
 
 	return service
 }
 
 func (s *WizardServiceImpl) CreateWizard(ctx context.Context, req *pb.CreateWizardRequest) (*pb.Wizard, error) {
-	// Check if user already has 2 wizards
+	// Check if user has a free wizard slot
 	var count int
 	err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM wizards WHERE user_id = $1", req.UserId).Scan(&count)
 	if err != nil {
@@ -49,8 +115,17 @@ func (s *WizardServiceImpl) CreateWizard(ctx context.Context, req *pb.CreateWiza
 		return nil, status.Error(codes.Internal, "Failed to check wizard count")
 	}
 
-	if count >= 2 {
-		return nil, status.Error(codes.FailedPrecondition, "Users can only create up to 2 wizards")
+	if count >= baseWizardSlots {
+		// Higher levels unlock more slots
+		bonusSlots, err := s.bonusWizardSlots(ctx, req.UserId)
+		if err != nil {
+			s.logger.Error("Failed to check wizard slots", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to check wizard count")
+		}
+		if count >= baseWizardSlots+bonusSlots {
+			return nil, status.Error(codes.FailedPrecondition,
+				fmt.Sprintf("Users can only create up to %d wizards", baseWizardSlots+bonusSlots))
+		}
 	}
 
 	var id int64
@@ -194,20 +269,48 @@ func (s *WizardServiceImpl) ListWizards(ctx context.Context, req *pb.ListWizards
 }
 
 func (s *WizardServiceImpl) DeleteWizard(ctx context.Context, req *pb.DeleteWizardRequest) (*pb.DeleteWizardResponse, error) {
-	result, err := s.db.ExecContext(ctx, "DELETE FROM wizards WHERE id = $1", req.Id)
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		s.logger.Error("Failed to delete wizard", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	var balance int64
+	err = tx.QueryRowContext(ctx,
+		"SELECT mana_balance FROM wizards WHERE id = $1 FOR UPDATE",
+		req.Id).Scan(&balance)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Wizard not found")
+		}
+		s.logger.Error("Failed to get wizard balance", "error", err)
 		return nil, status.Error(codes.Internal, "Failed to delete wizard")
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	// Close the wizard's ledger account so its remaining mana is accounted for
+	if balance != 0 {
+		_, err = ledger.Post(ctx, tx, ledger.Transfer("wizard_closed",
+			fmt.Sprintf("Wizard %d deleted", req.Id),
+			ledger.Wizard(req.Id), ledger.System(), balance))
+		if err != nil {
+			s.logger.Error("Failed to close wizard ledger account", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to delete wizard")
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM wizards WHERE id = $1", req.Id)
 	if err != nil {
-		s.logger.Error("Failed to get rows affected", "error", err)
+		s.logger.Error("Failed to delete wizard", "error", err)
 		return nil, status.Error(codes.Internal, "Failed to delete wizard")
 	}
 
-	if rowsAffected == 0 {
-		return nil, status.Error(codes.NotFound, "Wizard not found")
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to delete wizard")
 	}
 
 	return &pb.DeleteWizardResponse{Success: true}, nil
@@ -273,20 +376,87 @@ func (s *WizardServiceImpl) CreateJob(ctx context.Context, req *pb.CreateJobRequ
 		return nil, status.Error(codes.NotFound, "Realm not found")
 	}
 
+	var composition []byte
+	if req.PartyRule != nil {
+		composition, err = validatePartyRule(req.PartyRule, req.MaxWizards)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	// A job a wizard posts is paid for from their mana, held in escrow until it
+	// is worked or the posting expires
+	posted := req.CreatedByWizardId > 0
+	var expiresAt time.Time
+	if posted {
+		expiresAt, err = s.validateJobPosting(req)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	escrow := int64(postingBudget(req.ManaRewardPerHour, req.DurationMinutes)) * int64(req.MaxWizards)
+	if posted {
+		balances, err := s.lockBalances(ctx, tx, req.CreatedByWizardId)
+		if err != nil {
+			s.logger.Error("Failed to lock wizard balance", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to create job")
+		}
+		balance, ok := balances[req.CreatedByWizardId]
+		if !ok {
+			return nil, status.Error(codes.NotFound, "Wizard not found")
+		}
+		if balance < escrow {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Job needs %d mana in escrow, wizard has %d", escrow, balance))
+		}
+	}
+
 	var jobId int64
-	err = s.db.QueryRowContext(ctx,
+	err = tx.QueryRowContext(ctx,
 		`INSERT INTO jobs (realm_id, title, description, required_element, required_level, 
 		 mana_reward_per_hour, exp_reward_per_hour, duration_minutes, max_wizards, 
 		 difficulty, job_type, location, special_requirements, created_by_wizard_id) 
 		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING id`,
 		realmId, req.Title, req.Description, req.RequiredElement, req.RequiredLevel,
 		req.ManaRewardPerHour, req.ExpRewardPerHour, req.DurationMinutes, req.MaxWizards,
-		req.Difficulty, req.JobType, req.Location, req.SpecialRequirements, req.CreatedByWizardId).Scan(&jobId)
+		req.Difficulty, req.JobType, req.Location, req.SpecialRequirements, sql.NullInt64{Int64: req.CreatedByWizardId, Valid: posted}).Scan(&jobId)
 	if err != nil {
 		s.logger.Error("Failed to create job", "error", err)
 		return nil, status.Error(codes.Internal, "Failed to create job")
 	}
 
+	if req.PartyRule != nil {
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO job_party_rules (job_id, min_size, composition, reward_split) VALUES ($1, $2, $3, $4)",
+			jobId, req.PartyRule.MinSize, string(composition), partyRewardSplit(req.PartyRule))
+		if err != nil {
+			s.logger.Error("Failed to create job party rule", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to create job")
+		}
+	}
+
+	if posted {
+		if err := s.openJobPosting(ctx, tx, jobId, req, escrow, expiresAt); err != nil {
+			s.logger.Error("Failed to open job posting", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to create job")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to create job")
+	}
+
 	return s.GetJob(ctx, &pb.GetJobRequest{Id: jobId})
 }
 
@@ -295,20 +465,31 @@ func (s *WizardServiceImpl) GetJob(ctx context.Context, req *pb.GetJobRequest) (
 	var createdAt, updatedAt sql.NullTime
 	var location, specialRequirements sql.NullString
 	var createdByWizardId sql.NullInt64
+	var party partyRuleColumns
+	var posting jobPostingColumns
+	var templateId sql.NullInt64
+	var expiresAt sql.NullTime
 
 	err := s.db.QueryRowContext(ctx,
 		`SELECT j.id, j.realm_id, r.name as realm_name, j.title, j.description, 
 		 j.required_element, j.required_level, j.mana_reward_per_hour, j.exp_reward_per_hour,
 		 j.duration_minutes, j.max_wizards, j.currently_assigned, j.difficulty, j.job_type, 
-		 j.location, j.special_requirements, j.created_by_wizard_id, j.created_at, j.updated_at, j.is_active
+		 j.location, j.special_requirements, j.created_by_wizard_id, j.created_at, j.updated_at, j.is_active,
+		 pr.min_size, pr.composition, pr.reward_split,
+		 jpo.poster_wizard_id, jpo.reward_per_wizard, jpo.escrow_remaining, jpo.status, jpo.expires_at, jpo.closed_at
 		 FROM jobs j
 		 JOIN realms r ON j.realm_id = r.id
+		 LEFT JOIN job_party_rules pr ON pr.job_id = j.id
+		 LEFT JOIN job_postings jpo ON jpo.job_id = j.id
 		 WHERE j.id = $1`,
 		req.Id).Scan(
 		&job.Id, &job.RealmId, &job.RealmName, &job.Title, &job.Description,
 		&job.RequiredElement, &job.RequiredLevel, &job.ManaRewardPerHour, &job.ExpRewardPerHour,
 		&job.DurationMinutes, &job.MaxWizards, &job.CurrentlyAssigned, &job.Difficulty, &job.JobType,
-		&location, &specialRequirements, &createdByWizardId, &createdAt, &updatedAt, &job.IsActive)
+		&location, &specialRequirements, &createdByWizardId, &createdAt, &updatedAt, &job.IsActive,
+		&party.minSize, &party.composition, &party.rewardSplit,
+		&posting.posterWizardId, &posting.rewardPerWizard, &posting.escrowRemaining, &posting.status, &posting.expiresAt, &posting.closedAt,
+		&templateId, &expiresAt)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -333,6 +514,12 @@ func (s *WizardServiceImpl) GetJob(ctx context.Context, req *pb.GetJobRequest) (
 	if updatedAt.Valid {
 		job.UpdatedAt = timestamppb.New(updatedAt.Time)
 	}
+	job.PartyRule = party.rule()
+	job.Posting = posting.posting()
+	job.TemplateId = templateId.Int64
+	if expiresAt.Valid {
+		job.ExpiresAt = timestamppb.New(expiresAt.Time)
+	}
 
 	return &job, nil
 }
@@ -344,9 +531,13 @@ func (s *WizardServiceImpl) ListJobs(ctx context.Context, req *pb.ListJobsReques
 	query := `SELECT j.id, j.realm_id, r.name as realm_name, j.title, j.description, 
 	          j.required_element, j.required_level, j.mana_reward_per_hour, j.exp_reward_per_hour,
 	          j.duration_minutes, j.max_wizards, j.currently_assigned, j.difficulty, j.job_type, 
-	          j.location, j.special_requirements, j.created_by_wizard_id, j.created_at, j.updated_at, j.is_active
+	          j.location, j.special_requirements, j.created_by_wizard_id, j.created_at, j.updated_at, j.is_active,
+	          pr.min_size, pr.composition, pr.reward_split,
+	          jpo.poster_wizard_id, jpo.reward_per_wizard, jpo.escrow_remaining, jpo.status, jpo.expires_at, jpo.closed_at
 	          FROM jobs j
-	          JOIN realms r ON j.realm_id = r.id WHERE 1=1`
+	          JOIN realms r ON j.realm_id = r.id
+	          LEFT JOIN job_party_rules pr ON pr.job_id = j.id
+	          LEFT JOIN job_postings jpo ON jpo.job_id = j.id WHERE 1=1`
 	args := []interface{}{}
 	argIndex := 1
 
@@ -392,12 +583,19 @@ func (s *WizardServiceImpl) ListJobs(ctx context.Context, req *pb.ListJobsReques
 		var createdAt, updatedAt sql.NullTime
 		var location, specialRequirements sql.NullString
 		var createdByWizardId sql.NullInt64
+		var party partyRuleColumns
+		var posting jobPostingColumns
+		var templateId sql.NullInt64
+		var expiresAt sql.NullTime
 
 		if err := rows.Scan(
 			&job.Id, &job.RealmId, &job.RealmName, &job.Title, &job.Description,
 			&job.RequiredElement, &job.RequiredLevel, &job.ManaRewardPerHour, &job.ExpRewardPerHour,
 			&job.DurationMinutes, &job.MaxWizards, &job.CurrentlyAssigned, &job.Difficulty, &job.JobType,
-			&location, &specialRequirements, &createdByWizardId, &createdAt, &updatedAt, &job.IsActive); err != nil {
+			&location, &specialRequirements, &createdByWizardId, &createdAt, &updatedAt, &job.IsActive,
+			&party.minSize, &party.composition, &party.rewardSplit,
+			&posting.posterWizardId, &posting.rewardPerWizard, &posting.escrowRemaining, &posting.status, &posting.expiresAt, &posting.closedAt,
+			&templateId, &expiresAt); err != nil {
 			s.logger.Error("Failed to scan job row", "error", err)
 			return nil, status.Error(codes.Internal, "Failed to list jobs")
 		}
@@ -417,10 +615,26 @@ func (s *WizardServiceImpl) ListJobs(ctx context.Context, req *pb.ListJobsReques
 		if updatedAt.Valid {
 			job.UpdatedAt = timestamppb.New(updatedAt.Time)
 		}
+		job.PartyRule = party.rule()
+		job.Posting = posting.posting()
+		job.TemplateId = templateId.Int64
+		if expiresAt.Valid {
+			job.ExpiresAt = timestamppb.New(expiresAt.Time)
+		}
 
 		jobs = append(jobs, &job)
 	}
 
+	if req.WizardId > 0 {
+		if err := s.applyJobAffinities(ctx, req.WizardId, jobs); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, err
+			}
+			s.logger.Error("Failed to get job affinities", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to list jobs")
+		}
+	}
+
 	// Get total count for pagination
 	countQuery := "SELECT COUNT(*) FROM jobs j JOIN realms r ON j.realm_id = r.id WHERE 1=1"
 	countArgs := []interface{}{}
@@ -446,7 +660,25 @@ func (s *WizardServiceImpl) ListJobs(ctx context.Context, req *pb.ListJobsReques
 }
 
 func (s *WizardServiceImpl) UpdateJob(ctx context.Context, req *pb.UpdateJobRequest) (*pb.Job, error) {
-	_, err := s.db.ExecContext(ctx,
+	// A posted job's reward and places are what its escrow was funded for
+	var manaRewardPerHour, maxWizards int32
+	var postedJob bool
+	err := s.db.QueryRowContext(ctx,
+		`SELECT mana_reward_per_hour, max_wizards, EXISTS(SELECT 1 FROM job_postings WHERE job_id = jobs.id)
+		 FROM jobs WHERE id = $1`,
+		req.Id).Scan(&manaRewardPerHour, &maxWizards, &postedJob)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Job not found")
+		}
+		s.logger.Error("Failed to get job", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to update job")
+	}
+	if postedJob && (req.ManaRewardPerHour != manaRewardPerHour || req.MaxWizards != maxWizards) {
+		return nil, status.Error(codes.FailedPrecondition, "The reward and places of a posted job cannot be changed")
+	}
+
+	_, err = s.db.ExecContext(ctx,
 		`UPDATE jobs SET title = $1, description = $2, mana_reward_per_hour = $3, 
 		 exp_reward_per_hour = $4, max_wizards = $5, is_active = $6, updated_at = CURRENT_TIMESTAMP 
 		 WHERE id = $7`,
@@ -460,8 +692,24 @@ func (s *WizardServiceImpl) UpdateJob(ctx context.Context, req *pb.UpdateJobRequ
 	return s.GetJob(ctx, &pb.GetJobRequest{Id: req.Id})
 }
 
+// DeleteJob deletes a job. A posted job's remaining escrow goes back to its
+// poster first.
 func (s *WizardServiceImpl) DeleteJob(ctx context.Context, req *pb.DeleteJobRequest) (*pb.DeleteJobResponse, error) {
-	result, err := s.db.ExecContext(ctx, "DELETE FROM jobs WHERE id = $1", req.Id)
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	if err := s.releaseJobPosting(ctx, tx, req.Id); err != nil {
+		return nil, err
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM jobs WHERE id = $1", req.Id)
 	if err != nil {
 		s.logger.Error("Failed to delete job", "error", err)
 		return nil, status.Error(codes.Internal, "Failed to delete job")
@@ -477,6 +725,11 @@ func (s *WizardServiceImpl) DeleteJob(ctx context.Context, req *pb.DeleteJobRequ
 		return nil, status.Error(codes.NotFound, "Job not found")
 	}
 
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to delete job")
+	}
+
 	return &pb.DeleteJobResponse{Success: true}, nil
 }
 
@@ -493,92 +746,97 @@ func (s *WizardServiceImpl) AssignWizardToJob(ctx context.Context, req *pb.Assig
 		}
 	}()
 
-	// Check if job exists and has available slots
-	var maxWizards, currentlyAssigned, durationMinutes int32
-	err = tx.QueryRowContext(ctx,
-		"SELECT max_wizards, currently_assigned, duration_minutes FROM jobs WHERE id = $1 AND is_active = true",
-		req.JobId).Scan(&maxWizards, &currentlyAssigned, &durationMinutes)
+	assignmentId, endTime, err := s.startJobAssignment(ctx, tx, req.JobId, req.WizardId, false)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, status.Error(codes.NotFound, "Job not found or inactive")
-		}
-		s.logger.Error("Failed to check job availability", "error", err)
-		return nil, status.Error(codes.Internal, "Failed to assign wizard to job")
+		return nil, err
 	}
 
-	if currentlyAssigned >= maxWizards {
-		return nil, status.Error(codes.FailedPrecondition, "Job is full")
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to assign wizard to job")
 	}
 
-	// Check if wizard meets requirements (element and level)
-	var wizardElement string
-	var wizardLevel int32
-	var requiredElement string
-	var requiredLevel int32
+	s.completions.ScheduleJobCompletion(assignmentId, endTime)
 
-	err = tx.QueryRowContext(ctx,
-		"SELECT w.element, w.level FROM wizards w WHERE w.id = $1",
-		req.WizardId).Scan(&wizardElement, &wizardLevel)
+	// Return the created assignment
+	return s.getJobAssignmentByID(ctx, assignmentId)
+}
+
+// startJobAssignment assigns a wizard to a job within tx and starts their work
+// on it, returning the assignment and when it is due. A job a wizard posted
+// only takes the applicants they accept. Errors are gRPC statuses.
+func (s *WizardServiceImpl) startJobAssignment(ctx context.Context, tx *sql.Tx, jobId, wizardId int64, accepted bool) (int64, time.Time, error) {
+	// Check if job exists and has available slots
+	var maxWizards, currentlyAssigned, durationMinutes int32
+	var partyJob, postedJob bool
+	err := tx.QueryRowContext(ctx,
+		`SELECT max_wizards, currently_assigned, duration_minutes,
+		        EXISTS(SELECT 1 FROM job_party_rules WHERE job_id = jobs.id),
+		        EXISTS(SELECT 1 FROM job_postings WHERE job_id = jobs.id)
+		 FROM jobs WHERE id = $1 AND is_active = true`,
+		jobId).Scan(&maxWizards, &currentlyAssigned, &durationMinutes, &partyJob, &postedJob)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, status.Error(codes.NotFound, "Wizard not found")
+			return 0, time.Time{}, status.Error(codes.NotFound, "Job not found or inactive")
 		}
-		s.logger.Error("Failed to get wizard info", "error", err)
-		return nil, status.Error(codes.Internal, "Failed to assign wizard to job")
+		s.logger.Error("Failed to check job availability", "error", err)
+		return 0, time.Time{}, status.Error(codes.Internal, "Failed to assign wizard to job")
 	}
 
-	err = tx.QueryRowContext(ctx,
-		"SELECT required_element, required_level FROM jobs WHERE id = $1",
-		req.JobId).Scan(&requiredElement, &requiredLevel)
-	if err != nil {
-		s.logger.Error("Failed to get job requirements", "error", err)
-		return nil, status.Error(codes.Internal, "Failed to assign wizard to job")
+	if partyJob {
+		return 0, time.Time{}, status.Error(codes.FailedPrecondition, "Job only takes parties")
 	}
 
-	if wizardElement != requiredElement {
-		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Wizard element %s does not match required element %s", wizardElement, requiredElement))
+	if postedJob && !accepted {
+		return 0, time.Time{}, status.Error(codes.FailedPrecondition, "Job only takes accepted applications")
 	}
 
-	if wizardLevel < requiredLevel {
-		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Wizard level %d is below required level %d", wizardLevel, requiredLevel))
+	if currentlyAssigned >= maxWizards {
+		return 0, time.Time{}, status.Error(codes.FailedPrecondition, "Job is full")
 	}
 
+	affinity, err := s.checkJobEligibility(ctx, tx, jobId, wizardId)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	// Work starts as soon as the wizard is assigned
+	startTime := s.clock.Now()
+	endTime := startTime.Add(affinity.Duration(time.Duration(durationMinutes) * time.Minute))
+
 	// Try to create new job assignment
 	// The database constraint will prevent duplicate active assignments
 	var assignmentId int64
 	err = tx.QueryRowContext(ctx,
-		`INSERT INTO job_assignments (job_id, wizard_id, status) 
-		 VALUES ($1, $2, 'assigned') RETURNING id`,
-		req.JobId, req.WizardId).Scan(&assignmentId)
+		`INSERT INTO job_assignments (job_id, wizard_id, status, started_at, reward_factor, speed_factor) 
+		 VALUES ($1, $2, 'in_progress', $3, $4, $5) RETURNING id`,
+		jobId, wizardId, startTime, affinity.RewardFactor, affinity.SpeedFactor).Scan(&assignmentId)
 	if err != nil {
 		// Check if it's a constraint violation (wizard already assigned)
 		if strings.Contains(err.Error(), "job_assignments_active_unique") {
-			return nil, status.Error(codes.FailedPrecondition, "Wizard is already assigned to this job")
+			return 0, time.Time{}, status.Error(codes.FailedPrecondition, "Wizard is already assigned to this job")
 		}
 		s.logger.Error("Failed to create job assignment", "error", err)
-		return nil, status.Error(codes.Internal, "Failed to assign wizard to job")
+		return 0, time.Time{}, status.Error(codes.Internal, "Failed to assign wizard to job")
 	}
 
 	// Update job's currently_assigned count
 	_, err = tx.ExecContext(ctx,
 		"UPDATE jobs SET currently_assigned = currently_assigned + 1 WHERE id = $1",
-		req.JobId)
+		jobId)
 	if err != nil {
 		s.logger.Error("Failed to update job assignment count", "error", err)
-		return nil, status.Error(codes.Internal, "Failed to assign wizard to job")
+		return 0, time.Time{}, status.Error(codes.Internal, "Failed to assign wizard to job")
 	}
 
 	// Create job progress record with proper time tracking
-	startTime := time.Now()
-	endTime := startTime.Add(time.Duration(durationMinutes) * time.Minute)
-
 	_, err = tx.ExecContext(ctx,
 		`INSERT INTO job_progress (assignment_id, started_at, actual_start_time, expected_end_time, progress_percentage, time_worked_minutes, is_active, last_tick_time) 
 		 VALUES ($1, $2, $2, $3, 0, 0, true, $2)`,
 		assignmentId, startTime, endTime)
 	if err != nil {
 		s.logger.Error("Failed to create job progress record", "error", err)
-		return nil, status.Error(codes.Internal, "Failed to assign wizard to job")
+		return 0, time.Time{}, status.Error(codes.Internal, "Failed to assign wizard to job")
 	}
 
 	// Create activity log for job assignment
@@ -590,31 +848,87 @@ func (s *WizardServiceImpl) AssignWizardToJob(ctx context.Context, req *pb.Assig
 		 FROM wizards w 
 		 JOIN jobs j ON j.id = $2
 		 WHERE w.id = $3`,
-		assignmentId, req.JobId, req.WizardId)
+		assignmentId, jobId, wizardId)
 	if err != nil {
 		s.logger.Error("Failed to create activity log", "error", err)
 		// Don't fail the transaction for activity log issues
 	}
 
-	if err = tx.Commit(); err != nil {
-		s.logger.Error("Failed to commit transaction", "error", err)
-		return nil, status.Error(codes.Internal, "Failed to assign wizard to job")
+	return assignmentId, endTime, nil
+}
+
+// checkJobEligibility checks that a wizard meets a job's element, level and tier,
+// and returns the element affinity they would work it at. Errors are gRPC
+// statuses.
+func (s *WizardServiceImpl) checkJobEligibility(ctx context.Context, q queryRower, jobId, wizardId int64) (rewards.Affinity, error) {
+	// Check if wizard meets requirements (element and level)
+	var wizardElement string
+	var wizardLevel int32
+	var requiredElement, difficulty string
+	var requiredLevel int32
+
+	err := q.QueryRowContext(ctx,
+		"SELECT w.element, w.level FROM wizards w WHERE w.id = $1",
+		wizardId).Scan(&wizardElement, &wizardLevel)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return rewards.Affinity{}, status.Error(codes.NotFound, "Wizard not found")
+		}
+		s.logger.Error("Failed to get wizard info", "error", err)
+		return rewards.Affinity{}, status.Error(codes.Internal, "Failed to assign wizard to job")
 	}
 
-	// Return the created assignment
-	return s.getJobAssignmentByID(ctx, assignmentId)
+	err = q.QueryRowContext(ctx,
+		"SELECT required_element, required_level, difficulty FROM jobs WHERE id = $1",
+		jobId).Scan(&requiredElement, &requiredLevel, &difficulty)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return rewards.Affinity{}, status.Error(codes.NotFound, "Job not found")
+		}
+		s.logger.Error("Failed to get job requirements", "error", err)
+		return rewards.Affinity{}, status.Error(codes.Internal, "Failed to assign wizard to job")
+	}
+
+	// A wizard of another element can take the job if their element has an
+	// affinity with it, at the affinity's reward and speed
+	affinity, ok, err := s.elementAffinity(ctx, q, wizardElement, requiredElement)
+	if err != nil {
+		s.logger.Error("Failed to get element affinity", "error", err)
+		return rewards.Affinity{}, status.Error(codes.Internal, "Failed to assign wizard to job")
+	}
+	if !ok {
+		return rewards.Affinity{}, status.Error(codes.FailedPrecondition, fmt.Sprintf("Wizard element %s has no affinity with required element %s", wizardElement, requiredElement))
+	}
+
+	if wizardLevel < requiredLevel {
+		return rewards.Affinity{}, status.Error(codes.FailedPrecondition, fmt.Sprintf("Wizard level %d is below required level %d", wizardLevel, requiredLevel))
+	}
+
+	// Each job difficulty is a tier unlocked by level
+	tierLevel, err := s.jobTierLevel(ctx, q, difficulty)
+	if err != nil {
+		s.logger.Error("Failed to get job tier", "error", err)
+		return rewards.Affinity{}, status.Error(codes.Internal, "Failed to assign wizard to job")
+	}
+	if wizardLevel < tierLevel {
+		return rewards.Affinity{}, status.Error(codes.FailedPrecondition, fmt.Sprintf("%s jobs unlock at level %d", difficulty, tierLevel))
+	}
+
+	return affinity, nil
 }
 
 func (s *WizardServiceImpl) GetJobAssignments(ctx context.Context, req *pb.GetJobAssignmentsRequest) (*pb.GetJobAssignmentsResponse, error) {
 	offset := (req.PageNumber - 1) * req.PageSize
 
 	query := `SELECT ja.id, ja.job_id, ja.wizard_id, w.name as wizard_name, ja.assigned_at, 
-	          ja.started_at, ja.completed_at, ja.status, ja.mana_earned, ja.exp_earned, ja.notes,
+	          ja.started_at, ja.completed_at, ja.status, ja.mana_earned, ja.exp_earned, ja.notes, ja.party_id,
+	          ja.reward_factor, ja.speed_factor,
 	          j.title, j.description, j.required_element, j.required_level, j.mana_reward_per_hour,
 	          j.exp_reward_per_hour, j.duration_minutes, j.max_wizards, j.currently_assigned,
 	          j.difficulty, j.job_type, j.location, j.special_requirements, r.name as realm_name,
 	          jp.id as progress_id, jp.started_at as progress_started, jp.progress_percentage,
-	          jp.time_worked_minutes, jp.is_active as progress_active, jp.last_updated_at as progress_updated
+	          jp.time_worked_minutes, jp.is_active as progress_active, jp.last_updated_at as progress_updated,
+	          jp.actual_start_time, jp.expected_end_time, jp.paused_at, jp.paused_minutes
 	          FROM job_assignments ja
 	          JOIN wizards w ON ja.wizard_id = w.id
 	          JOIN jobs j ON ja.job_id = j.id
@@ -652,6 +966,7 @@ func (s *WizardServiceImpl) GetJobAssignments(ctx context.Context, req *pb.GetJo
 	}
 	defer rows.Close()
 
+	now := s.clock.Now()
 	var assignments []*pb.JobAssignment
 	for rows.Next() {
 		var assignment pb.JobAssignment
@@ -659,21 +974,22 @@ func (s *WizardServiceImpl) GetJobAssignments(ctx context.Context, req *pb.GetJo
 		var progress pb.JobProgress
 		var assignedAt, startedAt, completedAt sql.NullTime
 		var notes sql.NullString
-		var progressId sql.NullInt64
-		var progressStarted, progressUpdated sql.NullTime
-		var progressPercentage, timeWorked sql.NullInt32
+		var partyId, progressId sql.NullInt64
+		var progressStarted, progressUpdated, actualStartTime, expectedEndTime, pausedAt sql.NullTime
+		var progressPercentage, timeWorked, pausedMinutes sql.NullInt32
 		var progressActive sql.NullBool
 
 		if err := rows.Scan(
 			&assignment.Id, &assignment.JobId, &assignment.WizardId, &assignment.WizardName,
 			&assignedAt, &startedAt, &completedAt, &assignment.Status,
-			&assignment.ManaEarned, &assignment.ExpEarned, &notes,
+			&assignment.ManaEarned, &assignment.ExpEarned, &notes, &partyId,
+			&assignment.RewardFactor, &assignment.SpeedFactor,
 			&job.Title, &job.Description, &job.RequiredElement, &job.RequiredLevel,
 			&job.ManaRewardPerHour, &job.ExpRewardPerHour, &job.DurationMinutes,
 			&job.MaxWizards, &job.CurrentlyAssigned, &job.Difficulty, &job.JobType,
 			&job.Location, &job.SpecialRequirements, &job.RealmName,
 			&progressId, &progressStarted, &progressPercentage, &timeWorked,
-			&progressActive, &progressUpdated); err != nil {
+			&progressActive, &progressUpdated, &actualStartTime, &expectedEndTime, &pausedAt, &pausedMinutes); err != nil {
 			s.logger.Error("Failed to scan job assignment row", "error", err)
 			return nil, status.Error(codes.Internal, "Failed to get job assignments")
 		}
@@ -695,6 +1011,9 @@ func (s *WizardServiceImpl) GetJobAssignments(ctx context.Context, req *pb.GetJo
 		if notes.Valid {
 			assignment.Notes = notes.String
 		}
+		if partyId.Valid {
+			assignment.PartyId = partyId.Int64
+		}
 
 		// Set progress data if available
 		if progressId.Valid {
@@ -715,6 +1034,8 @@ func (s *WizardServiceImpl) GetJobAssignments(ctx context.Context, req *pb.GetJo
 			if progressActive.Valid {
 				progress.IsActive = progressActive.Bool
 			}
+			progress.PausedMinutes = pausedMinutes.Int32
+			applyProgress(&progress, actualStartTime, expectedEndTime, pausedAt, now)
 			assignment.Progress = &progress
 		}
 
@@ -738,18 +1059,36 @@ func (s *WizardServiceImpl) CompleteJobAssignment(ctx context.Context, req *pb.C
 		}
 	}()
 
+	// A party member's assignment is only changed with its party locked, and the
+	// party is locked first, so members finishing at once queue up on it
+	partyId, err := s.lockAssignmentParty(ctx, tx, req.AssignmentId)
+	if err != nil {
+		s.logger.Error("Failed to lock job party", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to complete job assignment")
+	}
+
 	// Get assignment and job details
-	var jobId, wizardId int64
+	var jobId, wizardId, realmId int64
 	var manaRewardPerHour, expRewardPerHour, durationMinutes int32
 	var currentExp, currentLevel int32
+	var actualStartTime, expectedEndTime sql.NullTime
+	var affinity rewards.Affinity
+	var posterId sql.NullInt64
+	var budget sql.NullInt32
 	err = tx.QueryRowContext(ctx,
-		`SELECT ja.job_id, ja.wizard_id, j.mana_reward_per_hour, j.exp_reward_per_hour, j.duration_minutes,
-		        w.experience_points, w.level
+		`SELECT ja.job_id, ja.wizard_id, j.realm_id, j.mana_reward_per_hour, j.exp_reward_per_hour, j.duration_minutes,
+		        w.experience_points, w.level, jp.actual_start_time, jp.expected_end_time, ja.reward_factor, ja.speed_factor,
+		        jpo.poster_wizard_id, jpo.reward_per_wizard
 		 FROM job_assignments ja
 		 JOIN jobs j ON ja.job_id = j.id
 		 JOIN wizards w ON ja.wizard_id = w.id
-		 WHERE ja.id = $1 AND ja.status IN ('assigned', 'in_progress')`,
-		req.AssignmentId).Scan(&jobId, &wizardId, &manaRewardPerHour, &expRewardPerHour, &durationMinutes, &currentExp, &currentLevel)
+		 LEFT JOIN job_progress jp ON ja.id = jp.assignment_id
+		 LEFT JOIN job_postings jpo ON jpo.job_id = j.id
+		 WHERE ja.id = $1 AND ja.status IN ('assigned', 'in_progress')
+		 FOR UPDATE OF ja`,
+		req.AssignmentId).Scan(&jobId, &wizardId, &realmId, &manaRewardPerHour, &expRewardPerHour, &durationMinutes,
+		&currentExp, &currentLevel, &actualStartTime, &expectedEndTime, &affinity.RewardFactor, &affinity.SpeedFactor,
+		&posterId, &budget)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, status.Error(codes.NotFound, "Assignment not found or already completed")
@@ -758,11 +1097,35 @@ func (s *WizardServiceImpl) CompleteJobAssignment(ctx context.Context, req *pb.C
 		return nil, status.Error(codes.Internal, "Failed to complete job assignment")
 	}
 
-	// Calculate total rewards - convert per-hour rates to per-minute rates
-	manaRewardPerMinute := manaRewardPerHour / 60
-	expRewardPerMinute := expRewardPerHour / 60
-	totalMana := manaRewardPerMinute * durationMinutes
-	totalExp := expRewardPerMinute * durationMinutes
+	// A party member's job completes with the rest of the party
+	if partyId.Valid {
+		return s.completeJobParty(ctx, tx, req.AssignmentId, partyId.Int64)
+	}
+
+	// A job completed before it is due pays for the work done so far. One
+	// without progress timestamps predates them and is paid in full.
+	worked, tracked := jobTimeWorked(actualStartTime, expectedEndTime, sql.NullTime{}, s.clock.Now())
+	if tracked {
+		worked = affinity.Work(worked)
+	} else {
+		worked = time.Duration(durationMinutes) * time.Minute
+	}
+	baseMana, totalExp := jobBaseRewards(manaRewardPerHour, expRewardPerHour, durationMinutes, worked)
+
+	totalMana, appliedModifiers, err := s.jobManaReward(ctx, tx, wizardId, realmId, baseMana, affinity)
+	if err != nil {
+		s.logger.Error("Failed to get reward modifiers", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to complete job assignment")
+	}
+
+	source, err := s.lockJobRewardSource(ctx, tx, jobId, wizardId, posterId)
+	if err != nil {
+		s.logger.Error("Failed to lock job poster", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to complete job assignment")
+	}
+	if posterId.Valid && totalMana > budget.Int32 {
+		totalMana = budget.Int32
+	}
 
 	// Update assignment status and rewards
 	_, err = tx.ExecContext(ctx,
@@ -774,36 +1137,17 @@ func (s *WizardServiceImpl) CompleteJobAssignment(ctx context.Context, req *pb.C
 		return nil, status.Error(codes.Internal, "Failed to complete job assignment")
 	}
 
-	// Calculate new experience and level
-	newExp := currentExp + totalExp
-	newLevel := s.calculateLevel(newExp)
-
-	// Update wizard's mana, experience, and level
-	_, err = tx.ExecContext(ctx,
-		`UPDATE wizards SET mana_balance = mana_balance + $1, experience_points = $2, level = $3
-		 WHERE id = $4`,
-		totalMana, newExp, newLevel, wizardId)
+	err = s.creditJobReward(ctx, tx, fmt.Sprintf("Job assignment %d", req.AssignmentId), source,
+		wizardId, totalMana, totalExp, currentExp, currentLevel)
 	if err != nil {
-		s.logger.Error("Failed to update wizard rewards", "error", err)
+		s.logger.Error("Failed to pay job reward", "error", err)
 		return nil, status.Error(codes.Internal, "Failed to complete job assignment")
 	}
 
-	// Log level up if it occurred
-	if newLevel > currentLevel {
-		s.logger.Info("Wizard leveled up!", "wizard_id", wizardId, "old_level", currentLevel, "new_level", newLevel)
-
-		// Create activity log for level up
-		_, err = tx.ExecContext(ctx,
-			`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata) 
-			 SELECT w.user_id, w.id, 'level_up', 
-			        'Leveled up from ' || $2::text || ' to ' || $3::text || '!',
-			        json_build_object('old_level', $2::integer, 'new_level', $3::integer, 'exp_gained', $4::integer)
-			 FROM wizards w 
-			 WHERE w.id = $1`,
-			wizardId, currentLevel, newLevel, totalExp)
-		if err != nil {
-			s.logger.Error("Failed to create level up activity log", "error", err)
-			// Don't fail the transaction for activity log issues
+	if posterId.Valid {
+		if err := s.settleJobPosting(ctx, tx, jobId, posterId.Int64, budget.Int32, totalMana); err != nil {
+			s.logger.Error("Failed to settle job posting", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to complete job assignment")
 		}
 	}
 
@@ -831,12 +1175,13 @@ func (s *WizardServiceImpl) CompleteJobAssignment(ctx context.Context, req *pb.C
 		`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata) 
 		 SELECT w.user_id, w.id, 'job_completed', 
 		        'Completed job: ' || j.title || ' - Earned ' || $2::text || ' mana and ' || $3::text || ' EXP',
-		        json_build_object('job_id', j.id, 'assignment_id', $1::bigint, 'job_title', j.title, 'mana_earned', $2::integer, 'exp_earned', $3::integer)
+		        json_build_object('job_id', j.id, 'assignment_id', $1::bigint, 'job_title', j.title, 'mana_earned', $2::integer, 'exp_earned', $3::integer,
+		                          'base_mana', $4::integer, 'modifiers', $5::jsonb)
 		 FROM job_assignments ja
 		 JOIN wizards w ON ja.wizard_id = w.id
 		 JOIN jobs j ON ja.job_id = j.id
 		 WHERE ja.id = $1::bigint`,
-		req.AssignmentId, totalMana, totalExp)
+		req.AssignmentId, totalMana, totalExp, baseMana, modifiersJSON(appliedModifiers))
 	if err != nil {
 		s.logger.Error("Failed to create activity log", "error", err)
 		// Don't fail the transaction for activity log issues
@@ -847,62 +1192,210 @@ func (s *WizardServiceImpl) CompleteJobAssignment(ctx context.Context, req *pb.C
 		return nil, status.Error(codes.Internal, "Failed to complete job assignment")
 	}
 
+	s.completions.CancelJobCompletion(req.AssignmentId)
+
 	return s.getJobAssignmentByID(ctx, req.AssignmentId)
 }
 
-// calculateLevel determines the wizard's level based on experience points
-// Using a standard RPG leveling formula: Level = floor(sqrt(experience / 100)) + 1
-// This means: Level 1 = 0-99 exp, Level 2 = 100-399 exp, Level 3 = 400-899 exp, etc.
-func (s *WizardServiceImpl) calculateLevel(experiencePoints int32) int32 {
-	if experiencePoints < 0 {
-		return 1
+// jobBaseRewards is the mana and experience a job pays for the time worked on it,
+// before reward modifiers
+func jobBaseRewards(manaRewardPerHour, expRewardPerHour, durationMinutes int32, worked time.Duration) (int32, int32) {
+	rates := rewards.JobRates{
+		ManaPerHour: int64(manaRewardPerHour),
+		ExpPerHour:  int64(expRewardPerHour),
+		Planned:     time.Duration(durationMinutes) * time.Minute,
 	}
+	mana, exp := rates.Earned(worked)
+	return int32(mana), int32(exp)
+}
 
-	// Use a gentler curve: level = floor(experience / 100) + 1
-	// This means: Level 1 = 0-99 exp, Level 2 = 100-199 exp, Level 3 = 200-299 exp, etc.
-	level := (experiencePoints / 100) + 1
-
-	// Cap at level 50 for now
-	if level > 50 {
-		return 50
+// jobTimeWorked is how long an assignment has been worked on as of now, not
+// counting time spent paused. It reports false if the assignment has no
+// progress timestamps to tell.
+func jobTimeWorked(actualStartTime, expectedEndTime, pausedAt sql.NullTime, now time.Time) (time.Duration, bool) {
+	if !actualStartTime.Valid || !expectedEndTime.Valid {
+		return 0, false
 	}
-
-	return level
+	if pausedAt.Valid {
+		now = pausedAt.Time
+	}
+	return workedAt(actualStartTime.Time, expectedEndTime.Time, now), true
 }
 
-func (s *WizardServiceImpl) CancelJobAssignment(ctx context.Context, req *pb.CancelJobAssignmentRequest) (*pb.JobAssignment, error) {
-	tx, err := s.db.BeginTx(ctx, nil)
+// jobManaReward applies the wizard's reward modifiers (realm boost) and the
+// element affinity the job was taken on to a job's base mana
+func (s *WizardServiceImpl) jobManaReward(ctx context.Context, tx *sql.Tx, wizardId, realmId int64, baseMana int32, affinity rewards.Affinity) (int32, []rewards.Applied, error) {
+	modifiers, err := s.rewardModifiers(ctx, tx, wizardId, realmId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to start transaction")
+		return 0, nil, err
 	}
-	defer func() {
-		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
-			s.logger.Error("Failed to rollback transaction", "error", err)
-		}
-	}()
+	modifiers = append(modifiers, affinity.Modifier())
+	boostedMana, appliedModifiers := rewards.NewPipeline(modifiers...).Apply(int64(baseMana))
+	return int32(boostedMana), appliedModifiers, nil
+}
 
-	// Get job_id for updating count
-	var jobId int64
-	err = tx.QueryRowContext(ctx,
-		"SELECT job_id FROM job_assignments WHERE id = $1 AND status IN ('assigned', 'in_progress')",
-		req.AssignmentId).Scan(&jobId)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, status.Error(codes.NotFound, "Assignment not found or cannot be cancelled")
+// creditJobReward pays job mana from source through the ledger and adds the
+// experience, granting the rewards of and logging any level the wizard reaches
+func (s *WizardServiceImpl) creditJobReward(ctx context.Context, tx *sql.Tx, description string, source ledger.Account, wizardId int64,
+	mana, exp, currentExp, currentLevel int32) error {
+	// Calculate new experience and level
+	newExp := currentExp + exp
+	newLevel := s.curve.Level(newExp)
+
+	// Pay the mana reward through the ledger
+	if mana > 0 {
+		_, err := ledger.Post(ctx, tx, ledger.Transfer("job_reward", description,
+			source, ledger.Wizard(wizardId), int64(mana)))
+		if err != nil {
+			return fmt.Errorf("post job reward: %w", err)
 		}
-		s.logger.Error("Failed to get assignment job_id", "error", err)
-		return nil, status.Error(codes.Internal, "Failed to cancel job assignment")
+	}
+
+	// Update wizard's experience and level
+	_, err := tx.ExecContext(ctx,
+		"UPDATE wizards SET experience_points = $1, level = $2 WHERE id = $3",
+		newExp, newLevel, wizardId)
+	if err != nil {
+		return fmt.Errorf("update wizard experience: %w", err)
+	}
+
+	// Grant the rewards and log the level up if it occurred
+	if newLevel > currentLevel {
+		s.logger.Info("Wizard leveled up!", "wizard_id", wizardId, "old_level", currentLevel, "new_level", newLevel)
+
+		granted, err := s.grantLevelRewards(ctx, tx, wizardId, currentLevel, newLevel)
+		if err != nil {
+			return fmt.Errorf("grant level rewards: %w", err)
+		}
+
+		// Create activity log for level up
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata) 
+			 SELECT w.user_id, w.id, 'level_up', 
+			        'Leveled up from ' || $2::text || ' to ' || $3::text || '!',
+			        json_build_object('old_level', $2::integer, 'new_level', $3::integer, 'exp_gained', $4::integer,
+			                          'rewards', $5::jsonb)
+			 FROM wizards w 
+			 WHERE w.id = $1`,
+			wizardId, currentLevel, newLevel, exp, levelRewardsJSON(granted))
+		if err != nil {
+			s.logger.Error("Failed to create level up activity log", "error", err)
+			// Don't fail the transaction for activity log issues
+		}
+	}
+
+	return nil
+}
+
+// CancelJobAssignment abandons a running or paused assignment. Once it has made
+// at least partialPayoutPercent progress, the wizard is paid for the time worked.
+func (s *WizardServiceImpl) CancelJobAssignment(ctx context.Context, req *pb.CancelJobAssignmentRequest) (*pb.JobAssignment, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	// A party member's assignment is only changed with its party locked, and the
+	// party is locked first, so members finishing at once queue up on it
+	partyId, err := s.lockAssignmentParty(ctx, tx, req.AssignmentId)
+	if err != nil {
+		s.logger.Error("Failed to lock job party", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to cancel job assignment")
+	}
+
+	var jobId, wizardId, realmId int64
+	var manaRewardPerHour, expRewardPerHour, durationMinutes int32
+	var currentExp, currentLevel int32
+	var progress, timeWorked sql.NullInt32
+	var progressActive sql.NullBool
+	var actualStartTime, expectedEndTime, pausedAt sql.NullTime
+	var affinity rewards.Affinity
+	var posterId sql.NullInt64
+	var budget sql.NullInt32
+	err = tx.QueryRowContext(ctx,
+		`SELECT ja.job_id, ja.wizard_id, j.realm_id, j.mana_reward_per_hour, j.exp_reward_per_hour, j.duration_minutes,
+		        w.experience_points, w.level, jp.progress_percentage, jp.time_worked_minutes, jp.is_active,
+		        jp.actual_start_time, jp.expected_end_time, jp.paused_at, ja.reward_factor, ja.speed_factor,
+		        jpo.poster_wizard_id, jpo.reward_per_wizard
+		 FROM job_assignments ja
+		 JOIN jobs j ON ja.job_id = j.id
+		 JOIN wizards w ON ja.wizard_id = w.id
+		 LEFT JOIN job_progress jp ON ja.id = jp.assignment_id
+		 LEFT JOIN job_postings jpo ON jpo.job_id = j.id
+		 WHERE ja.id = $1 AND ja.status IN ('assigned', 'in_progress', 'paused')
+		 FOR UPDATE OF ja`,
+		req.AssignmentId).Scan(&jobId, &wizardId, &realmId, &manaRewardPerHour, &expRewardPerHour, &durationMinutes,
+		&currentExp, &currentLevel, &progress, &timeWorked, &progressActive, &actualStartTime, &expectedEndTime, &pausedAt,
+		&affinity.RewardFactor, &affinity.SpeedFactor, &posterId, &budget)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Assignment not found or cannot be cancelled")
+		}
+		s.logger.Error("Failed to get assignment details", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to cancel job assignment")
+	}
+
+	// Progress as of now; a paused assignment keeps what it had when it was paused
+	current := pb.JobProgress{
+		ProgressPercentage: progress.Int32,
+		TimeWorkedMinutes:  timeWorked.Int32,
+		IsActive:           progressActive.Bool,
+	}
+	now := s.clock.Now()
+	applyProgress(&current, actualStartTime, expectedEndTime, pausedAt, now)
+
+	var partialMana, partialExp int32
+	var appliedModifiers []rewards.Applied
+	if current.ProgressPercentage >= s.partialPayoutPercent {
+		worked, _ := jobTimeWorked(actualStartTime, expectedEndTime, pausedAt, now)
+		partialMana, partialExp = jobBaseRewards(manaRewardPerHour, expRewardPerHour, durationMinutes, affinity.Work(worked))
+
+		partialMana, appliedModifiers, err = s.jobManaReward(ctx, tx, wizardId, realmId, partialMana, affinity)
+		if err != nil {
+			s.logger.Error("Failed to get reward modifiers", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to cancel job assignment")
+		}
+	}
+
+	source, err := s.lockJobRewardSource(ctx, tx, jobId, wizardId, posterId)
+	if err != nil {
+		s.logger.Error("Failed to lock job poster", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to cancel job assignment")
+	}
+	if posterId.Valid && partialMana > budget.Int32 {
+		partialMana = budget.Int32
 	}
 
 	// Update assignment status
 	_, err = tx.ExecContext(ctx,
-		"UPDATE job_assignments SET status = 'cancelled', notes = $1 WHERE id = $2",
-		req.Reason, req.AssignmentId)
+		"UPDATE job_assignments SET status = 'cancelled', notes = $1, mana_earned = $2, exp_earned = $3 WHERE id = $4",
+		req.Reason, partialMana, partialExp, req.AssignmentId)
 	if err != nil {
 		s.logger.Error("Failed to update assignment status", "error", err)
 		return nil, status.Error(codes.Internal, "Failed to cancel job assignment")
 	}
 
+	if partialMana > 0 || partialExp > 0 {
+		err = s.creditJobReward(ctx, tx, fmt.Sprintf("Job assignment %d (cancelled at %d%%)", req.AssignmentId, current.ProgressPercentage), source,
+			wizardId, partialMana, partialExp, currentExp, currentLevel)
+		if err != nil {
+			s.logger.Error("Failed to pay partial job reward", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to cancel job assignment")
+		}
+	}
+
+	if posterId.Valid {
+		if err := s.settleJobPosting(ctx, tx, jobId, posterId.Int64, budget.Int32, partialMana); err != nil {
+			s.logger.Error("Failed to settle job posting", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to cancel job assignment")
+		}
+	}
+
 	// Update job's currently_assigned count
 	_, err = tx.ExecContext(ctx,
 		"UPDATE jobs SET currently_assigned = currently_assigned - 1 WHERE id = $1",
@@ -912,27 +1405,256 @@ func (s *WizardServiceImpl) CancelJobAssignment(ctx context.Context, req *pb.Can
 		return nil, status.Error(codes.Internal, "Failed to cancel job assignment")
 	}
 
+	// Stop the progress where it was abandoned
+	_, err = tx.ExecContext(ctx,
+		`UPDATE job_progress SET progress_percentage = $1, time_worked_minutes = $2, is_active = false,
+		 paused_at = NULL, last_updated_at = CURRENT_TIMESTAMP WHERE assignment_id = $3`,
+		current.ProgressPercentage, current.TimeWorkedMinutes, req.AssignmentId)
+	if err != nil {
+		s.logger.Error("Failed to update job progress", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to cancel job assignment")
+	}
+
+	// Create activity log for job cancellation
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata) 
+		 SELECT w.user_id, w.id, 'job_cancelled', 
+		        'Abandoned job: ' || j.title || ' at ' || $2::text || '% - Earned ' || $3::text || ' mana and ' || $4::text || ' EXP',
+		        json_build_object('job_id', j.id, 'assignment_id', $1::bigint, 'job_title', j.title, 'progress', $2::integer,
+		                          'mana_earned', $3::integer, 'exp_earned', $4::integer, 'reason', $5::text, 'modifiers', $6::jsonb)
+		 FROM job_assignments ja
+		 JOIN wizards w ON ja.wizard_id = w.id
+		 JOIN jobs j ON ja.job_id = j.id
+		 WHERE ja.id = $1::bigint`,
+		req.AssignmentId, current.ProgressPercentage, partialMana, partialExp, req.Reason, modifiersJSON(appliedModifiers))
+	if err != nil {
+		s.logger.Error("Failed to create activity log", "error", err)
+		// Don't fail the transaction for activity log issues
+	}
+
+	// The rest of a party carries on without the member, and the party is
+	// cancelled once nobody is left
+	if partyId.Valid {
+		if err := s.cancelAbandonedParty(ctx, tx, partyId.Int64); err != nil {
+			s.logger.Error("Failed to update job party", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to cancel job assignment")
+		}
+	}
+
 	if err = tx.Commit(); err != nil {
 		s.logger.Error("Failed to commit transaction", "error", err)
 		return nil, status.Error(codes.Internal, "Failed to cancel job assignment")
 	}
 
+	s.completions.CancelJobCompletion(req.AssignmentId)
+
+	return s.getJobAssignmentByID(ctx, req.AssignmentId)
+}
+
+// PauseJobAssignment stops the clock on a running assignment. Its progress is
+// kept as it was, and it is not completed while paused.
+func (s *WizardServiceImpl) PauseJobAssignment(ctx context.Context, req *pb.PauseJobAssignmentRequest) (*pb.JobAssignment, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	var assignmentStatus string
+	var actualStartTime, expectedEndTime time.Time
+	var storedProgress int32
+	var partyId sql.NullInt64
+	err = tx.QueryRowContext(ctx,
+		`SELECT ja.status, jp.actual_start_time, jp.expected_end_time, jp.progress_percentage, ja.party_id
+		 FROM job_assignments ja
+		 JOIN job_progress jp ON ja.id = jp.assignment_id
+		 WHERE ja.id = $1
+		 FOR UPDATE OF ja, jp`,
+		req.AssignmentId).Scan(&assignmentStatus, &actualStartTime, &expectedEndTime, &storedProgress, &partyId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Job assignment not found")
+		}
+		s.logger.Error("Failed to get assignment progress", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to pause job assignment")
+	}
+
+	if assignmentStatus != "assigned" && assignmentStatus != "in_progress" {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Cannot pause a %s assignment", assignmentStatus))
+	}
+
+	// A party works to one clock, so its members cannot stop it on their own
+	if partyId.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "Cannot pause a party member's assignment")
+	}
+
+	now := s.clock.Now()
+	if !now.Before(expectedEndTime) {
+		return nil, status.Error(codes.FailedPrecondition, "Job is already finished")
+	}
+
+	progress, timeWorked := progressAt(actualStartTime, expectedEndTime, now)
+	if storedProgress > progress {
+		progress = storedProgress
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE job_assignments SET status = 'paused' WHERE id = $1",
+		req.AssignmentId)
+	if err != nil {
+		s.logger.Error("Failed to update assignment status", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to pause job assignment")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE job_progress SET paused_at = $1, is_active = false, progress_percentage = $2,
+		 time_worked_minutes = $3, last_updated_at = CURRENT_TIMESTAMP WHERE assignment_id = $4`,
+		now, progress, timeWorked, req.AssignmentId)
+	if err != nil {
+		s.logger.Error("Failed to update job progress", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to pause job assignment")
+	}
+
+	// Create activity log for pausing
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata) 
+		 SELECT w.user_id, w.id, 'job_paused', 
+		        'Paused job: ' || j.title || ' at ' || $2::text || '%',
+		        json_build_object('job_id', j.id, 'assignment_id', $1::bigint, 'job_title', j.title, 'progress', $2::integer)
+		 FROM job_assignments ja
+		 JOIN wizards w ON ja.wizard_id = w.id
+		 JOIN jobs j ON ja.job_id = j.id
+		 WHERE ja.id = $1::bigint`,
+		req.AssignmentId, progress)
+	if err != nil {
+		s.logger.Error("Failed to create activity log", "error", err)
+		// Don't fail the transaction for activity log issues
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to pause job assignment")
+	}
+
+	s.completions.CancelJobCompletion(req.AssignmentId)
+
+	return s.getJobAssignmentByID(ctx, req.AssignmentId)
+}
+
+// ResumeJobAssignment restarts the clock on a paused assignment. Its start and
+// expected end time both move forward by the time it spent paused, so progress
+// picks up where it stopped. Failed completion attempts from before the pause are
+// forgotten, so a completion that fails after it backs off from the start again.
+func (s *WizardServiceImpl) ResumeJobAssignment(ctx context.Context, req *pb.ResumeJobAssignmentRequest) (*pb.JobAssignment, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	var assignmentStatus string
+	var actualStartTime, expectedEndTime time.Time
+	var pausedAt sql.NullTime
+	err = tx.QueryRowContext(ctx,
+		`SELECT ja.status, jp.actual_start_time, jp.expected_end_time, jp.paused_at
+		 FROM job_assignments ja
+		 JOIN job_progress jp ON ja.id = jp.assignment_id
+		 WHERE ja.id = $1
+		 FOR UPDATE OF ja, jp`,
+		req.AssignmentId).Scan(&assignmentStatus, &actualStartTime, &expectedEndTime, &pausedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Job assignment not found")
+		}
+		s.logger.Error("Failed to get assignment progress", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to resume job assignment")
+	}
+
+	if assignmentStatus != "paused" || !pausedAt.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "Only paused assignments can be resumed")
+	}
+
+	pausedFor := s.clock.Now().Sub(pausedAt.Time)
+	if pausedFor < 0 {
+		pausedFor = 0
+	}
+	newEndTime := expectedEndTime.Add(pausedFor)
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE job_progress SET actual_start_time = $1, expected_end_time = $2, paused_at = NULL,
+		 paused_minutes = paused_minutes + $3, is_active = true, last_updated_at = CURRENT_TIMESTAMP,
+		 completion_attempts = 0, last_completion_error = NULL, next_completion_at = NULL
+		 WHERE assignment_id = $4`,
+		actualStartTime.Add(pausedFor), newEndTime, int32(pausedFor.Minutes()), req.AssignmentId)
+	if err != nil {
+		s.logger.Error("Failed to update job progress", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to resume job assignment")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE job_assignments SET status = 'in_progress' WHERE id = $1",
+		req.AssignmentId)
+	if err != nil {
+		s.logger.Error("Failed to update assignment status", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to resume job assignment")
+	}
+
+	// Create activity log for resuming
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata) 
+		 SELECT w.user_id, w.id, 'job_resumed', 
+		        'Resumed job: ' || j.title || ' after ' || $2::text || ' minutes',
+		        json_build_object('job_id', j.id, 'assignment_id', $1::bigint, 'job_title', j.title,
+		                          'paused_minutes', $2::integer, 'expected_end_time', $3::timestamptz)
+		 FROM job_assignments ja
+		 JOIN wizards w ON ja.wizard_id = w.id
+		 JOIN jobs j ON ja.job_id = j.id
+		 WHERE ja.id = $1::bigint`,
+		req.AssignmentId, int32(pausedFor.Minutes()), newEndTime)
+	if err != nil {
+		s.logger.Error("Failed to create activity log", "error", err)
+		// Don't fail the transaction for activity log issues
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to resume job assignment")
+	}
+
+	s.completions.ScheduleJobCompletion(req.AssignmentId, newEndTime)
+
 	return s.getJobAssignmentByID(ctx, req.AssignmentId)
 }
 
 // Helper function to get job assignment by ID
+// GetJobAssignment returns one assignment with its progress
+func (s *WizardServiceImpl) GetJobAssignment(ctx context.Context, req *pb.GetJobAssignmentRequest) (*pb.JobAssignment, error) {
+	return s.getJobAssignmentByID(ctx, req.AssignmentId)
+}
+
 func (s *WizardServiceImpl) getJobAssignmentByID(ctx context.Context, id int64) (*pb.JobAssignment, error) {
 	var assignment pb.JobAssignment
 	var assignedAt, startedAt, completedAt sql.NullTime
 	var notes sql.NullString
 	var progress pb.JobProgress
-	var progressStartedAt, progressLastUpdated, progressCreatedAt sql.NullTime
+	var progressStartedAt, progressLastUpdated, progressCreatedAt, actualStartTime, expectedEndTime, pausedAt sql.NullTime
+	var partyId sql.NullInt64
 
 	err := s.db.QueryRowContext(ctx,
 		`SELECT ja.id, ja.job_id, ja.wizard_id, w.name as wizard_name, ja.assigned_at, 
-		 ja.started_at, ja.completed_at, ja.status, ja.mana_earned, ja.exp_earned, ja.notes,
+		 ja.started_at, ja.completed_at, ja.status, ja.mana_earned, ja.exp_earned, ja.notes, ja.party_id,
+		 ja.reward_factor, ja.speed_factor,
 		 jp.id, jp.assignment_id, jp.started_at, jp.last_updated_at, jp.progress_percentage,
-		 jp.time_worked_minutes, jp.is_active, jp.created_at
+		 jp.time_worked_minutes, jp.is_active, jp.created_at, jp.actual_start_time, jp.expected_end_time,
+		 jp.paused_at, jp.paused_minutes
 		 FROM job_assignments ja
 		 JOIN wizards w ON ja.wizard_id = w.id
 		 LEFT JOIN job_progress jp ON ja.id = jp.assignment_id
@@ -940,9 +1662,11 @@ func (s *WizardServiceImpl) getJobAssignmentByID(ctx context.Context, id int64)
 		id).Scan(
 		&assignment.Id, &assignment.JobId, &assignment.WizardId, &assignment.WizardName,
 		&assignedAt, &startedAt, &completedAt, &assignment.Status,
-		&assignment.ManaEarned, &assignment.ExpEarned, &notes,
+		&assignment.ManaEarned, &assignment.ExpEarned, &notes, &partyId,
+		&assignment.RewardFactor, &assignment.SpeedFactor,
 		&progress.Id, &progress.AssignmentId, &progressStartedAt, &progressLastUpdated,
-		&progress.ProgressPercentage, &progress.TimeWorkedMinutes, &progress.IsActive, &progressCreatedAt)
+		&progress.ProgressPercentage, &progress.TimeWorkedMinutes, &progress.IsActive, &progressCreatedAt,
+		&actualStartTime, &expectedEndTime, &pausedAt, &progress.PausedMinutes)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -964,6 +1688,9 @@ func (s *WizardServiceImpl) getJobAssignmentByID(ctx context.Context, id int64)
 	if notes.Valid {
 		assignment.Notes = notes.String
 	}
+	if partyId.Valid {
+		assignment.PartyId = partyId.Int64
+	}
 
 	// Add progress data if available
 	if progress.Id > 0 {
@@ -976,6 +1703,7 @@ func (s *WizardServiceImpl) getJobAssignmentByID(ctx context.Context, id int64)
 		if progressCreatedAt.Valid {
 			progress.CreatedAt = timestamppb.New(progressCreatedAt.Time)
 		}
+		applyProgress(&progress, actualStartTime, expectedEndTime, pausedAt, s.clock.Now())
 		assignment.Progress = &progress
 	}
 
@@ -1086,16 +1814,17 @@ func (s *WizardServiceImpl) UpdateJobProgress(ctx context.Context, req *pb.Updat
 
 func (s *WizardServiceImpl) GetJobProgress(ctx context.Context, req *pb.GetJobProgressRequest) (*pb.JobProgress, error) {
 	var progress pb.JobProgress
-	var startedAt, lastUpdated, createdAt, actualStartTime, expectedEndTime sql.NullTime
+	var startedAt, lastUpdated, createdAt, actualStartTime, expectedEndTime, pausedAt sql.NullTime
 
 	err := s.db.QueryRowContext(ctx,
 		`SELECT jp.id, jp.assignment_id, jp.started_at, jp.last_updated_at, jp.progress_percentage,
-		 jp.time_worked_minutes, jp.is_active, jp.created_at, jp.actual_start_time, jp.expected_end_time
+		 jp.time_worked_minutes, jp.is_active, jp.created_at, jp.actual_start_time, jp.expected_end_time,
+		 jp.paused_at, jp.paused_minutes
 		 FROM job_progress jp WHERE jp.assignment_id = $1`,
 		req.AssignmentId).Scan(
 		&progress.Id, &progress.AssignmentId, &startedAt, &lastUpdated,
 		&progress.ProgressPercentage, &progress.TimeWorkedMinutes, &progress.IsActive, &createdAt,
-		&actualStartTime, &expectedEndTime)
+		&actualStartTime, &expectedEndTime, &pausedAt, &progress.PausedMinutes)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -1105,32 +1834,7 @@ func (s *WizardServiceImpl) GetJobProgress(ctx context.Context, req *pb.GetJobPr
 		return nil, status.Error(codes.Internal, "Failed to get job progress")
 	}
 
-	// Calculate real-time progress if job is active
-	if progress.IsActive && actualStartTime.Valid && expectedEndTime.Valid {
-		now := time.Now()
-		startTime := actualStartTime.Time
-		endTime := expectedEndTime.Time
-
-		if now.After(startTime) {
-			elapsed := now.Sub(startTime)
-			total := endTime.Sub(startTime)
-
-			if elapsed >= total {
-				progress.ProgressPercentage = 100
-			} else {
-				progressFloat := float64(elapsed) / float64(total) * 100
-				realTimeProgress := int32(progressFloat)
-
-				// Use the higher of stored progress or real-time progress
-				if realTimeProgress > progress.ProgressPercentage {
-					progress.ProgressPercentage = realTimeProgress
-				}
-			}
-
-			// Update time worked
-			progress.TimeWorkedMinutes = int32(elapsed.Minutes())
-		}
-	}
+	applyProgress(&progress, actualStartTime, expectedEndTime, pausedAt, s.clock.Now())
 
 	if startedAt.Valid {
 		progress.StartedAt = timestamppb.New(startedAt.Time)
@@ -1272,28 +1976,39 @@ func (s *WizardServiceImpl) GetManaBalance(ctx context.Context, req *pb.GetManaB
 }
 
 func (s *WizardServiceImpl) UpdateManaBalance(ctx context.Context, req *pb.UpdateManaBalanceRequest) (*pb.UpdateManaBalanceResponse, error) {
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to start transaction")
-	}
-	defer func() {
-		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
-			s.logger.Error("Failed to rollback transaction", "error", err)
-		}
-	}()
+	return idempotency.DoTx(ctx, s.idempotency, "wizard.UpdateManaBalance", req.IdempotencyKey, req, func(tx *sql.Tx) (*pb.UpdateManaBalanceResponse, error) {
+		return s.updateManaBalance(ctx, tx, req)
+	})
+}
 
-	// Check current balance and validate the operation
-	var currentBalance int64
-	err = tx.QueryRowContext(ctx,
-		"SELECT mana_balance FROM wizards WHERE id = $1",
-		req.WizardId).Scan(&currentBalance)
+func (s *WizardServiceImpl) updateManaBalance(ctx context.Context, tx *sql.Tx, req *pb.UpdateManaBalanceRequest) (*pb.UpdateManaBalanceResponse, error) {
+	// Lock the wizard's row so concurrent updates check and apply against the same balance
+	balances, err := s.lockBalances(ctx, tx, req.WizardId)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, status.Error(codes.NotFound, "Wizard not found")
-		}
 		s.logger.Error("Failed to get current mana balance", "error", err)
 		return nil, status.Error(codes.Internal, "Failed to update mana balance")
 	}
+	currentBalance, ok := balances[req.WizardId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Wizard not found")
+	}
+
+	// A reference that is already in the ledger was applied by an earlier attempt,
+	// so report the current balance instead of applying it twice
+	if req.Reference != "" {
+		recorded, err := ledger.Recorded(ctx, tx, req.Reference)
+		if err != nil {
+			s.logger.Error("Failed to look up ledger reference", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to update mana balance")
+		}
+		if recorded {
+			return &pb.UpdateManaBalanceResponse{
+				NewBalance: currentBalance,
+				Success:    true,
+				Replayed:   true,
+			}, nil
+		}
+	}
 
 	// Check for insufficient funds if this is a deduction
 	newBalance := currentBalance + req.Amount
@@ -1301,13 +2016,30 @@ func (s *WizardServiceImpl) UpdateManaBalance(ctx context.Context, req *pb.Updat
 		return nil, status.Error(codes.FailedPrecondition, "Insufficient mana balance")
 	}
 
-	// Update the balance
-	_, err = tx.ExecContext(ctx,
-		"UPDATE wizards SET mana_balance = $1 WHERE id = $2",
-		newBalance, req.WizardId)
-	if err != nil {
-		s.logger.Error("Failed to update mana balance", "error", err)
-		return nil, status.Error(codes.Internal, "Failed to update mana balance")
+	// Record the change in the ledger, which also updates the cached balance
+	if req.Amount != 0 || len(req.CounterPostings) > 0 {
+		entry, err := manaUpdateEntry(req)
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		posted, err := ledger.Post(ctx, tx, entry)
+		if errors.Is(err, ledger.ErrDuplicate) {
+			return nil, status.Error(codes.Aborted, "Mana update with this reference is already being applied")
+		}
+		if errors.Is(err, ledger.ErrInsufficientMana) {
+			return nil, status.Error(codes.FailedPrecondition, "Insufficient mana balance")
+		}
+		if err != nil {
+			s.logger.Error("Failed to post ledger entry", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to update mana balance")
+		}
+		if balance, ok := posted.Balances[req.WizardId]; ok {
+			newBalance = balance
+		}
 	}
 
 	// Create activity log if reason is provided
@@ -1315,20 +2047,17 @@ func (s *WizardServiceImpl) UpdateManaBalance(ctx context.Context, req *pb.Updat
 		_, err = tx.ExecContext(ctx,
 			`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata) 
 			 SELECT user_id, id, 'mana_update', $2,
-			        json_build_object('amount', $3, 'old_balance', $4, 'new_balance', $5, 'reason', $2)
+			        json_build_object('amount', $3::bigint, 'old_balance', $4::bigint, 'new_balance', $5::bigint, 'reason', $2::text,
+			                          'modifiers', $6::jsonb)
 			 FROM wizards WHERE id = $1`,
-			req.WizardId, req.Reason, req.Amount, currentBalance, newBalance)
+			req.WizardId, req.Reason, req.Amount, currentBalance, newBalance,
+			modifiersJSON(rewards.AppliedFromProto(req.Modifiers)))
 		if err != nil {
 			s.logger.Error("Failed to create activity log", "error", err)
 			// Don't fail the transaction for activity log issues
 		}
 	}
 
-	if err = tx.Commit(); err != nil {
-		s.logger.Error("Failed to commit transaction", "error", err)
-		return nil, status.Error(codes.Internal, "Failed to update mana balance")
-	}
-
 	return &pb.UpdateManaBalanceResponse{
 		NewBalance: newBalance,
 		Success:    true,
@@ -1350,32 +2079,35 @@ func (s *WizardServiceImpl) TransferMana(ctx context.Context, req *pb.TransferMa
 		}, nil
 	}
 
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to start transaction")
-	}
-	defer func() {
-		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
-			s.logger.Error("Failed to rollback transaction", "error", err)
-		}
-	}()
+	return idempotency.DoTx(ctx, s.idempotency, "wizard.TransferMana", req.IdempotencyKey, req, func(tx *sql.Tx) (*pb.TransferManaResponse, error) {
+		return s.transferMana(ctx, tx, req)
+	})
+}
 
-	// Check sender's balance
-	var senderBalance int64
-	err = tx.QueryRowContext(ctx,
-		"SELECT mana_balance FROM wizards WHERE id = $1",
-		req.FromWizardId).Scan(&senderBalance)
+func (s *WizardServiceImpl) transferMana(ctx context.Context, tx *sql.Tx, req *pb.TransferManaRequest) (*pb.TransferManaResponse, error) {
+	// Lock both wizards in id order, so opposing transfers cannot deadlock and the
+	// sender's balance cannot change between the check and the debit
+	balances, err := s.lockBalances(ctx, tx, req.FromWizardId, req.ToWizardId)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return &pb.TransferManaResponse{
-				Success: false,
-				Message: "Sender wizard not found",
-			}, nil
-		}
-		s.logger.Error("Failed to get sender balance", "error", err)
+		s.logger.Error("Failed to lock wizard balances", "error", err)
 		return nil, status.Error(codes.Internal, "Failed to transfer mana")
 	}
 
+	senderBalance, ok := balances[req.FromWizardId]
+	if !ok {
+		return &pb.TransferManaResponse{
+			Success: false,
+			Message: "Sender wizard not found",
+		}, nil
+	}
+
+	if _, ok := balances[req.ToWizardId]; !ok {
+		return &pb.TransferManaResponse{
+			Success: false,
+			Message: "Receiver wizard not found",
+		}, nil
+	}
+
 	if senderBalance < req.Amount {
 		return &pb.TransferManaResponse{
 			Success: false,
@@ -1383,50 +2115,30 @@ func (s *WizardServiceImpl) TransferMana(ctx context.Context, req *pb.TransferMa
 		}, nil
 	}
 
-	// Check receiver exists
-	var receiverBalance int64
-	err = tx.QueryRowContext(ctx,
-		"SELECT mana_balance FROM wizards WHERE id = $1",
-		req.ToWizardId).Scan(&receiverBalance)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return &pb.TransferManaResponse{
-				Success: false,
-				Message: "Receiver wizard not found",
-			}, nil
-		}
-		s.logger.Error("Failed to get receiver balance", "error", err)
-		return nil, status.Error(codes.Internal, "Failed to transfer mana")
+	reason := req.Reason
+	if reason == "" {
+		reason = "Mana transfer"
 	}
 
-	// Update balances
-	_, err = tx.ExecContext(ctx,
-		"UPDATE wizards SET mana_balance = mana_balance - $1 WHERE id = $2",
-		req.Amount, req.FromWizardId)
-	if err != nil {
-		s.logger.Error("Failed to update sender balance", "error", err)
-		return nil, status.Error(codes.Internal, "Failed to transfer mana")
+	// Update balances through the ledger
+	_, err = ledger.Post(ctx, tx, ledger.Transfer("transfer", reason,
+		ledger.Wizard(req.FromWizardId), ledger.Wizard(req.ToWizardId), req.Amount))
+	if errors.Is(err, ledger.ErrInsufficientMana) {
+		// The failed posting aborted tx, so it cannot be committed with a response
+		return nil, status.Error(codes.FailedPrecondition, "Insufficient mana balance")
 	}
-
-	_, err = tx.ExecContext(ctx,
-		"UPDATE wizards SET mana_balance = mana_balance + $1 WHERE id = $2",
-		req.Amount, req.ToWizardId)
 	if err != nil {
-		s.logger.Error("Failed to update receiver balance", "error", err)
+		s.logger.Error("Failed to post transfer", "error", err)
 		return nil, status.Error(codes.Internal, "Failed to transfer mana")
 	}
 
 	// Create activity logs for both wizards
-	reason := req.Reason
-	if reason == "" {
-		reason = "Mana transfer"
-	}
 
 	// Log for sender
 	_, err = tx.ExecContext(ctx,
 		`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata) 
 		 SELECT user_id, id, 'mana_transfer_sent', $2,
-		        json_build_object('amount', $3, 'to_wizard_id', $4, 'reason', $2)
+		        json_build_object('amount', $3::bigint, 'to_wizard_id', $4::bigint, 'reason', $2::text)
 		 FROM wizards WHERE id = $1`,
 		req.FromWizardId, fmt.Sprintf("Sent %d mana: %s", req.Amount, reason), req.Amount, req.ToWizardId)
 	if err != nil {
@@ -1437,18 +2149,13 @@ func (s *WizardServiceImpl) TransferMana(ctx context.Context, req *pb.TransferMa
 	_, err = tx.ExecContext(ctx,
 		`INSERT INTO activity_logs (user_id, wizard_id, activity_type, activity_description, metadata) 
 		 SELECT user_id, id, 'mana_transfer_received', $2,
-		        json_build_object('amount', $3, 'from_wizard_id', $4, 'reason', $2)
+		        json_build_object('amount', $3::bigint, 'from_wizard_id', $4::bigint, 'reason', $2::text)
 		 FROM wizards WHERE id = $1`,
 		req.ToWizardId, fmt.Sprintf("Received %d mana: %s", req.Amount, reason), req.Amount, req.FromWizardId)
 	if err != nil {
 		s.logger.Error("Failed to create receiver activity log", "error", err)
 	}
 
-	if err = tx.Commit(); err != nil {
-		s.logger.Error("Failed to commit transaction", "error", err)
-		return nil, status.Error(codes.Internal, "Failed to transfer mana")
-	}
-
 	return &pb.TransferManaResponse{
 		Success: true,
 		Message: "Mana transfer completed successfully",
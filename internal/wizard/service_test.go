@@ -8,10 +8,17 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/tectix/mysticfunds/internal/idempotency"
+	"github.com/tectix/mysticfunds/internal/ledger"
+	"github.com/tectix/mysticfunds/internal/ledger/ledgertest"
+	"github.com/tectix/mysticfunds/internal/progression"
 	"github.com/tectix/mysticfunds/pkg/config"
 	"github.com/tectix/mysticfunds/pkg/logger"
+	"github.com/tectix/mysticfunds/pkg/sim"
 	pb "github.com/tectix/mysticfunds/proto/wizard"
 )
 
@@ -26,13 +33,18 @@ func setupTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *WizardServiceImpl) {
 	}
 	log := logger.NewLogger("debug")
 
-	// Create service manually without auto-starting ticker
+	// Create service manually without starting the completion scheduler
 	service := &WizardServiceImpl{
-		db:     db,
-		cfg:    cfg,
-		logger: log,
-		ticker: nil, // Don't start ticker in tests
+		db:          db,
+		idempotency: idempotency.NewStore(db, log),
+		cfg:         cfg,
+		logger:      log,
+		clock:       sim.RealClock(),
+
+		partialPayoutPercent: defaultPartialPayoutPercent,
+		curve:                progression.DefaultCurve(),
 	}
+	service.completions = NewJobCompletionScheduler(db, log, service, service.clock)
 
 	return db, mock, service
 }
@@ -165,9 +177,14 @@ func TestDeleteWizard(t *testing.T) {
 	db, mock, service := setupTest(t)
 	defer db.Close()
 
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(0))
 	mock.ExpectExec("DELETE FROM wizards").
 		WithArgs(1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 
 	response, err := service.DeleteWizard(context.Background(), &pb.DeleteWizardRequest{Id: 1})
 
@@ -233,3 +250,568 @@ func TestLeaveGuild(t *testing.T) {
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestGetRewardModifiers(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM wizards WHERE id = \\$1\\)").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery("SELECT r.name, r.mana_boost_factor FROM wizards w").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_boost_factor"}).AddRow("Umbros", 1.2))
+
+	resp, err := service.GetRewardModifiers(context.Background(), &pb.GetRewardModifiersRequest{
+		WizardId:   1,
+		RewardType: "investment",
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Modifiers, 1)
+	assert.Equal(t, "Umbros", resp.Modifiers[0].Name)
+	assert.Equal(t, "realm", resp.Modifiers[0].Source)
+	assert.InDelta(t, 1.2, resp.Modifiers[0].Factor, 0.0001)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// expectAssignmentReadBack sets up the read of an assignment an RPC returns
+func expectAssignmentReadBack(mock sqlmock.Sqlmock, id int64, status string, manaEarned int32, now time.Time) {
+	mock.ExpectQuery("SELECT (.+) FROM job_assignments ja").
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "job_id", "wizard_id", "wizard_name", "assigned_at",
+			"started_at", "completed_at", "status", "mana_earned", "exp_earned", "notes", "party_id",
+			"reward_factor", "speed_factor", "jp_id", "assignment_id", "jp_started_at", "last_updated_at", "progress_percentage",
+			"time_worked_minutes", "is_active", "created_at", "actual_start_time", "expected_end_time",
+			"paused_at", "paused_minutes"}).
+			AddRow(id, 2, 1, "Merlin", now, now, now, status, manaEarned, 0, nil, nil,
+				1.0, 1.0, 9, id, now, now, 100, 60, false, now, now.Add(-time.Hour), now, nil, 0))
+}
+
+// expectNoParty sets up the party lock of an assignment that is not in a party
+func expectNoParty(mock sqlmock.Sqlmock, id int64) {
+	mock.ExpectQuery("SELECT p.id FROM job_parties p(.+)FOR UPDATE OF p").
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+}
+
+// expectCompleteDetails sets up the locked read of a job that started at jobEpoch
+func expectCompleteDetails(mock sqlmock.Sqlmock, id int64, manaPerHour, expPerHour, minutes int32) {
+	expectNoParty(mock, id)
+	mock.ExpectQuery("SELECT ja.job_id, ja.wizard_id, j.realm_id").
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"job_id", "wizard_id", "realm_id", "mana_reward_per_hour",
+			"exp_reward_per_hour", "duration_minutes", "experience_points", "level", "actual_start_time", "expected_end_time",
+			"reward_factor", "speed_factor", "poster_wizard_id", "reward_per_wizard"}).
+			AddRow(2, 1, 7, manaPerHour, expPerHour, minutes, 0, 1, jobEpoch, jobEpoch.Add(time.Duration(minutes)*time.Minute), 1.0, 1.0, nil, nil))
+}
+
+func TestCompleteJobAssignmentAppliesRealmBoost(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	now := jobEpoch.Add(time.Hour)
+	service.clock = sim.NewFakeClock(now)
+
+	mock.ExpectBegin()
+	expectCompleteDetails(mock, 5, 600, 120, 60)
+	mock.ExpectQuery("SELECT name, mana_boost_factor FROM realms WHERE id = \\$1").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_boost_factor"}).AddRow("Nyxthar", 1.25))
+	// 600 mana/hour for 60 minutes is 600 base mana, boosted by 25%
+	mock.ExpectExec("UPDATE job_assignments SET status = 'completed'").
+		WithArgs(750, 120, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	ledgertest.ExpectPost(mock, ledger.Transfer("job_reward", "", ledger.System(), ledger.Wizard(1), 750),
+		map[int64]int64{1: 750})
+	mock.ExpectExec("UPDATE wizards SET experience_points = \\$1, level = \\$2").
+		WithArgs(120, 2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// Level 2 has no rewards here
+	mock.ExpectQuery("SELECT level, reward_type, amount").
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"level", "reward_type", "amount", "job_difficulty", "description"}))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(1, 1, 2, 120, "[]").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE jobs SET currently_assigned = currently_assigned - 1").
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_progress SET progress_percentage = 100").
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(5, 750, 120, 600,
+			`[{"name":"Nyxthar","source":"realm","factor":1.25,"amount_before":600,"amount_after":750}]`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAssignmentReadBack(mock, 5, "completed", 750, now)
+
+	resp, err := service.CompleteJobAssignment(context.Background(), &pb.CompleteJobAssignmentRequest{
+		AssignmentId: 5,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(750), resp.ManaEarned)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// expectCancelDetails sets up the locked read of a one-hour, 600 mana/hour job
+// that started at jobEpoch
+func expectCancelDetails(mock sqlmock.Sqlmock, id int64) {
+	expectNoParty(mock, id)
+	mock.ExpectQuery("SELECT ja.job_id, ja.wizard_id, j.realm_id(.+)FOR UPDATE OF ja").
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"job_id", "wizard_id", "realm_id", "mana_reward_per_hour",
+			"exp_reward_per_hour", "duration_minutes", "experience_points", "level", "progress_percentage",
+			"time_worked_minutes", "is_active", "actual_start_time", "expected_end_time", "paused_at", "reward_factor", "speed_factor",
+			"poster_wizard_id", "reward_per_wizard"}).
+			AddRow(2, 1, 7, 600, 120, 60, 0, 1, 0, 0, true, jobEpoch, jobEpoch.Add(time.Hour), nil, 1.0, 1.0, nil, nil))
+}
+
+func TestCompleteJobAssignmentEarlyPaysTimeWorked(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch.Add(30 * time.Minute))
+
+	// Half of an hour-long job at 50 mana and 15 EXP an hour, which once paid
+	// nothing at all
+	mock.ExpectBegin()
+	expectCompleteDetails(mock, 5, 50, 15, 60)
+	mock.ExpectQuery("SELECT name, mana_boost_factor FROM realms WHERE id = \\$1").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows(nil))
+	mock.ExpectExec("UPDATE job_assignments SET status = 'completed'").
+		WithArgs(25, 7, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	ledgertest.ExpectPost(mock, ledger.Transfer("job_reward", "", ledger.System(), ledger.Wizard(1), 25),
+		map[int64]int64{1: 25})
+	mock.ExpectExec("UPDATE wizards SET experience_points = \\$1, level = \\$2").
+		WithArgs(7, 1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE jobs SET currently_assigned = currently_assigned - 1").
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_progress SET progress_percentage = 100").
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(5, 25, 7, 25, "[]").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAssignmentReadBack(mock, 5, "completed", 25, jobEpoch)
+
+	resp, err := service.CompleteJobAssignment(context.Background(), &pb.CompleteJobAssignmentRequest{AssignmentId: 5})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(25), resp.ManaEarned)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCancelJobAssignmentPaysPartialReward(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch.Add(45 * time.Minute))
+
+	mock.ExpectBegin()
+	expectCancelDetails(mock, 5)
+	mock.ExpectQuery("SELECT name, mana_boost_factor FROM realms WHERE id = \\$1").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "mana_boost_factor"}).AddRow("Nyxthar", 1.0))
+	// 45 minutes at 600 mana and 120 EXP an hour
+	mock.ExpectExec("UPDATE job_assignments SET status = 'cancelled'").
+		WithArgs("Needed elsewhere", 450, 90, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	ledgertest.ExpectPost(mock, ledger.Transfer("job_reward", "", ledger.System(), ledger.Wizard(1), 450),
+		map[int64]int64{1: 450})
+	mock.ExpectExec("UPDATE wizards SET experience_points = \\$1, level = \\$2").
+		WithArgs(90, 1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE jobs SET currently_assigned = currently_assigned - 1").
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_progress SET progress_percentage = \\$1").
+		WithArgs(75, 45, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(5, 75, 450, 90, "Needed elsewhere", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAssignmentReadBack(mock, 5, "cancelled", 450, jobEpoch)
+
+	resp, err := service.CancelJobAssignment(context.Background(), &pb.CancelJobAssignmentRequest{
+		AssignmentId: 5,
+		Reason:       "Needed elsewhere",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(450), resp.ManaEarned)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCancelJobAssignmentBelowThresholdPaysNothing(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch.Add(15 * time.Minute))
+
+	mock.ExpectBegin()
+	expectCancelDetails(mock, 5)
+	mock.ExpectExec("UPDATE job_assignments SET status = 'cancelled'").
+		WithArgs("", 0, 0, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE jobs SET currently_assigned = currently_assigned - 1").
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_progress SET progress_percentage = \\$1").
+		WithArgs(25, 15, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(5, 25, 0, 0, "", "[]").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAssignmentReadBack(mock, 5, "cancelled", 0, jobEpoch)
+
+	_, err := service.CancelJobAssignment(context.Background(), &pb.CancelJobAssignmentRequest{AssignmentId: 5})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPauseJobAssignment(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	pausedAt := jobEpoch.Add(20 * time.Minute)
+	service.clock = sim.NewFakeClock(pausedAt)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT ja.status, jp.actual_start_time, jp.expected_end_time, jp.progress_percentage").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "actual_start_time", "expected_end_time", "progress_percentage", "party_id"}).
+			AddRow("in_progress", jobEpoch, jobEpoch.Add(time.Hour), 0, nil))
+	mock.ExpectExec("UPDATE job_assignments SET status = 'paused'").
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_progress SET paused_at = \\$1").
+		WithArgs(pausedAt, 33, 20, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(5, 33).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAssignmentReadBack(mock, 5, "paused", 0, jobEpoch)
+
+	resp, err := service.PauseJobAssignment(context.Background(), &pb.PauseJobAssignmentRequest{AssignmentId: 5})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "paused", resp.Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPauseJobAssignmentRejectsFinishedJobs(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  string
+		now     time.Time
+		partyId interface{}
+	}{
+		{"already paused", "paused", jobEpoch.Add(10 * time.Minute), nil},
+		{"completed", "completed", jobEpoch.Add(2 * time.Hour), nil},
+		{"due", "in_progress", jobEpoch.Add(time.Hour), nil},
+		{"party member", "in_progress", jobEpoch.Add(10 * time.Minute), 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, service := setupTest(t)
+			defer db.Close()
+
+			service.clock = sim.NewFakeClock(tt.now)
+
+			mock.ExpectBegin()
+			mock.ExpectQuery("SELECT ja.status, jp.actual_start_time").
+				WithArgs(5).
+				WillReturnRows(sqlmock.NewRows([]string{"status", "actual_start_time", "expected_end_time", "progress_percentage", "party_id"}).
+					AddRow(tt.status, jobEpoch, jobEpoch.Add(time.Hour), 0, tt.partyId))
+			mock.ExpectRollback()
+
+			_, err := service.PauseJobAssignment(context.Background(), &pb.PauseJobAssignmentRequest{AssignmentId: 5})
+
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResumeJobAssignmentShiftsEndTime(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	// Paused 20 minutes in, resumed 30 minutes later
+	pausedAt := jobEpoch.Add(20 * time.Minute)
+	service.clock = sim.NewFakeClock(pausedAt.Add(30 * time.Minute))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT ja.status, jp.actual_start_time, jp.expected_end_time, jp.paused_at").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "actual_start_time", "expected_end_time", "paused_at"}).
+			AddRow("paused", jobEpoch, jobEpoch.Add(time.Hour), pausedAt))
+	mock.ExpectExec("UPDATE job_progress SET actual_start_time = \\$1, expected_end_time = \\$2(.+)completion_attempts = 0, last_completion_error = NULL, next_completion_at = NULL").
+		WithArgs(jobEpoch.Add(30*time.Minute), jobEpoch.Add(90*time.Minute), 30, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE job_assignments SET status = 'in_progress'").
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(5, 30, jobEpoch.Add(90*time.Minute)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	expectAssignmentReadBack(mock, 5, "in_progress", 0, jobEpoch)
+
+	_, err := service.ResumeJobAssignment(context.Background(), &pb.ResumeJobAssignmentRequest{AssignmentId: 5})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResumeJobAssignmentRequiresPause(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT ja.status, jp.actual_start_time, jp.expected_end_time, jp.paused_at").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "actual_start_time", "expected_end_time", "paused_at"}).
+			AddRow("in_progress", jobEpoch, jobEpoch.Add(time.Hour), nil))
+	mock.ExpectRollback()
+
+	_, err := service.ResumeJobAssignment(context.Background(), &pb.ResumeJobAssignmentRequest{AssignmentId: 5})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateManaBalanceHoldsInvestmentPrincipal(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(1000))
+	ledgertest.ExpectPost(mock, ledger.Transfer("mana_update", "", ledger.Wizard(1), ledger.Investment(1), 400),
+		map[int64]int64{1: 600})
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	resp, err := service.UpdateManaBalance(context.Background(), &pb.UpdateManaBalanceRequest{
+		WizardId: 1,
+		Amount:   -400,
+		Reason:   "Investment creation",
+		CounterPostings: []*pb.LedgerPosting{
+			{AccountType: "investment", Amount: 400},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(600), resp.NewBalance)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateManaBalanceMovesBetweenCounterAccounts(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	// A compounded rollover keeps the profit invested, so the balance is untouched
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(1000))
+	ledgertest.ExpectPost(mock, ledger.Transfer("mana_update", "", ledger.System(), ledger.Investment(1), 80),
+		map[int64]int64{})
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	resp, err := service.UpdateManaBalance(context.Background(), &pb.UpdateManaBalanceRequest{
+		WizardId: 1,
+		Reason:   "Investment rollover",
+		CounterPostings: []*pb.LedgerPosting{
+			{AccountType: "system", Amount: -80},
+			{AccountType: "investment", Amount: 80},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), resp.NewBalance)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateManaBalanceReplaysReference(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	// The debit was applied by an earlier attempt, so the balance is already lower
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(200))
+	mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM ledger_entries WHERE reference = \\$1\\)").
+		WithArgs("investment:7:principal").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectCommit()
+
+	resp, err := service.UpdateManaBalance(context.Background(), &pb.UpdateManaBalanceRequest{
+		WizardId:  1,
+		Amount:    -400,
+		Reason:    "Investment creation",
+		Reference: "investment:7:principal",
+		CounterPostings: []*pb.LedgerPosting{
+			{AccountType: "investment", Amount: 400},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.True(t, resp.Replayed)
+	assert.Equal(t, int64(200), resp.NewBalance)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTransferManaLocksInIDOrder(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	// The receiver has the lower id, so it is locked first
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(50))
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(300))
+	ledgertest.ExpectPost(mock, ledger.Transfer("transfer", "", ledger.Wizard(5), ledger.Wizard(2), 120),
+		map[int64]int64{5: 180, 2: 170})
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(5, sqlmock.AnyArg(), 120, 2).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WithArgs(2, sqlmock.AnyArg(), 120, 5).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	resp, err := service.TransferMana(context.Background(), &pb.TransferManaRequest{
+		FromWizardId: 5,
+		ToWizardId:   2,
+		Amount:       120,
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTransferManaInsufficientBalance(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(100))
+	mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1 FOR UPDATE").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(0))
+	mock.ExpectCommit()
+
+	resp, err := service.TransferMana(context.Background(), &pb.TransferManaRequest{
+		FromWizardId: 1,
+		ToWizardId:   2,
+		Amount:       101,
+	})
+
+	assert.NoError(t, err)
+	assert.False(t, resp.Success)
+	assert.Equal(t, "Insufficient mana balance", resp.Message)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTransferManaStoresResponseWithTransfer(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	// The key is claimed and its response stored in the transfer's transaction
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO idempotency_keys").
+		WithArgs("wizard.TransferMana", "transfer-1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectBalanceLock(mock, 1, 500)
+	expectBalanceLock(mock, 2, 0)
+	ledgertest.ExpectPost(mock, ledger.Transfer("transfer", "", ledger.Wizard(1), ledger.Wizard(2), 100),
+		map[int64]int64{1: 400, 2: 100})
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO activity_logs").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectExec("UPDATE idempotency_keys SET response = \\$3").
+		WithArgs("wizard.TransferMana", "transfer-1", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	resp, err := service.TransferMana(context.Background(), &pb.TransferManaRequest{
+		FromWizardId:   1,
+		ToWizardId:     2,
+		Amount:         100,
+		IdempotencyKey: "transfer-1",
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateManaBalanceRejectsInvalidCounterPostings(t *testing.T) {
+	tests := []struct {
+		name     string
+		postings []*pb.LedgerPosting
+	}{
+		{
+			name:     "unbalanced",
+			postings: []*pb.LedgerPosting{{AccountType: "system", Amount: -50}},
+		},
+		{
+			name:     "another wizard",
+			postings: []*pb.LedgerPosting{{AccountType: "wizard", WizardId: 2, Amount: -100}},
+		},
+		{
+			name:     "another wizard's escrow",
+			postings: []*pb.LedgerPosting{{AccountType: "escrow", WizardId: 2, Amount: -100}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, service := setupTest(t)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectQuery("SELECT mana_balance FROM wizards WHERE id = \\$1").
+				WithArgs(1).
+				WillReturnRows(sqlmock.NewRows([]string{"mana_balance"}).AddRow(1000))
+			mock.ExpectRollback()
+
+			_, err := service.UpdateManaBalance(context.Background(), &pb.UpdateManaBalanceRequest{
+				WizardId:        1,
+				Amount:          100,
+				CounterPostings: tt.postings,
+			})
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,489 @@
+package wizard
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tectix/mysticfunds/internal/schedule"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// jobDifficulties are the difficulties the jobs table allows
+var jobDifficulties = map[string]bool{
+	"Easy": true, "Medium": true, "Hard": true, "Expert": true, "Legendary": true,
+}
+
+const jobTemplateQuery = `SELECT t.id, t.realm_id, r.name, t.title, t.description, t.required_element, t.required_level,
+	t.mana_reward_per_hour, t.exp_reward_per_hour, t.duration_minutes, t.max_wizards, t.difficulty, t.job_type,
+	t.location, t.special_requirements, t.interval_minutes, t.cron_expr, t.posting_lifetime_minutes,
+	t.active_from, t.active_until, t.is_active, t.next_run_at, t.last_run_at, t.created_at, t.updated_at
+	FROM job_templates t
+	LEFT JOIN realms r ON r.id = t.realm_id`
+
+// scanJobTemplate reads a row selected with jobTemplateQuery
+func scanJobTemplate(row rowScanner) (*pb.JobTemplate, error) {
+	template := &pb.JobTemplate{Spec: &pb.JobTemplateSpec{}}
+	spec := template.Spec
+	var realmId, intervalMinutes sql.NullInt64
+	var realmName, location, specialRequirements, cron sql.NullString
+	var activeFrom, activeUntil, nextRunAt, lastRunAt, createdAt, updatedAt sql.NullTime
+
+	err := row.Scan(&template.Id, &realmId, &realmName, &spec.Title, &spec.Description, &spec.RequiredElement,
+		&spec.RequiredLevel, &spec.ManaRewardPerHour, &spec.ExpRewardPerHour, &spec.DurationMinutes,
+		&spec.MaxWizards, &spec.Difficulty, &spec.JobType, &location, &specialRequirements,
+		&intervalMinutes, &cron, &spec.PostingLifetimeMinutes, &activeFrom, &activeUntil,
+		&template.IsActive, &nextRunAt, &lastRunAt, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	spec.RealmId = realmId.Int64
+	template.RealmName = realmName.String
+	spec.Location = location.String
+	spec.SpecialRequirements = specialRequirements.String
+	spec.IntervalMinutes = int32(intervalMinutes.Int64)
+	spec.Cron = cron.String
+	for _, ts := range []struct {
+		value sql.NullTime
+		dest  **timestamppb.Timestamp
+	}{
+		{activeFrom, &spec.ActiveFrom},
+		{activeUntil, &spec.ActiveUntil},
+		{nextRunAt, &template.NextRunAt},
+		{lastRunAt, &template.LastRunAt},
+		{createdAt, &template.CreatedAt},
+		{updatedAt, &template.UpdatedAt},
+	} {
+		if ts.value.Valid {
+			*ts.dest = timestamppb.New(ts.value.Time)
+		}
+	}
+	return template, nil
+}
+
+// jobTemplateSchedule returns the schedule a template posts on
+func jobTemplateSchedule(spec *pb.JobTemplateSpec) (schedule.Schedule, error) {
+	if (spec.IntervalMinutes > 0) == (spec.Cron != "") {
+		return nil, fmt.Errorf("Set exactly one of interval_minutes and cron")
+	}
+	if spec.Cron != "" {
+		cron, err := schedule.ParseCron(spec.Cron)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid cron expression: %v", err)
+		}
+		return cron, nil
+	}
+	interval, err := schedule.Every(time.Duration(spec.IntervalMinutes) * time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid interval: %v", err)
+	}
+	return interval, nil
+}
+
+// validateJobTemplate checks a template spec from an admin request and returns
+// its schedule
+func validateJobTemplate(spec *pb.JobTemplateSpec) (schedule.Schedule, error) {
+	if spec == nil {
+		return nil, fmt.Errorf("Job template spec is required")
+	}
+	if strings.TrimSpace(spec.Title) == "" || len(spec.Title) > 200 {
+		return nil, fmt.Errorf("Job title must be 1-200 characters")
+	}
+	if strings.TrimSpace(spec.Description) == "" {
+		return nil, fmt.Errorf("Job description is required")
+	}
+	if strings.TrimSpace(spec.JobType) == "" {
+		return nil, fmt.Errorf("Job type is required")
+	}
+	if !jobDifficulties[spec.Difficulty] {
+		return nil, fmt.Errorf("Unknown job difficulty %q", spec.Difficulty)
+	}
+	if spec.RequiredLevel < 1 {
+		return nil, fmt.Errorf("Required level must be at least 1")
+	}
+	if spec.ManaRewardPerHour < 0 || spec.ExpRewardPerHour < 0 {
+		return nil, fmt.Errorf("Job rewards cannot be negative")
+	}
+	if spec.DurationMinutes < 1 {
+		return nil, fmt.Errorf("Job duration must be at least 1 minute")
+	}
+	if spec.MaxWizards < 1 {
+		return nil, fmt.Errorf("A job needs at least 1 wizard")
+	}
+	if spec.PostingLifetimeMinutes < 1 {
+		return nil, fmt.Errorf("Posting lifetime must be at least 1 minute")
+	}
+	if spec.ActiveFrom != nil && spec.ActiveUntil != nil && !spec.ActiveUntil.AsTime().After(spec.ActiveFrom.AsTime()) {
+		return nil, fmt.Errorf("active_until must be after active_from")
+	}
+	return jobTemplateSchedule(spec)
+}
+
+// nextJobTemplateRun returns when a template posts next: a period of its
+// schedule after its last run, or for a template yet to run on this schedule,
+// straight away on an interval and at the next matching time on a cron. It is
+// never before the template's window opens.
+func nextJobTemplateRun(sched schedule.Schedule, spec *pb.JobTemplateSpec, lastRun sql.NullTime, now time.Time) time.Time {
+	start := now
+	if spec.ActiveFrom != nil && spec.ActiveFrom.AsTime().After(now) {
+		start = spec.ActiveFrom.AsTime()
+	}
+	if lastRun.Valid {
+		if next := sched.Next(lastRun.Time); !next.Before(start) {
+			return next
+		}
+	}
+	if _, ok := sched.(schedule.Interval); ok {
+		return start
+	}
+	// Next is strictly after its argument, so a window opening on a matching
+	// minute runs then
+	return sched.Next(start.Add(-time.Nanosecond))
+}
+
+// checkJobTemplateWindow rejects activating a template whose window has closed
+func checkJobTemplateWindow(spec *pb.JobTemplateSpec, now time.Time) error {
+	if spec.ActiveUntil != nil && !spec.ActiveUntil.AsTime().After(now) {
+		return fmt.Errorf("An active template's window must end in the future")
+	}
+	return nil
+}
+
+// checkJobTemplateRealm checks that the realm a template posts in exists. 0 is
+// every realm.
+func (s *WizardServiceImpl) checkJobTemplateRealm(ctx context.Context, q queryRower, realmId int64) error {
+	if realmId == 0 {
+		return nil
+	}
+	var id int64
+	err := q.QueryRowContext(ctx, "SELECT id FROM realms WHERE id = $1", realmId).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return status.Error(codes.NotFound, "Realm not found")
+		}
+		s.logger.Error("Failed to find realm", "error", err)
+		return status.Error(codes.Internal, "Failed to find realm")
+	}
+	return nil
+}
+
+// jobTemplateArgs are a spec's columns in the order the template statements
+// take them
+func jobTemplateArgs(spec *pb.JobTemplateSpec) []interface{} {
+	var activeFrom, activeUntil sql.NullTime
+	if spec.ActiveFrom != nil {
+		activeFrom = sql.NullTime{Time: spec.ActiveFrom.AsTime(), Valid: true}
+	}
+	if spec.ActiveUntil != nil {
+		activeUntil = sql.NullTime{Time: spec.ActiveUntil.AsTime(), Valid: true}
+	}
+	return []interface{}{
+		sql.NullInt64{Int64: spec.RealmId, Valid: spec.RealmId > 0},
+		spec.Title, spec.Description, spec.RequiredElement, spec.RequiredLevel,
+		spec.ManaRewardPerHour, spec.ExpRewardPerHour, spec.DurationMinutes, spec.MaxWizards,
+		spec.Difficulty, spec.JobType, spec.Location, spec.SpecialRequirements,
+		sql.NullInt32{Int32: spec.IntervalMinutes, Valid: spec.IntervalMinutes > 0},
+		sql.NullString{String: spec.Cron, Valid: spec.Cron != ""},
+		spec.PostingLifetimeMinutes, activeFrom, activeUntil,
+	}
+}
+
+// CreateJobTemplate adds an active template, first posting straight away on an
+// interval or at the next matching time on a cron
+func (s *WizardServiceImpl) CreateJobTemplate(ctx context.Context, req *pb.CreateJobTemplateRequest) (*pb.JobTemplate, error) {
+	sched, err := validateJobTemplate(req.Spec)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	now := s.clock.Now()
+	if err := checkJobTemplateWindow(req.Spec, now); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.checkJobTemplateRealm(ctx, s.db, req.Spec.RealmId); err != nil {
+		return nil, err
+	}
+
+	var id int64
+	args := append(jobTemplateArgs(req.Spec), nextJobTemplateRun(sched, req.Spec, sql.NullTime{}, now))
+	err = s.db.QueryRowContext(ctx,
+		`INSERT INTO job_templates (realm_id, title, description, required_element, required_level,
+		 mana_reward_per_hour, exp_reward_per_hour, duration_minutes, max_wizards, difficulty, job_type,
+		 location, special_requirements, interval_minutes, cron_expr, posting_lifetime_minutes,
+		 active_from, active_until, next_run_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+		 RETURNING id`,
+		args...).Scan(&id)
+	if err != nil {
+		s.logger.Error("Failed to create job template", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to create job template")
+	}
+
+	return s.GetJobTemplate(ctx, &pb.GetJobTemplateRequest{Id: id})
+}
+
+func (s *WizardServiceImpl) GetJobTemplate(ctx context.Context, req *pb.GetJobTemplateRequest) (*pb.JobTemplate, error) {
+	template, err := scanJobTemplate(s.db.QueryRowContext(ctx, jobTemplateQuery+" WHERE t.id = $1", req.Id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Job template not found")
+		}
+		s.logger.Error("Failed to get job template", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get job template")
+	}
+	return template, nil
+}
+
+func (s *WizardServiceImpl) ListJobTemplates(ctx context.Context, req *pb.ListJobTemplatesRequest) (*pb.ListJobTemplatesResponse, error) {
+	query := jobTemplateQuery + " WHERE 1=1"
+	args := []interface{}{}
+	if req.RealmId > 0 {
+		args = append(args, req.RealmId)
+		query += fmt.Sprintf(" AND (t.realm_id = $%d OR t.realm_id IS NULL)", len(args))
+	}
+	if req.OnlyActive {
+		query += " AND t.is_active = true"
+	}
+	query += " ORDER BY t.id"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		s.logger.Error("Failed to list job templates", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list job templates")
+	}
+	defer rows.Close()
+
+	var templates []*pb.JobTemplate
+	for rows.Next() {
+		template, err := scanJobTemplate(rows)
+		if err != nil {
+			s.logger.Error("Failed to scan job template", "error", err)
+			return nil, status.Error(codes.Internal, "Failed to list job templates")
+		}
+		templates = append(templates, template)
+	}
+	if err := rows.Err(); err != nil {
+		s.logger.Error("Failed to list job templates", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to list job templates")
+	}
+
+	return &pb.ListJobTemplatesResponse{Templates: templates}, nil
+}
+
+// UpdateJobTemplate replaces a template's spec and reschedules it from its last
+// run, so editing a template does not post an extra round of jobs
+func (s *WizardServiceImpl) UpdateJobTemplate(ctx context.Context, req *pb.UpdateJobTemplateRequest) (*pb.JobTemplate, error) {
+	sched, err := validateJobTemplate(req.Spec)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	now := s.clock.Now()
+	if req.IsActive {
+		if err := checkJobTemplateWindow(req.Spec, now); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to start transaction")
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	if err := s.checkJobTemplateRealm(ctx, tx, req.Spec.RealmId); err != nil {
+		return nil, err
+	}
+
+	// Lock the template so a run in progress finishes before it is rescheduled
+	var lastRunAt sql.NullTime
+	err = tx.QueryRowContext(ctx, "SELECT last_run_at FROM job_templates WHERE id = $1 FOR UPDATE", req.Id).Scan(&lastRunAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "Job template not found")
+		}
+		s.logger.Error("Failed to lock job template", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to update job template")
+	}
+
+	args := append(jobTemplateArgs(req.Spec), nextJobTemplateRun(sched, req.Spec, lastRunAt, now), req.IsActive, req.Id)
+	_, err = tx.ExecContext(ctx,
+		`UPDATE job_templates SET realm_id = $1, title = $2, description = $3, required_element = $4,
+		 required_level = $5, mana_reward_per_hour = $6, exp_reward_per_hour = $7, duration_minutes = $8,
+		 max_wizards = $9, difficulty = $10, job_type = $11, location = $12, special_requirements = $13,
+		 interval_minutes = $14, cron_expr = $15, posting_lifetime_minutes = $16, active_from = $17,
+		 active_until = $18, next_run_at = $19, is_active = $20, failed_runs = 0, last_error = NULL,
+		 updated_at = CURRENT_TIMESTAMP
+		 WHERE id = $21`,
+		args...)
+	if err != nil {
+		s.logger.Error("Failed to update job template", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to update job template")
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("Failed to commit transaction", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to update job template")
+	}
+
+	return s.GetJobTemplate(ctx, &pb.GetJobTemplateRequest{Id: req.Id})
+}
+
+// DeleteJobTemplate stops a template posting jobs. The jobs it already posted
+// stay on the board until they expire.
+func (s *WizardServiceImpl) DeleteJobTemplate(ctx context.Context, req *pb.DeleteJobTemplateRequest) (*pb.DeleteJobTemplateResponse, error) {
+	result, err := s.db.ExecContext(ctx, "DELETE FROM job_templates WHERE id = $1", req.Id)
+	if err != nil {
+		s.logger.Error("Failed to delete job template", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to delete job template")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		s.logger.Error("Failed to get rows affected", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to delete job template")
+	}
+
+	if rowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "Job template not found")
+	}
+
+	return &pb.DeleteJobTemplateResponse{Success: true}, nil
+}
+
+// templateRealm is a realm a template posts a job in
+type templateRealm struct {
+	id      int64
+	element string
+}
+
+// jobTemplateRealms returns the realms a template posts in: its own, or every
+// realm if it has none
+func jobTemplateRealms(ctx context.Context, tx *sql.Tx, realmId int64) ([]templateRealm, error) {
+	query := "SELECT id, element FROM realms ORDER BY id"
+	args := []interface{}{}
+	if realmId > 0 {
+		query = "SELECT id, element FROM realms WHERE id = $1"
+		args = append(args, realmId)
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("load realms: %w", err)
+	}
+	defer rows.Close()
+
+	var realms []templateRealm
+	for rows.Next() {
+		var realm templateRealm
+		if err := rows.Scan(&realm.id, &realm.element); err != nil {
+			return nil, fmt.Errorf("scan realm: %w", err)
+		}
+		realms = append(realms, realm)
+	}
+	return realms, rows.Err()
+}
+
+// runJobTemplate posts a fresh job from a due template in each of its realms and
+// schedules its next run. A template past its window is deactivated instead,
+// and one no longer due, because an admin rescheduled it, is left alone.
+func (s *WizardServiceImpl) runJobTemplate(ctx context.Context, templateId int64) error {
+	now := s.clock.Now()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			s.logger.Error("Failed to rollback transaction", "error", err)
+		}
+	}()
+
+	template, err := scanJobTemplate(tx.QueryRowContext(ctx,
+		jobTemplateQuery+" WHERE t.id = $1 AND t.is_active = true AND t.next_run_at <= $2 FOR UPDATE OF t",
+		templateId, now))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("lock job template: %w", err)
+	}
+	spec := template.Spec
+
+	var activeUntil time.Time
+	if spec.ActiveUntil != nil {
+		activeUntil = spec.ActiveUntil.AsTime()
+	}
+	if !activeUntil.IsZero() && !now.Before(activeUntil) {
+		if _, err := tx.ExecContext(ctx,
+			"UPDATE job_templates SET is_active = false, updated_at = CURRENT_TIMESTAMP WHERE id = $1",
+			templateId); err != nil {
+			return fmt.Errorf("deactivate job template: %w", err)
+		}
+		return tx.Commit()
+	}
+
+	sched, err := jobTemplateSchedule(spec)
+	if err != nil {
+		return fmt.Errorf("job template schedule: %w", err)
+	}
+
+	realms, err := jobTemplateRealms(ctx, tx, spec.RealmId)
+	if err != nil {
+		return err
+	}
+
+	// A template's jobs come off the board when its window closes
+	expiresAt := now.Add(time.Duration(spec.PostingLifetimeMinutes) * time.Minute)
+	if !activeUntil.IsZero() && expiresAt.After(activeUntil) {
+		expiresAt = activeUntil
+	}
+
+	for _, realm := range realms {
+		element := spec.RequiredElement
+		if element == "" {
+			element = realm.element
+		}
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO jobs (realm_id, title, description, required_element, required_level,
+			 mana_reward_per_hour, exp_reward_per_hour, duration_minutes, max_wizards,
+			 difficulty, job_type, location, special_requirements, template_id, expires_at)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
+			realm.id, spec.Title, spec.Description, element, spec.RequiredLevel,
+			spec.ManaRewardPerHour, spec.ExpRewardPerHour, spec.DurationMinutes, spec.MaxWizards,
+			spec.Difficulty, spec.JobType, spec.Location, spec.SpecialRequirements, templateId, expiresAt)
+		if err != nil {
+			return fmt.Errorf("post job in realm %d: %w", realm.id, err)
+		}
+	}
+
+	next := schedule.NextAfter(sched, template.NextRunAt.AsTime(), now)
+	active := !next.IsZero() && (activeUntil.IsZero() || next.Before(activeUntil))
+	if next.IsZero() {
+		next = now
+	}
+	_, err = tx.ExecContext(ctx,
+		`UPDATE job_templates SET last_run_at = $1, next_run_at = $2, is_active = $3, failed_runs = 0, last_error = NULL
+		 WHERE id = $4`,
+		now, next, active, templateId)
+	if err != nil {
+		return fmt.Errorf("reschedule job template: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit job template run: %w", err)
+	}
+
+	s.logger.Info("Posted jobs from template", "template_id", templateId, "jobs", len(realms))
+	return nil
+}
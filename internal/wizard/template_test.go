@@ -0,0 +1,326 @@
+package wizard
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tectix/mysticfunds/pkg/sim"
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// patrolSpec posts an hour-long patrol in every realm each hour, kept on the
+// board for two hours
+func patrolSpec() *pb.JobTemplateSpec {
+	return &pb.JobTemplateSpec{
+		Title:                  "Patrol the borders",
+		Description:            "Walk the realm's borders and report what stirs",
+		RequiredLevel:          1,
+		ManaRewardPerHour:      300,
+		ExpRewardPerHour:       60,
+		DurationMinutes:        60,
+		MaxWizards:             2,
+		Difficulty:             "Easy",
+		JobType:                "Patrol",
+		IntervalMinutes:        60,
+		PostingLifetimeMinutes: 120,
+	}
+}
+
+// patrolTemplateRows is template 4, patrolSpec stored with the given schedule
+// and window
+func patrolTemplateRows(interval, cron, activeUntil, lastRunAt interface{}, nextRunAt time.Time) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "realm_id", "realm_name", "title", "description", "required_element",
+		"required_level", "mana_reward_per_hour", "exp_reward_per_hour", "duration_minutes", "max_wizards",
+		"difficulty", "job_type", "location", "special_requirements", "interval_minutes", "cron_expr",
+		"posting_lifetime_minutes", "active_from", "active_until", "is_active", "next_run_at", "last_run_at",
+		"created_at", "updated_at"}).
+		AddRow(4, nil, nil, "Patrol the borders", "Walk the realm's borders and report what stirs", "",
+			1, 300, 60, 60, 2,
+			"Easy", "Patrol", nil, nil, interval, cron,
+			120, nil, activeUntil, true, nextRunAt, lastRunAt,
+			jobEpoch, jobEpoch)
+}
+
+// patrolArgs are patrolSpec's columns as the template statements take them
+func patrolArgs(interval, cron, activeFrom interface{}) []driver.Value {
+	return []driver.Value{nil, "Patrol the borders", "Walk the realm's borders and report what stirs", "", 1,
+		300, 60, 60, 2, "Easy", "Patrol", "", "", interval, cron, 120, activeFrom, nil}
+}
+
+func TestCreateJobTemplateOnIntervalRunsStraightAway(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch)
+
+	mock.ExpectQuery("INSERT INTO job_templates").
+		WithArgs(append(patrolArgs(60, nil, nil), jobEpoch)...).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectQuery("SELECT t.id, t.realm_id").
+		WithArgs(4).
+		WillReturnRows(patrolTemplateRows(60, nil, nil, nil, jobEpoch))
+
+	template, err := service.CreateJobTemplate(context.Background(), &pb.CreateJobTemplateRequest{Spec: patrolSpec()})
+
+	assert.NoError(t, err)
+	assert.True(t, template.IsActive)
+	assert.Equal(t, jobEpoch, template.NextRunAt.AsTime())
+	assert.Equal(t, int32(60), template.Spec.IntervalMinutes)
+	assert.Empty(t, template.RealmName)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateJobTemplateOnCronWaitsForItsWindow(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch)
+
+	spec := patrolSpec()
+	spec.IntervalMinutes = 0
+	spec.Cron = "0 6 * * *"
+	activeFrom := jobEpoch.Add(48 * time.Hour)
+	spec.ActiveFrom = timestamppb.New(activeFrom)
+
+	// The window opens at noon, so the first 6am after it is the next day's
+	firstRun := time.Date(2025, time.March, 4, 6, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("INSERT INTO job_templates").
+		WithArgs(append(patrolArgs(nil, "0 6 * * *", activeFrom), firstRun)...).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectQuery("SELECT t.id, t.realm_id").
+		WithArgs(4).
+		WillReturnRows(patrolTemplateRows(nil, "0 6 * * *", nil, nil, firstRun))
+
+	template, err := service.CreateJobTemplate(context.Background(), &pb.CreateJobTemplateRequest{Spec: spec})
+
+	assert.NoError(t, err)
+	assert.Equal(t, firstRun, template.NextRunAt.AsTime())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateJobTemplateRejectsInvalidSpecs(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch)
+
+	tests := []struct {
+		name    string
+		modify  func(spec *pb.JobTemplateSpec)
+		message string
+	}{
+		{"both schedules", func(spec *pb.JobTemplateSpec) { spec.Cron = "@hourly" }, "Set exactly one of interval_minutes and cron"},
+		{"no schedule", func(spec *pb.JobTemplateSpec) { spec.IntervalMinutes = 0 }, "Set exactly one of interval_minutes and cron"},
+		{"bad cron", func(spec *pb.JobTemplateSpec) { spec.IntervalMinutes, spec.Cron = 0, "0 25 * * *" }, "Invalid cron expression"},
+		{"unknown difficulty", func(spec *pb.JobTemplateSpec) { spec.Difficulty = "Trivial" }, "Unknown job difficulty"},
+		{"no lifetime", func(spec *pb.JobTemplateSpec) { spec.PostingLifetimeMinutes = 0 }, "Posting lifetime must be at least 1 minute"},
+		{"closed window", func(spec *pb.JobTemplateSpec) { spec.ActiveUntil = timestamppb.New(jobEpoch) }, "window must end in the future"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := patrolSpec()
+			tt.modify(spec)
+
+			_, err := service.CreateJobTemplate(context.Background(), &pb.CreateJobTemplateRequest{Spec: spec})
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateJobTemplateReschedulesFromLastRun(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch.Add(20 * time.Minute))
+
+	// Editing an hourly template twenty minutes after it ran keeps it on the hour
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT last_run_at FROM job_templates WHERE id = \\$1 FOR UPDATE").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"last_run_at"}).AddRow(jobEpoch))
+	mock.ExpectExec("UPDATE job_templates SET realm_id = \\$1").
+		WithArgs(append(patrolArgs(60, nil, nil), jobEpoch.Add(time.Hour), true, 4)...).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT t.id, t.realm_id").
+		WithArgs(4).
+		WillReturnRows(patrolTemplateRows(60, nil, nil, jobEpoch, jobEpoch.Add(time.Hour)))
+
+	template, err := service.UpdateJobTemplate(context.Background(), &pb.UpdateJobTemplateRequest{
+		Id: 4, Spec: patrolSpec(), IsActive: true,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, jobEpoch.Add(time.Hour), template.NextRunAt.AsTime())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateJobTemplateNotFound(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT last_run_at FROM job_templates WHERE id = \\$1 FOR UPDATE").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"last_run_at"}))
+	mock.ExpectRollback()
+
+	_, err := service.UpdateJobTemplate(context.Background(), &pb.UpdateJobTemplateRequest{Id: 4, Spec: patrolSpec()})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunJobTemplatePostsInEveryRealm(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	now := jobEpoch.Add(time.Minute)
+	service.clock = sim.NewFakeClock(now)
+	activeUntil := jobEpoch.Add(90 * time.Minute)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT t.id, t.realm_id(.+)FOR UPDATE OF t").
+		WithArgs(4, now).
+		WillReturnRows(patrolTemplateRows(60, nil, activeUntil, nil, jobEpoch))
+	mock.ExpectQuery("SELECT id, element FROM realms ORDER BY id").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "element"}).AddRow(1, "Fire").AddRow(2, "Air"))
+	// Each realm's job takes the realm's element, and comes off the board when
+	// the template's window closes rather than two hours on
+	for _, realm := range []struct {
+		id      int64
+		element string
+	}{{1, "Fire"}, {2, "Air"}} {
+		mock.ExpectExec("INSERT INTO jobs").
+			WithArgs(realm.id, "Patrol the borders", "Walk the realm's borders and report what stirs", realm.element, 1,
+				300, 60, 60, 2, "Easy", "Patrol", "", "", 4, activeUntil).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectExec("UPDATE job_templates SET last_run_at = \\$1, next_run_at = \\$2, is_active = \\$3").
+		WithArgs(now, jobEpoch.Add(time.Hour), true, 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := service.runJobTemplate(context.Background(), 4)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunJobTemplateDeactivatesPastItsWindow(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	now := jobEpoch.Add(2 * time.Hour)
+	service.clock = sim.NewFakeClock(now)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT t.id, t.realm_id(.+)FOR UPDATE OF t").
+		WithArgs(4, now).
+		WillReturnRows(patrolTemplateRows(60, nil, jobEpoch.Add(90*time.Minute), nil, jobEpoch))
+	mock.ExpectExec("UPDATE job_templates SET is_active = false").
+		WithArgs(4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := service.runJobTemplate(context.Background(), 4)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunJobTemplateSkipsTemplateNoLongerDue(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	service.clock = sim.NewFakeClock(jobEpoch)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT t.id, t.realm_id(.+)FOR UPDATE OF t").
+		WithArgs(4, jobEpoch).
+		WillReturnRows(sqlmock.NewRows(nil))
+	mock.ExpectRollback()
+
+	err := service.runJobTemplate(context.Background(), 4)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestJobBoardRotatorExpiresStaleJobsInBatches(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	clock := sim.NewFakeClock(jobEpoch)
+	rotator := NewJobBoardRotator(db, service.logger, service, clock)
+	rotator.batchSize = 2
+
+	mock.ExpectExec("UPDATE jobs SET is_active = false(.+)expires_at <= \\$1").
+		WithArgs(jobEpoch, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("UPDATE jobs SET is_active = false(.+)expires_at <= \\$1").
+		WithArgs(jobEpoch, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := rotator.expireStaleJobs(context.Background())
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestJobBoardRotatorBacksOffFailingTemplates(t *testing.T) {
+	db, mock, service := setupTest(t)
+	defer db.Close()
+
+	clock := sim.NewFakeClock(jobEpoch)
+	service.clock = clock
+	rotator := NewJobBoardRotator(db, service.logger, service, clock)
+
+	mock.ExpectQuery("SELECT id, failed_runs FROM job_templates").
+		WithArgs(jobEpoch, rotator.batchSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "failed_runs"}).AddRow(4, 1).AddRow(5, 4).AddRow(6, 0))
+
+	// Template 4 fails for the second time and waits a minute
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT t.id, t.realm_id(.+)FOR UPDATE OF t").
+		WithArgs(4, jobEpoch).
+		WillReturnError(assert.AnError)
+	mock.ExpectRollback()
+	mock.ExpectExec("UPDATE job_templates SET failed_runs = \\$1, last_error = \\$2, next_run_at = \\$3").
+		WithArgs(2, sqlmock.AnyArg(), jobEpoch.Add(time.Minute), 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Template 5 has failed too often and comes off the schedule
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT t.id, t.realm_id(.+)FOR UPDATE OF t").
+		WithArgs(5, jobEpoch).
+		WillReturnError(assert.AnError)
+	mock.ExpectRollback()
+	mock.ExpectExec("UPDATE job_templates SET is_active = false, failed_runs = \\$1").
+		WithArgs(5, sqlmock.AnyArg(), 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// The template behind them still runs
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT t.id, t.realm_id(.+)FOR UPDATE OF t").
+		WithArgs(6, jobEpoch).
+		WillReturnRows(sqlmock.NewRows(nil))
+	mock.ExpectRollback()
+
+	err := rotator.processDue(context.Background())
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
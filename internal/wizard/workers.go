@@ -0,0 +1,41 @@
+package wizard
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/tectix/mysticfunds/proto/wizard"
+)
+
+// workersElection is the election for the wizard service's background workers
+const workersElection = "wizard-workers"
+
+// GetLeaderStatus reports which replica runs the background workers
+func (s *WizardServiceImpl) GetLeaderStatus(ctx context.Context, req *pb.GetLeaderStatusRequest) (*pb.LeaderStatus, error) {
+	st, err := s.leader.Status(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get leader status", "error", err)
+		return nil, status.Error(codes.Internal, "Failed to get leader status")
+	}
+
+	resp := &pb.LeaderStatus{
+		Election:         st.Election,
+		InstanceId:       st.Instance,
+		IsLeader:         st.Leading,
+		LeaderInstanceId: st.Leader,
+	}
+	if st.Leader != "" {
+		resp.ElectedAt = timestamppb.New(st.ElectedAt)
+		resp.HeartbeatAt = timestamppb.New(st.HeartbeatAt)
+	}
+	return resp, nil
+}
+
+// StopWorkers stops campaigning and, if this replica is leading, hands the
+// background workers over to another replica straight away
+func (s *WizardServiceImpl) StopWorkers() {
+	s.leader.Stop()
+}
@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS update_investment_sagas_updated_at ON investment_sagas;
+DROP TABLE IF EXISTS investment_saga_steps;
+DROP TABLE IF EXISTS investment_sagas;
+
+UPDATE wizard_investments SET status = 'failed' WHERE status IN ('pending', 'settling');
+ALTER TABLE wizard_investments DROP CONSTRAINT IF EXISTS wizard_investments_status_check;
+ALTER TABLE wizard_investments ADD CONSTRAINT wizard_investments_status_check
+CHECK (status IN ('active', 'completed', 'failed'));
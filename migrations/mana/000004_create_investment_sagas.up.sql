@@ -0,0 +1,44 @@
+-- Investment sagas: journal of the cross-service steps that move mana for an investment
+
+-- Investments are pending until the principal is debited and settling until the
+-- return has been credited
+ALTER TABLE wizard_investments DROP CONSTRAINT IF EXISTS wizard_investments_status_check;
+ALTER TABLE wizard_investments ADD CONSTRAINT wizard_investments_status_check
+CHECK (status IN ('pending', 'active', 'settling', 'completed', 'failed'));
+
+-- One saga per investment and direction. request is the wizard service call that
+-- moves the mana and compensation is the call that undoes it; both carry a ledger
+-- reference so they can be retried safely.
+CREATE TABLE IF NOT EXISTS investment_sagas (
+    id SERIAL PRIMARY KEY,
+    investment_id INTEGER NOT NULL REFERENCES wizard_investments(id),
+    saga_type VARCHAR(30) NOT NULL CHECK (saga_type IN ('create_investment', 'settle_investment')),
+    state VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (state IN ('pending', 'compensating', 'completed', 'compensated', 'failed')),
+    request JSONB NOT NULL,
+    compensation JSONB,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (investment_id, saga_type)
+);
+
+-- Append-only record of every step outcome
+CREATE TABLE IF NOT EXISTS investment_saga_steps (
+    id SERIAL PRIMARY KEY,
+    saga_id INTEGER NOT NULL REFERENCES investment_sagas(id),
+    step VARCHAR(30) NOT NULL,
+    succeeded BOOLEAN NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 1,
+    error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_investment_sagas_state ON investment_sagas(state, updated_at);
+CREATE INDEX IF NOT EXISTS idx_investment_saga_steps_saga_id ON investment_saga_steps(saga_id);
+
+CREATE TRIGGER update_investment_sagas_updated_at
+    BEFORE UPDATE ON investment_sagas
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_wizard_investments_due;
+
+ALTER TABLE wizard_investments
+    DROP COLUMN IF EXISTS failure_reason,
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS attempts;
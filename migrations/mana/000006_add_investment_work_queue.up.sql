@@ -0,0 +1,12 @@
+-- wizard_investments doubles as the settlement work queue. Workers claim due rows
+-- with FOR UPDATE SKIP LOCKED, and a failed attempt pushes next_attempt_at back
+-- until the investment runs out of attempts and is marked failed.
+ALTER TABLE wizard_investments
+    ADD COLUMN IF NOT EXISTS attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS last_error TEXT,
+    ADD COLUMN IF NOT EXISTS failure_reason TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_wizard_investments_due
+    ON wizard_investments(end_time)
+    WHERE status = 'active';
@@ -0,0 +1,16 @@
+DELETE FROM investment_saga_steps WHERE saga_id IN (
+    SELECT id FROM investment_sagas WHERE saga_type = 'withdraw_investment'
+);
+DELETE FROM investment_sagas WHERE saga_type = 'withdraw_investment';
+ALTER TABLE investment_sagas DROP CONSTRAINT IF EXISTS investment_sagas_saga_type_check;
+ALTER TABLE investment_sagas ADD CONSTRAINT investment_sagas_saga_type_check
+CHECK (saga_type IN ('create_investment', 'settle_investment'));
+
+UPDATE wizard_investments SET status = 'completed' WHERE status = 'withdrawn';
+UPDATE wizard_investments SET status = 'failed' WHERE status = 'withdrawing';
+ALTER TABLE wizard_investments DROP CONSTRAINT IF EXISTS wizard_investments_status_check;
+ALTER TABLE wizard_investments ADD CONSTRAINT wizard_investments_status_check
+CHECK (status IN ('pending', 'active', 'settling', 'completed', 'failed'));
+
+ALTER TABLE wizard_investments DROP COLUMN IF EXISTS penalty_amount;
+ALTER TABLE investment_types DROP COLUMN IF EXISTS early_exit_penalties;
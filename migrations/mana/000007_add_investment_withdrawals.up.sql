@@ -0,0 +1,38 @@
+-- Early withdrawal of investments. Each investment type has a penalty schedule: a
+-- list of {"after_percent", "penalty_percent"} tiers, where the tier with the highest
+-- after_percent not above the share of the term already served applies. An empty
+-- schedule means withdrawals are free.
+ALTER TABLE investment_types
+    ADD COLUMN IF NOT EXISTS early_exit_penalties JSONB NOT NULL DEFAULT '[]';
+
+UPDATE investment_types SET early_exit_penalties =
+    '[{"after_percent": 0, "penalty_percent": 5}, {"after_percent": 50, "penalty_percent": 2}]'
+WHERE name = 'Novice Spell Bond';
+
+UPDATE investment_types SET early_exit_penalties =
+    '[{"after_percent": 0, "penalty_percent": 10}, {"after_percent": 50, "penalty_percent": 5}, {"after_percent": 90, "penalty_percent": 2}]'
+WHERE name = 'Mystic Market Fund';
+
+UPDATE investment_types SET early_exit_penalties =
+    '[{"after_percent": 0, "penalty_percent": 15}, {"after_percent": 50, "penalty_percent": 8}, {"after_percent": 90, "penalty_percent": 3}]'
+WHERE name = 'Elemental Ventures';
+
+UPDATE investment_types SET early_exit_penalties =
+    '[{"after_percent": 0, "penalty_percent": 20}, {"after_percent": 50, "penalty_percent": 10}, {"after_percent": 90, "penalty_percent": 5}]'
+WHERE name = 'Dragon''s Hoard';
+
+UPDATE investment_types SET early_exit_penalties =
+    '[{"after_percent": 0, "penalty_percent": 25}, {"after_percent": 50, "penalty_percent": 15}, {"after_percent": 90, "penalty_percent": 8}]'
+WHERE name = 'Phoenix Rising';
+
+-- Withdrawn investments pay out their principal less the penalty
+ALTER TABLE wizard_investments
+    ADD COLUMN IF NOT EXISTS penalty_amount BIGINT;
+
+ALTER TABLE wizard_investments DROP CONSTRAINT IF EXISTS wizard_investments_status_check;
+ALTER TABLE wizard_investments ADD CONSTRAINT wizard_investments_status_check
+CHECK (status IN ('pending', 'active', 'settling', 'withdrawing', 'withdrawn', 'completed', 'failed'));
+
+ALTER TABLE investment_sagas DROP CONSTRAINT IF EXISTS investment_sagas_saga_type_check;
+ALTER TABLE investment_sagas ADD CONSTRAINT investment_sagas_saga_type_check
+CHECK (saga_type IN ('create_investment', 'settle_investment', 'withdraw_investment'));
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS investment_type_audit_log;
+
+DROP INDEX IF EXISTS idx_wizard_investments_type_version;
+ALTER TABLE wizard_investments DROP COLUMN IF EXISTS investment_type_version_id;
+ALTER TABLE investment_types DROP COLUMN IF EXISTS current_version;
+
+DROP TABLE IF EXISTS investment_type_versions;
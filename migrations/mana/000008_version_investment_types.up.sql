@@ -0,0 +1,58 @@
+-- Versioned investment type terms. Every change to a type's terms adds a version,
+-- and each investment points at the version it was opened under, so later edits
+-- never change the terms of existing investments. investment_types keeps a copy of
+-- the current terms for listing.
+CREATE TABLE IF NOT EXISTS investment_type_versions (
+    id SERIAL PRIMARY KEY,
+    investment_type_id INTEGER NOT NULL REFERENCES investment_types(id),
+    version INTEGER NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    min_amount BIGINT NOT NULL CHECK (min_amount > 0),
+    max_amount BIGINT,
+    duration_hours INTEGER NOT NULL CHECK (duration_hours > 0),
+    base_return_rate DECIMAL(5,2) NOT NULL,
+    risk_level INTEGER NOT NULL CHECK (risk_level BETWEEN 1 AND 5),
+    early_exit_penalties JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (investment_type_id, version),
+    CHECK (max_amount IS NULL OR max_amount >= min_amount)
+);
+
+INSERT INTO investment_type_versions
+    (investment_type_id, version, name, description, min_amount, max_amount,
+     duration_hours, base_return_rate, risk_level, early_exit_penalties)
+SELECT id, 1, name, description, min_amount, max_amount,
+       duration_hours, base_return_rate, risk_level, early_exit_penalties
+FROM investment_types;
+
+ALTER TABLE investment_types
+    ADD COLUMN IF NOT EXISTS current_version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE wizard_investments
+    ADD COLUMN IF NOT EXISTS investment_type_version_id INTEGER REFERENCES investment_type_versions(id);
+
+UPDATE wizard_investments i
+SET investment_type_version_id = v.id
+FROM investment_type_versions v
+WHERE v.investment_type_id = i.investment_type_id AND v.version = 1;
+
+ALTER TABLE wizard_investments ALTER COLUMN investment_type_version_id SET NOT NULL;
+
+-- Append-only record of who changed an investment type, how and why
+CREATE TABLE IF NOT EXISTS investment_type_audit_log (
+    id SERIAL PRIMARY KEY,
+    investment_type_id INTEGER NOT NULL REFERENCES investment_types(id),
+    version INTEGER NOT NULL,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('created', 'updated', 'activated', 'deactivated')),
+    changed_by VARCHAR(100) NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO investment_type_audit_log (investment_type_id, version, action, changed_by, reason)
+SELECT id, 1, 'created', 'migration', 'Seeded investment type'
+FROM investment_types;
+
+CREATE INDEX IF NOT EXISTS idx_investment_type_audit_log_type ON investment_type_audit_log(investment_type_id);
+CREATE INDEX IF NOT EXISTS idx_wizard_investments_type_version ON wizard_investments(investment_type_version_id);
@@ -0,0 +1,19 @@
+DELETE FROM investment_saga_steps WHERE saga_id IN (
+    SELECT id FROM investment_sagas WHERE saga_type = 'rollover_investment'
+);
+DELETE FROM investment_sagas WHERE saga_type = 'rollover_investment';
+ALTER TABLE investment_sagas DROP CONSTRAINT IF EXISTS investment_sagas_saga_type_check;
+ALTER TABLE investment_sagas ADD CONSTRAINT investment_sagas_saga_type_check
+CHECK (saga_type IN ('create_investment', 'settle_investment', 'withdraw_investment'));
+
+UPDATE wizard_investments SET status = 'completed' WHERE status = 'rolled_over';
+ALTER TABLE wizard_investments DROP CONSTRAINT IF EXISTS wizard_investments_status_check;
+ALTER TABLE wizard_investments ADD CONSTRAINT wizard_investments_status_check
+CHECK (status IN ('pending', 'active', 'settling', 'withdrawing', 'withdrawn', 'completed', 'failed'));
+
+DROP INDEX IF EXISTS idx_wizard_investments_rolled_over_from;
+ALTER TABLE wizard_investments
+    DROP COLUMN IF EXISTS rolled_over_from,
+    DROP COLUMN IF EXISTS rollovers_remaining,
+    DROP COLUMN IF EXISTS rollover_mode,
+    DROP COLUMN IF EXISTS auto_rollover;
@@ -0,0 +1,20 @@
+-- Auto-rollover. When an investment with auto_rollover matures, a new investment of
+-- the same type is opened with its principal plus return ('compound') or only its
+-- principal ('principal'), and the rest is credited to the wizard. Each rollover
+-- uses up one of rollovers_remaining; the chain is linked by rolled_over_from.
+ALTER TABLE wizard_investments
+    ADD COLUMN IF NOT EXISTS auto_rollover BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS rollover_mode VARCHAR(20) NOT NULL DEFAULT 'compound'
+        CHECK (rollover_mode IN ('compound', 'principal')),
+    ADD COLUMN IF NOT EXISTS rollovers_remaining INTEGER NOT NULL DEFAULT 0 CHECK (rollovers_remaining >= 0),
+    ADD COLUMN IF NOT EXISTS rolled_over_from INTEGER REFERENCES wizard_investments(id);
+
+CREATE INDEX IF NOT EXISTS idx_wizard_investments_rolled_over_from ON wizard_investments(rolled_over_from);
+
+ALTER TABLE wizard_investments DROP CONSTRAINT IF EXISTS wizard_investments_status_check;
+ALTER TABLE wizard_investments ADD CONSTRAINT wizard_investments_status_check
+CHECK (status IN ('pending', 'active', 'settling', 'withdrawing', 'withdrawn', 'completed', 'rolled_over', 'failed'));
+
+ALTER TABLE investment_sagas DROP CONSTRAINT IF EXISTS investment_sagas_saga_type_check;
+ALTER TABLE investment_sagas ADD CONSTRAINT investment_sagas_saga_type_check
+CHECK (saga_type IN ('create_investment', 'settle_investment', 'withdraw_investment', 'rollover_investment'));
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS market_prices;
+DROP TABLE IF EXISTS investment_type_markets;
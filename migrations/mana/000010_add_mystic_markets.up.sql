@@ -0,0 +1,32 @@
+-- Mystic markets. Each investment type has a simulated market whose price moves in
+-- a seeded random walk, one step per tick, under a regime (calm, bull or bear) that
+-- changes now and then. An investment returns the market's move between the ticks
+-- it started and matured in, so investments of a type that mature together share
+-- one return. Step n of a market is drawn from its seed and n alone, so the walk
+-- is the same whichever replica advances it.
+CREATE TABLE IF NOT EXISTS investment_type_markets (
+    investment_type_id INTEGER PRIMARY KEY REFERENCES investment_types(id),
+    price DOUBLE PRECISION NOT NULL CHECK (price > 0),
+    regime VARCHAR(10) NOT NULL DEFAULT 'calm' CHECK (regime IN ('calm', 'bull', 'bear')),
+    seed BIGINT NOT NULL,
+    steps BIGINT NOT NULL DEFAULT 0,
+    last_tick_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS market_prices (
+    id BIGSERIAL PRIMARY KEY,
+    investment_type_id INTEGER NOT NULL REFERENCES investment_types(id),
+    tick_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    price DOUBLE PRECISION NOT NULL CHECK (price > 0),
+    regime VARCHAR(10) NOT NULL,
+    UNIQUE (investment_type_id, tick_at)
+);
+
+-- Existing types open at 100 on the current hour
+INSERT INTO investment_type_markets (investment_type_id, price, regime, seed, last_tick_at)
+SELECT id, 100, 'calm', id, date_trunc('hour', NOW())
+FROM investment_types;
+
+INSERT INTO market_prices (investment_type_id, tick_at, price, regime)
+SELECT investment_type_id, last_tick_at, price, regime
+FROM investment_type_markets;
@@ -0,0 +1,4 @@
+CREATE TRIGGER update_investment_sagas_updated_at
+    BEFORE UPDATE ON investment_sagas
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,3 @@
+-- The saga coordinator stamps updated_at from the scheduler's clock, which runs
+-- faster than the database's in simulation mode; the trigger would overwrite it
+DROP TRIGGER IF EXISTS update_investment_sagas_updated_at ON investment_sagas;
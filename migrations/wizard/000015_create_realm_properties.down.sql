@@ -0,0 +1,13 @@
+-- Drop realm economy tables and columns
+
+DROP INDEX IF EXISTS idx_property_ownership_history_buyer;
+DROP INDEX IF EXISTS idx_property_ownership_history_property;
+DROP INDEX IF EXISTS idx_properties_owner;
+DROP INDEX IF EXISTS idx_properties_realm_id;
+
+DROP TABLE IF EXISTS property_ownership_history;
+DROP TABLE IF EXISTS properties;
+
+DROP TRIGGER IF EXISTS update_realms_updated_at ON realms;
+ALTER TABLE realms DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE realms DROP COLUMN IF EXISTS mana_boost_factor;
@@ -0,0 +1,84 @@
+-- Realm economy: mana boost factors and purchasable properties
+
+-- Realms gain a mana boost factor applied to rewards earned in the realm
+ALTER TABLE realms ADD COLUMN IF NOT EXISTS mana_boost_factor NUMERIC(4,2) NOT NULL DEFAULT 1.00
+    CHECK (mana_boost_factor > 0 AND mana_boost_factor <= 10);
+ALTER TABLE realms ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+
+CREATE TRIGGER update_realms_updated_at
+    BEFORE UPDATE ON realms
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Realms were seeded with explicit ids, so move the sequence past them
+SELECT setval('realms_id_seq', (SELECT COALESCE(MAX(id), 1) FROM realms));
+
+UPDATE realms SET mana_boost_factor = CASE name
+    WHEN 'Pyrrhian Flame' THEN 1.10
+    WHEN 'Zepharion Heights' THEN 1.05
+    WHEN 'Terravine Hollow' THEN 1.00
+    WHEN 'Thalorion Depths' THEN 1.05
+    WHEN 'Virelya' THEN 1.15
+    WHEN 'Umbros' THEN 1.20
+    WHEN 'Nyxthar' THEN 1.25
+    WHEN 'Aetherion' THEN 1.15
+    WHEN 'Chronarxis' THEN 1.20
+    WHEN 'Technarok' THEN 1.10
+    ELSE 1.00
+END;
+
+-- Properties: land and buildings within a realm that wizards can own
+CREATE TABLE IF NOT EXISTS properties (
+    id SERIAL PRIMARY KEY,
+    realm_id INTEGER NOT NULL REFERENCES realms(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    price BIGINT NOT NULL CHECK (price > 0),
+    owner_wizard_id INTEGER REFERENCES wizards(id) ON DELETE SET NULL, -- NULL means owned by the realm (system)
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(realm_id, name)
+);
+
+-- Ownership history: every sale of a property
+CREATE TABLE IF NOT EXISTS property_ownership_history (
+    id SERIAL PRIMARY KEY,
+    property_id INTEGER NOT NULL REFERENCES properties(id) ON DELETE CASCADE,
+    seller_wizard_id INTEGER REFERENCES wizards(id) ON DELETE SET NULL, -- NULL for system sales
+    buyer_wizard_id INTEGER NOT NULL REFERENCES wizards(id) ON DELETE CASCADE,
+    price BIGINT NOT NULL,
+    purchased_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_properties_realm_id ON properties(realm_id);
+CREATE INDEX IF NOT EXISTS idx_properties_owner ON properties(owner_wizard_id);
+CREATE INDEX IF NOT EXISTS idx_property_ownership_history_property ON property_ownership_history(property_id);
+CREATE INDEX IF NOT EXISTS idx_property_ownership_history_buyer ON property_ownership_history(buyer_wizard_id);
+
+CREATE TRIGGER update_properties_updated_at
+    BEFORE UPDATE ON properties
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Seed properties for each realm
+INSERT INTO properties (realm_id, name, description, price) VALUES
+(1, 'Cinder Forge Workshop', 'A smithy built over a magma vent beside the Eternal Forge', 2500),
+(1, 'Ashfall Watchtower', 'A basalt tower overlooking the volcanic plains', 4000),
+(2, 'Cloudreach Aerie', 'A floating manor tethered to a drifting island', 3000),
+(2, 'Whisperwind Observatory', 'An open-air observatory at the edge of The Whisper', 5000),
+(3, 'Rootbound Cottage', 'A home carved into the roots of an ancient tree', 2000),
+(3, 'Titan''s Rest Quarry', 'A quarry cut from the flank of a slumbering stone titan', 4500),
+(4, 'Coral Spire Residence', 'A bubble-domed spire within the sunken empire', 3500),
+(4, 'Tidewell Archive', 'A drowned library preserved in still water', 5500),
+(5, 'Prism Garden Villa', 'A villa of living crystal in the Virelya gardens', 4000),
+(5, 'Dawnlight Sanctum', 'A sanctuary where truth cannot be concealed', 7000),
+(6, 'Duskveil Hideaway', 'A hidden refuge beyond the void rifts', 3500),
+(6, 'Memory Bazaar Stall', 'A trading stall in the shadowmage memory markets', 6000),
+(7, 'Silent Hermitage', 'A cell of absolute stillness at the edge of the collapse', 5000),
+(7, 'Hollow Throne Ruins', 'The remains of a court that forgot itself', 9000),
+(8, 'Dreamer''s Pavilion', 'A pavilion that drifts between the dreams of the dead', 4000),
+(8, 'Soulforge Annex', 'A workshop adjoining the Aetherion Nexus', 6500),
+(9, 'Clockwork Loft', 'A loft above the Chronarxis clock towers', 4500),
+(9, 'Spiral Gallery', 'A gallery where each room shows a different century', 8000),
+(10, 'Foundry Tenement', 'Quarters among the steel god foundries', 3000),
+(10, 'Nanoforge Laboratory', 'A laboratory wired into the Technarok nano-grid', 7500);
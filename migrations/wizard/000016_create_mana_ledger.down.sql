@@ -0,0 +1,16 @@
+-- Drop the mana ledger
+
+DROP TRIGGER IF EXISTS wizard_balance_matches_ledger ON wizards;
+DROP FUNCTION IF EXISTS check_wizard_balance_matches_ledger();
+
+DROP TRIGGER IF EXISTS ledger_entry_balanced ON ledger_postings;
+DROP FUNCTION IF EXISTS check_ledger_entry_balanced();
+
+DROP INDEX IF EXISTS idx_ledger_postings_account_id;
+DROP INDEX IF EXISTS idx_ledger_postings_entry_id;
+DROP INDEX IF EXISTS idx_ledger_entries_type;
+DROP INDEX IF EXISTS idx_ledger_accounts_owner;
+
+DROP TABLE IF EXISTS ledger_postings;
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS ledger_accounts;
@@ -0,0 +1,111 @@
+-- Double-entry mana ledger
+-- Every mana movement is a journal entry whose postings sum to zero. wizards.mana_balance
+-- is kept as a cached copy of each wizard account and is verified against the ledger.
+
+CREATE TABLE IF NOT EXISTS ledger_accounts (
+    id SERIAL PRIMARY KEY,
+    account_type VARCHAR(20) NOT NULL CHECK (account_type IN ('wizard', 'system', 'escrow', 'investment')),
+    -- No foreign key: accounts and their history outlive deleted wizards
+    wizard_id INTEGER,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((account_type = 'system') = (wizard_id IS NULL))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ledger_accounts_owner ON ledger_accounts(account_type, COALESCE(wizard_id, 0));
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id BIGSERIAL PRIMARY KEY,
+    entry_type VARCHAR(50) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS ledger_postings (
+    id BIGSERIAL PRIMARY KEY,
+    entry_id BIGINT NOT NULL REFERENCES ledger_entries(id),
+    account_id INTEGER NOT NULL REFERENCES ledger_accounts(id),
+    amount BIGINT NOT NULL CHECK (amount <> 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_type ON ledger_entries(entry_type);
+CREATE INDEX IF NOT EXISTS idx_ledger_postings_entry_id ON ledger_postings(entry_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_postings_account_id ON ledger_postings(account_id);
+
+INSERT INTO ledger_accounts (account_type) VALUES ('system');
+
+INSERT INTO ledger_accounts (account_type, wizard_id)
+SELECT 'wizard', id FROM wizards;
+
+-- Carry existing balances over as a single opening entry funded by the system account
+DO $$
+DECLARE
+    opening_entry BIGINT;
+    total BIGINT;
+BEGIN
+    IF EXISTS (SELECT 1 FROM wizards WHERE mana_balance <> 0) THEN
+        INSERT INTO ledger_entries (entry_type, description)
+        VALUES ('opening_balance', 'Balances carried over from wizards.mana_balance')
+        RETURNING id INTO opening_entry;
+
+        INSERT INTO ledger_postings (entry_id, account_id, amount)
+        SELECT opening_entry, a.id, w.mana_balance
+        FROM wizards w
+        JOIN ledger_accounts a ON a.account_type = 'wizard' AND a.wizard_id = w.id
+        WHERE w.mana_balance <> 0;
+
+        SELECT SUM(mana_balance) INTO total FROM wizards;
+        IF total <> 0 THEN
+            INSERT INTO ledger_postings (entry_id, account_id, amount)
+            SELECT opening_entry, id, -total FROM ledger_accounts WHERE account_type = 'system';
+        END IF;
+    END IF;
+END $$;
+
+-- Reject entries whose postings do not sum to zero once the transaction commits
+CREATE OR REPLACE FUNCTION check_ledger_entry_balanced()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF (SELECT SUM(amount) FROM ledger_postings WHERE entry_id = NEW.entry_id) <> 0 THEN
+        RAISE EXCEPTION 'ledger entry % is not balanced', NEW.entry_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER ledger_entry_balanced
+    AFTER INSERT ON ledger_postings
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+    EXECUTE FUNCTION check_ledger_entry_balanced();
+
+-- Reject balance changes that bypass the ledger. The current row is re-read because
+-- the trigger is deferred and the balance may have changed again since the event.
+CREATE OR REPLACE FUNCTION check_wizard_balance_matches_ledger()
+RETURNS TRIGGER AS $$
+DECLARE
+    cached BIGINT;
+    ledger_balance BIGINT;
+BEGIN
+    SELECT mana_balance INTO cached FROM wizards WHERE id = NEW.id;
+    IF NOT FOUND THEN
+        RETURN NULL;
+    END IF;
+
+    SELECT COALESCE(SUM(p.amount), 0) INTO ledger_balance
+    FROM ledger_postings p
+    JOIN ledger_accounts a ON p.account_id = a.id
+    WHERE a.account_type = 'wizard' AND a.wizard_id = NEW.id;
+
+    IF cached <> ledger_balance THEN
+        RAISE EXCEPTION 'mana balance % of wizard % does not match ledger balance %',
+            cached, NEW.id, ledger_balance;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER wizard_balance_matches_ledger
+    AFTER INSERT OR UPDATE OF mana_balance ON wizards
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+    EXECUTE FUNCTION check_wizard_balance_matches_ledger();
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_ledger_entries_reference;
+
+ALTER TABLE ledger_entries DROP COLUMN IF EXISTS reference;
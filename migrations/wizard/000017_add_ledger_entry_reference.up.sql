@@ -0,0 +1,4 @@
+-- Callers that retry a mana update tag it with a reference so it is applied at most once
+ALTER TABLE ledger_entries ADD COLUMN reference VARCHAR(100);
+
+CREATE UNIQUE INDEX idx_ledger_entries_reference ON ledger_entries(reference) WHERE reference IS NOT NULL;
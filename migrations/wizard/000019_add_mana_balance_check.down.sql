@@ -0,0 +1 @@
+ALTER TABLE wizards DROP CONSTRAINT IF EXISTS wizards_mana_balance_non_negative;
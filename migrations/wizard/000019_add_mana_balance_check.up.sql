@@ -0,0 +1,2 @@
+-- A wizard's mana balance can never go below zero, whatever path updates it
+ALTER TABLE wizards ADD CONSTRAINT wizards_mana_balance_non_negative CHECK (mana_balance >= 0);
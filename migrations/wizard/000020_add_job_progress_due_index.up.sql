@@ -0,0 +1,3 @@
+-- Running jobs are completed in order of expected_end_time, so the scheduler only
+-- ever reads the rows that are due instead of every active one
+CREATE INDEX IF NOT EXISTS idx_job_progress_due ON job_progress(expected_end_time) WHERE is_active = true;
@@ -0,0 +1,8 @@
+-- The replica holding an election's advisory lock runs its background workers and
+-- records itself here; heartbeat_at shows it is still alive
+CREATE TABLE IF NOT EXISTS worker_leaders (
+    name VARCHAR(100) PRIMARY KEY,
+    instance VARCHAR(255) NOT NULL,
+    elected_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    heartbeat_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
@@ -0,0 +1,16 @@
+UPDATE job_progress SET is_active = false
+WHERE assignment_id IN (SELECT id FROM job_assignments WHERE status = 'paused');
+UPDATE job_assignments SET status = 'cancelled' WHERE status = 'paused';
+
+ALTER TABLE job_progress
+    DROP COLUMN IF EXISTS paused_minutes,
+    DROP COLUMN IF EXISTS paused_at;
+
+DROP INDEX IF EXISTS job_assignments_active_unique;
+CREATE UNIQUE INDEX job_assignments_active_unique
+ON job_assignments (job_id, wizard_id)
+WHERE status IN ('assigned', 'in_progress');
+
+ALTER TABLE job_assignments DROP CONSTRAINT IF EXISTS job_assignments_status_check;
+ALTER TABLE job_assignments ADD CONSTRAINT job_assignments_status_check
+CHECK (status IN ('assigned', 'in_progress', 'completed', 'failed', 'cancelled'));
@@ -0,0 +1,15 @@
+-- Job assignments can be paused; a paused assignment still holds its place on the job
+ALTER TABLE job_assignments DROP CONSTRAINT IF EXISTS job_assignments_status_check;
+ALTER TABLE job_assignments ADD CONSTRAINT job_assignments_status_check
+CHECK (status IN ('assigned', 'in_progress', 'paused', 'completed', 'failed', 'cancelled'));
+
+DROP INDEX IF EXISTS job_assignments_active_unique;
+CREATE UNIQUE INDEX job_assignments_active_unique
+ON job_assignments (job_id, wizard_id)
+WHERE status IN ('assigned', 'in_progress', 'paused');
+
+-- Resuming shifts actual_start_time and expected_end_time by the time spent paused,
+-- so progress stays a function of the two; paused_minutes keeps the total
+ALTER TABLE job_progress
+    ADD COLUMN IF NOT EXISTS paused_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS paused_minutes INTEGER NOT NULL DEFAULT 0 CHECK (paused_minutes >= 0);
@@ -0,0 +1,37 @@
+-- Rewards a wizard is granted on reaching a level. Mana bonuses are paid once when
+-- the level is reached; job tiers and wizard slots hold for as long as the level does.
+CREATE TABLE IF NOT EXISTS level_rewards (
+    id SERIAL PRIMARY KEY,
+    level INTEGER NOT NULL CHECK (level >= 1),
+    reward_type VARCHAR(20) NOT NULL CHECK (reward_type IN ('mana_bonus', 'job_tier', 'wizard_slot')),
+    amount BIGINT NOT NULL DEFAULT 0 CHECK (amount >= 0),
+    -- The difficulty of jobs a job_tier reward unlocks
+    job_difficulty VARCHAR(20) CHECK (job_difficulty IN ('Easy', 'Medium', 'Hard', 'Expert', 'Legendary')),
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((reward_type = 'job_tier') = (job_difficulty IS NOT NULL)),
+    UNIQUE (level, reward_type)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_level_rewards_job_difficulty ON level_rewards(job_difficulty);
+
+-- Job tiers open at the lowest level any seeded job of the difficulty requires
+INSERT INTO level_rewards (level, reward_type, job_difficulty, description) VALUES
+(1, 'job_tier', 'Easy', 'Easy jobs'),
+(2, 'job_tier', 'Medium', 'Medium jobs'),
+(3, 'job_tier', 'Hard', 'Hard jobs'),
+(4, 'job_tier', 'Expert', 'Expert jobs'),
+(6, 'job_tier', 'Legendary', 'Legendary jobs');
+
+INSERT INTO level_rewards (level, reward_type, amount, description) VALUES
+(2, 'mana_bonus', 100, 'Apprentice''s purse'),
+(5, 'mana_bonus', 250, 'Adept''s stipend'),
+(10, 'mana_bonus', 500, 'Journeyman''s endowment'),
+(10, 'wizard_slot', 1, 'Take on an apprentice'),
+(15, 'mana_bonus', 750, 'Guild patronage'),
+(20, 'mana_bonus', 1000, 'Magister''s endowment'),
+(25, 'mana_bonus', 1250, 'Council honorarium'),
+(25, 'wizard_slot', 1, 'Found a circle'),
+(30, 'mana_bonus', 1500, 'Archmage''s tithe'),
+(40, 'mana_bonus', 2000, 'Realm warden''s grant'),
+(50, 'mana_bonus', 2500, 'Legend of the realms');
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_job_assignments_party_id;
+ALTER TABLE job_assignments DROP COLUMN IF EXISTS party_id;
+DROP TABLE IF EXISTS job_parties;
+DROP TABLE IF EXISTS job_party_rules;
@@ -0,0 +1,24 @@
+-- Party jobs are worked by a group of wizards that apply together. A job with a
+-- party rule only takes parties; composition gives the least number of members of
+-- each element, and reward_split how the pooled rewards are shared out.
+CREATE TABLE IF NOT EXISTS job_party_rules (
+    job_id INTEGER PRIMARY KEY REFERENCES jobs(id) ON DELETE CASCADE,
+    min_size INTEGER NOT NULL CHECK (min_size >= 2),
+    composition JSONB NOT NULL DEFAULT '{}',
+    reward_split VARCHAR(20) NOT NULL DEFAULT 'equal' CHECK (reward_split IN ('equal', 'level_weighted'))
+);
+
+CREATE TABLE IF NOT EXISTS job_parties (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'in_progress' CHECK (status IN ('in_progress', 'completed', 'cancelled')),
+    reward_split VARCHAR(20) NOT NULL CHECK (reward_split IN ('equal', 'level_weighted')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Each member of a party has an assignment of its own
+ALTER TABLE job_assignments ADD COLUMN IF NOT EXISTS party_id INTEGER REFERENCES job_parties(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_job_parties_job_id ON job_parties(job_id);
+CREATE INDEX IF NOT EXISTS idx_job_assignments_party_id ON job_assignments(party_id) WHERE party_id IS NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE job_assignments DROP COLUMN IF EXISTS speed_factor;
+ALTER TABLE job_assignments DROP COLUMN IF EXISTS reward_factor;
+DROP TABLE IF EXISTS element_affinities;
@@ -0,0 +1,38 @@
+-- How well a wizard of one element works a job of another. A wizard of the job's
+-- own element needs no row and works it at full reward and speed; a pair with no
+-- row cannot take the job at all. reward_factor scales the mana paid and
+-- speed_factor how fast the work is done, so 0.80 takes a quarter longer.
+CREATE TABLE IF NOT EXISTS element_affinities (
+    wizard_element VARCHAR(50) NOT NULL,
+    job_element VARCHAR(50) NOT NULL,
+    reward_factor NUMERIC(4,2) NOT NULL CHECK (reward_factor > 0),
+    speed_factor NUMERIC(4,2) NOT NULL CHECK (speed_factor > 0),
+    PRIMARY KEY (wizard_element, job_element),
+    CHECK (wizard_element <> job_element)
+);
+
+-- Kindred elements, both ways round
+WITH kindred (a, b, reward_factor, speed_factor) AS (VALUES
+    ('Fire', 'Metal', 0.85, 0.80),
+    ('Fire', 'Light', 0.85, 0.80),
+    ('Air', 'Water', 0.85, 0.80),
+    ('Air', 'Spirit', 0.85, 0.80),
+    ('Earth', 'Metal', 0.85, 0.80),
+    ('Earth', 'Water', 0.85, 0.80),
+    ('Light', 'Spirit', 0.85, 0.80),
+    ('Shadow', 'Null', 0.85, 0.80),
+    ('Shadow', 'Spirit', 0.75, 0.80),
+    ('Time', 'Spirit', 0.75, 0.80),
+    ('Time', 'Null', 0.75, 0.80),
+    ('Time', 'Metal', 0.85, 0.80)
+)
+INSERT INTO element_affinities (wizard_element, job_element, reward_factor, speed_factor)
+SELECT a, b, reward_factor, speed_factor FROM kindred
+UNION ALL
+SELECT b, a, reward_factor, speed_factor FROM kindred
+ON CONFLICT (wizard_element, job_element) DO NOTHING;
+
+-- Each assignment keeps the affinity it was taken on, so later changes to the
+-- matrix do not change the terms of running jobs
+ALTER TABLE job_assignments ADD COLUMN IF NOT EXISTS reward_factor NUMERIC(4,2) NOT NULL DEFAULT 1.00;
+ALTER TABLE job_assignments ADD COLUMN IF NOT EXISTS speed_factor NUMERIC(4,2) NOT NULL DEFAULT 1.00;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_job_applications_wizard_id;
+DROP INDEX IF EXISTS idx_job_postings_open_expiry;
+DROP INDEX IF EXISTS job_applications_pending_unique;
+DROP TABLE IF EXISTS job_applications;
+DROP TABLE IF EXISTS job_postings;
@@ -0,0 +1,31 @@
+-- A job posted by a wizard is funded from their mana. reward_per_wizard for
+-- every place on the job is moved into the poster's escrow account when it is
+-- posted; escrow_remaining is what is still held for it.
+CREATE TABLE IF NOT EXISTS job_postings (
+    job_id INTEGER PRIMARY KEY REFERENCES jobs(id) ON DELETE CASCADE,
+    poster_wizard_id INTEGER NOT NULL REFERENCES wizards(id) ON DELETE CASCADE,
+    reward_per_wizard INTEGER NOT NULL CHECK (reward_per_wizard >= 0),
+    escrow_remaining BIGINT NOT NULL CHECK (escrow_remaining >= 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'filled', 'expired')),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    closed_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Wizards apply to posted jobs, and the poster accepts or rejects them
+CREATE TABLE IF NOT EXISTS job_applications (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    wizard_id INTEGER NOT NULL REFERENCES wizards(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'rejected', 'expired')),
+    message TEXT,
+    assignment_id INTEGER REFERENCES job_assignments(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    decided_at TIMESTAMP WITH TIME ZONE
+);
+
+-- A wizard has at most one application waiting on each job
+CREATE UNIQUE INDEX IF NOT EXISTS job_applications_pending_unique ON job_applications(job_id, wizard_id) WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS idx_job_postings_open_expiry ON job_postings(expires_at) WHERE status = 'open';
+CREATE INDEX IF NOT EXISTS idx_job_applications_wizard_id ON job_applications(wizard_id);
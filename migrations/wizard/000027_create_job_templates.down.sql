@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_jobs_template_id;
+DROP INDEX IF EXISTS idx_jobs_active_expiry;
+DROP INDEX IF EXISTS idx_job_templates_due;
+ALTER TABLE jobs DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE jobs DROP COLUMN IF EXISTS template_id;
+DROP TABLE IF EXISTS job_templates;
@@ -0,0 +1,41 @@
+-- A job template posts fresh jobs on a schedule, every interval_minutes or on a
+-- cron expression (in UTC), into one realm or, with no realm_id, into every
+-- realm. Templates only post between active_from and active_until, and each job
+-- they post comes off the board posting_lifetime_minutes later.
+CREATE TABLE IF NOT EXISTS job_templates (
+    id SERIAL PRIMARY KEY,
+    realm_id INTEGER REFERENCES realms(id) ON DELETE CASCADE,
+    title VARCHAR(200) NOT NULL,
+    description TEXT NOT NULL,
+    -- Empty takes the element of the realm the job is posted in
+    required_element VARCHAR(50) NOT NULL DEFAULT '',
+    required_level INTEGER NOT NULL DEFAULT 1,
+    mana_reward_per_hour INTEGER NOT NULL,
+    exp_reward_per_hour INTEGER NOT NULL DEFAULT 10,
+    duration_minutes INTEGER NOT NULL,
+    max_wizards INTEGER NOT NULL DEFAULT 1,
+    difficulty VARCHAR(20) NOT NULL CHECK (difficulty IN ('Easy', 'Medium', 'Hard', 'Expert', 'Legendary')),
+    job_type VARCHAR(50) NOT NULL,
+    location VARCHAR(200),
+    special_requirements TEXT,
+    interval_minutes INTEGER CHECK (interval_minutes > 0),
+    cron_expr VARCHAR(100),
+    posting_lifetime_minutes INTEGER NOT NULL CHECK (posting_lifetime_minutes > 0),
+    active_from TIMESTAMP WITH TIME ZONE,
+    active_until TIMESTAMP WITH TIME ZONE,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((interval_minutes IS NULL) <> (cron_expr IS NULL)),
+    CHECK (active_until IS NULL OR active_from IS NULL OR active_until > active_from)
+);
+
+-- Jobs a template posted, and when they come off the board
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS template_id INTEGER REFERENCES job_templates(id) ON DELETE SET NULL;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_job_templates_due ON job_templates(next_run_at) WHERE is_active = true;
+CREATE INDEX IF NOT EXISTS idx_jobs_active_expiry ON jobs(expires_at) WHERE is_active = true AND expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_jobs_template_id ON jobs(template_id) WHERE template_id IS NOT NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE job_progress
+    DROP COLUMN IF EXISTS last_completion_error,
+    DROP COLUMN IF EXISTS next_completion_at,
+    DROP COLUMN IF EXISTS completion_attempts;
@@ -0,0 +1,6 @@
+-- job_progress is the job completion queue. A failed auto-completion pushes
+-- next_completion_at back so one broken assignment cannot hold up the rest.
+ALTER TABLE job_progress
+    ADD COLUMN IF NOT EXISTS completion_attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_completion_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS last_completion_error TEXT;
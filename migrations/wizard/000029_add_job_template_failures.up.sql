@@ -0,0 +1,6 @@
+-- A template whose run fails is retried with backoff, and taken off the
+-- schedule once it has failed failed_runs times in a row. A successful run or
+-- an admin update clears the count.
+ALTER TABLE job_templates
+    ADD COLUMN IF NOT EXISTS failed_runs INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS last_error TEXT;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_properties_for_sale;
+
+ALTER TABLE properties DROP COLUMN IF EXISTS for_sale;
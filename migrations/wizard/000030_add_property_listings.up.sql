@@ -0,0 +1,5 @@
+-- A property the realm still owns is always for sale. Once a wizard owns it, it
+-- is only sold after they list it, and a sale takes it off the market again.
+ALTER TABLE properties ADD COLUMN IF NOT EXISTS for_sale BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX IF NOT EXISTS idx_properties_for_sale ON properties(realm_id) WHERE for_sale = true;
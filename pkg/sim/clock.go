@@ -0,0 +1,193 @@
+// Package sim provides the clock and random source that background workers run on.
+// Services use the real ones; tests swap in a FakeClock and a seeded RNG to control
+// time and reproduce exact outcomes, and operators can run a seeded simulation.
+package sim
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock tells the time and schedules work
+type Clock interface {
+	Now() time.Time
+	AfterFunc(d time.Duration, f func()) Timer
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a pending AfterFunc call
+type Timer interface {
+	Stop() bool
+}
+
+// Ticker delivers ticks on C
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+type realClock struct{}
+
+// RealClock returns the system clock
+func RealClock() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTicker struct {
+	ticker *time.Ticker
+}
+
+func (t realTicker) C() <-chan time.Time { return t.ticker.C }
+func (t realTicker) Stop()               { t.ticker.Stop() }
+
+// scaledClock runs speed times faster than the system clock from the moment it
+// was created
+type scaledClock struct {
+	start time.Time
+	speed float64
+}
+
+// ScaledClock returns a clock that starts at the current time and runs speed times
+// faster than the system clock
+func ScaledClock(speed float64) Clock {
+	return &scaledClock{start: time.Now(), speed: speed}
+}
+
+func (c *scaledClock) Now() time.Time {
+	return c.start.Add(time.Duration(float64(time.Since(c.start)) * c.speed))
+}
+
+func (c *scaledClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(c.real(d), f)
+}
+
+func (c *scaledClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(c.real(d))}
+}
+
+// real converts a duration on this clock to wall-clock time
+func (c *scaledClock) real(d time.Duration) time.Duration {
+	real := time.Duration(float64(d) / c.speed)
+	if real <= 0 {
+		real = time.Nanosecond
+	}
+	return real
+}
+
+// FakeClock only moves when Advance is called. Timers and tickers that fall due
+// fire in time order during Advance, and AfterFunc callbacks run synchronously.
+type FakeClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	clock  *FakeClock
+	at     time.Time
+	period time.Duration
+	fn     func()
+	ch     chan time.Time
+}
+
+// NewFakeClock returns a FakeClock stopped at now
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	return c.add(&fakeTimer{at: c.Now().Add(d), fn: f})
+}
+
+func (c *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("sim: non-positive interval for NewTicker")
+	}
+	return fakeTicker{c.add(&fakeTimer{at: c.Now().Add(d), period: d, ch: make(chan time.Time, 1)})}
+}
+
+func (c *FakeClock) add(t *fakeTimer) *fakeTimer {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	t.clock = c
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance moves the clock forward by d, firing everything that falls due on the way
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	target := c.now.Add(d)
+	c.mutex.Unlock()
+
+	for {
+		c.mutex.Lock()
+		sort.SliceStable(c.timers, func(i, j int) bool { return c.timers[i].at.Before(c.timers[j].at) })
+		if len(c.timers) == 0 || c.timers[0].at.After(target) {
+			c.now = target
+			c.mutex.Unlock()
+			return
+		}
+
+		t := c.timers[0]
+		c.now = t.at
+		if t.period > 0 {
+			t.at = t.at.Add(t.period)
+		} else {
+			c.timers = c.timers[1:]
+		}
+		now := c.now
+		c.mutex.Unlock()
+
+		if t.ch != nil {
+			// Like time.Ticker, drop ticks the reader is not keeping up with
+			select {
+			case t.ch <- now:
+			default:
+			}
+		} else {
+			t.fn()
+		}
+	}
+}
+
+func (t *fakeTimer) Stop() bool {
+	c := t.clock
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for i, pending := range c.timers {
+		if pending == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+type fakeTicker struct {
+	timer *fakeTimer
+}
+
+func (t fakeTicker) C() <-chan time.Time { return t.timer.ch }
+func (t fakeTicker) Stop()               { t.timer.Stop() }
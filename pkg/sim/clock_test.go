@@ -0,0 +1,54 @@
+package sim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeClockFiresTimersInOrder(t *testing.T) {
+	start := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	var fired []string
+	clock.AfterFunc(2*time.Hour, func() { fired = append(fired, "second") })
+	clock.AfterFunc(time.Hour, func() {
+		fired = append(fired, "first")
+		assert.Equal(t, start.Add(time.Hour), clock.Now())
+	})
+	stopped := clock.AfterFunc(90*time.Minute, func() { fired = append(fired, "stopped") })
+	assert.True(t, stopped.Stop())
+
+	clock.Advance(time.Hour + 59*time.Minute)
+	assert.Equal(t, []string{"first"}, fired)
+
+	clock.Advance(time.Minute)
+	assert.Equal(t, []string{"first", "second"}, fired)
+	assert.Equal(t, start.Add(2*time.Hour), clock.Now())
+}
+
+func TestFakeClockTicker(t *testing.T) {
+	clock := NewFakeClock(time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC))
+	ticker := clock.NewTicker(time.Minute)
+
+	clock.Advance(30 * time.Second)
+	assert.Len(t, ticker.C(), 0)
+
+	// Ticks the reader does not collect are dropped, as with time.Ticker
+	clock.Advance(3 * time.Minute)
+	assert.Len(t, ticker.C(), 1)
+	<-ticker.C()
+
+	ticker.Stop()
+	clock.Advance(time.Hour)
+	assert.Len(t, ticker.C(), 0)
+}
+
+func TestNewRNGIsReproducible(t *testing.T) {
+	first, second := NewRNG(99), NewRNG(99)
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, first.Float64(), second.Float64())
+		assert.Equal(t, first.Int63n(1000), second.Int63n(1000))
+	}
+}
@@ -0,0 +1,70 @@
+package sim
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/tectix/mysticfunds/pkg/config"
+)
+
+// RNG is a source of random numbers that is safe for concurrent use
+type RNG interface {
+	Float64() float64
+	Int63n(n int64) int64
+}
+
+type lockedRNG struct {
+	mutex sync.Mutex
+	rand  *rand.Rand
+}
+
+// NewRNG returns a random source that always produces the same sequence for seed
+func NewRNG(seed int64) RNG {
+	return &lockedRNG{rand: rand.New(rand.NewSource(seed))}
+}
+
+// SystemRNG returns a random source seeded from the current time
+func SystemRNG() RNG {
+	return NewRNG(time.Now().UnixNano())
+}
+
+func (r *lockedRNG) Float64() float64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.rand.Float64()
+}
+
+func (r *lockedRNG) Int63n(n int64) int64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.rand.Int63n(n)
+}
+
+// FromConfig returns the clock and random source a service should run on. Setting
+// SIMULATION_SEED turns on simulation mode, where randomness comes from the seed so
+// a run can be replayed, and SIMULATION_SPEED makes time pass that many times
+// faster. Replays are only exact when a single replica is doing the work.
+func FromConfig(cfg *config.Config) (Clock, RNG, error) {
+	seedValue := cfg.GetString("SIMULATION_SEED", "")
+	if seedValue == "" {
+		return RealClock(), SystemRNG(), nil
+	}
+
+	seed, err := strconv.ParseInt(seedValue, 10, 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid SIMULATION_SEED %q: %w", seedValue, err)
+	}
+
+	speed, err := strconv.ParseFloat(cfg.GetString("SIMULATION_SPEED", "1"), 64)
+	if err != nil || speed <= 0 {
+		return nil, nil, fmt.Errorf("invalid SIMULATION_SPEED %q", cfg.GetString("SIMULATION_SPEED", "1"))
+	}
+
+	if speed == 1 {
+		return RealClock(), NewRNG(seed), nil
+	}
+	return ScaledClock(speed), NewRNG(seed), nil
+}
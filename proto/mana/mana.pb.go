@@ -106,9 +106,10 @@ type TransferManaRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FromWizardId int64 `protobuf:"varint,1,opt,name=from_wizard_id,json=fromWizardId,proto3" json:"from_wizard_id,omitempty"`
-	ToWizardId   int64 `protobuf:"varint,2,opt,name=to_wizard_id,json=toWizardId,proto3" json:"to_wizard_id,omitempty"`
-	Amount       int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	FromWizardId   int64  `protobuf:"varint,1,opt,name=from_wizard_id,json=fromWizardId,proto3" json:"from_wizard_id,omitempty"`
+	ToWizardId     int64  `protobuf:"varint,2,opt,name=to_wizard_id,json=toWizardId,proto3" json:"to_wizard_id,omitempty"`
+	Amount         int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // Optional; retries with the same key replay the original response
 }
 
 func (x *TransferManaRequest) Reset() {
@@ -164,6 +165,13 @@ func (x *TransferManaRequest) GetAmount() int64 {
 	return 0
 }
 
+func (x *TransferManaRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type TransferManaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -445,14 +453,17 @@ type InvestmentType struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description    string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	MinAmount      int64   `protobuf:"varint,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
-	MaxAmount      int64   `protobuf:"varint,5,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
-	DurationHours  int32   `protobuf:"varint,6,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
-	BaseReturnRate float64 `protobuf:"fixed64,7,opt,name=base_return_rate,json=baseReturnRate,proto3" json:"base_return_rate,omitempty"`
-	RiskLevel      int32   `protobuf:"varint,8,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	Id                 int64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string              `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	MinAmount          int64               `protobuf:"varint,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount          int64               `protobuf:"varint,5,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	DurationHours      int32               `protobuf:"varint,6,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	BaseReturnRate     float64             `protobuf:"fixed64,7,opt,name=base_return_rate,json=baseReturnRate,proto3" json:"base_return_rate,omitempty"`
+	RiskLevel          int32               `protobuf:"varint,8,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	EarlyExitPenalties []*EarlyExitPenalty `protobuf:"bytes,9,rep,name=early_exit_penalties,json=earlyExitPenalties,proto3" json:"early_exit_penalties,omitempty"`
+	Version            int32               `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"` // Current version of the terms; new investments are opened under it
+	IsActive           bool                `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 }
 
 func (x *InvestmentType) Reset() {
@@ -543,27 +554,111 @@ func (x *InvestmentType) GetRiskLevel() int32 {
 	return 0
 }
 
+func (x *InvestmentType) GetEarlyExitPenalties() []*EarlyExitPenalty {
+	if x != nil {
+		return x.EarlyExitPenalties
+	}
+	return nil
+}
+
+func (x *InvestmentType) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *InvestmentType) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+// EarlyExitPenalty is the share of the principal kept when an investment is
+// withdrawn once at least after_percent of its term has passed
+type EarlyExitPenalty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AfterPercent   float64 `protobuf:"fixed64,1,opt,name=after_percent,json=afterPercent,proto3" json:"after_percent,omitempty"`
+	PenaltyPercent float64 `protobuf:"fixed64,2,opt,name=penalty_percent,json=penaltyPercent,proto3" json:"penalty_percent,omitempty"`
+}
+
+func (x *EarlyExitPenalty) Reset() {
+	*x = EarlyExitPenalty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EarlyExitPenalty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EarlyExitPenalty) ProtoMessage() {}
+
+func (x *EarlyExitPenalty) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EarlyExitPenalty.ProtoReflect.Descriptor instead.
+func (*EarlyExitPenalty) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EarlyExitPenalty) GetAfterPercent() float64 {
+	if x != nil {
+		return x.AfterPercent
+	}
+	return 0
+}
+
+func (x *EarlyExitPenalty) GetPenaltyPercent() float64 {
+	if x != nil {
+		return x.PenaltyPercent
+	}
+	return 0
+}
+
 type Investment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id               int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	WizardId         int64   `protobuf:"varint,2,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
-	InvestmentType   string  `protobuf:"bytes,3,opt,name=investment_type,json=investmentType,proto3" json:"investment_type,omitempty"`
-	Amount           int64   `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	StartTime        int64   `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime          int64   `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Status           string  `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	ActualReturnRate float64 `protobuf:"fixed64,8,opt,name=actual_return_rate,json=actualReturnRate,proto3" json:"actual_return_rate,omitempty"`
-	ReturnedAmount   int64   `protobuf:"varint,9,opt,name=returned_amount,json=returnedAmount,proto3" json:"returned_amount,omitempty"`
-	RiskLevel        int32   `protobuf:"varint,10,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	Id                 int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WizardId           int64   `protobuf:"varint,2,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	InvestmentType     string  `protobuf:"bytes,3,opt,name=investment_type,json=investmentType,proto3" json:"investment_type,omitempty"`
+	Amount             int64   `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	StartTime          int64   `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime            int64   `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Status             string  `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ActualReturnRate   float64 `protobuf:"fixed64,8,opt,name=actual_return_rate,json=actualReturnRate,proto3" json:"actual_return_rate,omitempty"`
+	ReturnedAmount     int64   `protobuf:"varint,9,opt,name=returned_amount,json=returnedAmount,proto3" json:"returned_amount,omitempty"`
+	RiskLevel          int32   `protobuf:"varint,10,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	FailureReason      string  `protobuf:"bytes,11,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	PenaltyAmount      int64   `protobuf:"varint,12,opt,name=penalty_amount,json=penaltyAmount,proto3" json:"penalty_amount,omitempty"`
+	AutoRollover       bool    `protobuf:"varint,13,opt,name=auto_rollover,json=autoRollover,proto3" json:"auto_rollover,omitempty"`
+	RolloverMode       string  `protobuf:"bytes,14,opt,name=rollover_mode,json=rolloverMode,proto3" json:"rollover_mode,omitempty"`
+	RolloversRemaining int32   `protobuf:"varint,15,opt,name=rollovers_remaining,json=rolloversRemaining,proto3" json:"rollovers_remaining,omitempty"`
+	RolledOverFrom     int64   `protobuf:"varint,16,opt,name=rolled_over_from,json=rolledOverFrom,proto3" json:"rolled_over_from,omitempty"` // The investment this one was rolled over from, if any
 }
 
 func (x *Investment) Reset() {
 	*x = Investment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mana_mana_proto_msgTypes[8]
+		mi := &file_proto_mana_mana_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -576,7 +671,7 @@ func (x *Investment) String() string {
 func (*Investment) ProtoMessage() {}
 
 func (x *Investment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mana_mana_proto_msgTypes[8]
+	mi := &file_proto_mana_mana_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,7 +684,7 @@ func (x *Investment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Investment.ProtoReflect.Descriptor instead.
 func (*Investment) Descriptor() ([]byte, []int) {
-	return file_proto_mana_mana_proto_rawDescGZIP(), []int{8}
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Investment) GetId() int64 {
@@ -662,20 +757,68 @@ func (x *Investment) GetRiskLevel() int32 {
 	return 0
 }
 
+func (x *Investment) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Investment) GetPenaltyAmount() int64 {
+	if x != nil {
+		return x.PenaltyAmount
+	}
+	return 0
+}
+
+func (x *Investment) GetAutoRollover() bool {
+	if x != nil {
+		return x.AutoRollover
+	}
+	return false
+}
+
+func (x *Investment) GetRolloverMode() string {
+	if x != nil {
+		return x.RolloverMode
+	}
+	return ""
+}
+
+func (x *Investment) GetRolloversRemaining() int32 {
+	if x != nil {
+		return x.RolloversRemaining
+	}
+	return 0
+}
+
+func (x *Investment) GetRolledOverFrom() int64 {
+	if x != nil {
+		return x.RolledOverFrom
+	}
+	return 0
+}
+
 type CreateInvestmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WizardId         int64 `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
-	InvestmentTypeId int64 `protobuf:"varint,2,opt,name=investment_type_id,json=investmentTypeId,proto3" json:"investment_type_id,omitempty"`
-	Amount           int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	WizardId         int64  `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	InvestmentTypeId int64  `protobuf:"varint,2,opt,name=investment_type_id,json=investmentTypeId,proto3" json:"investment_type_id,omitempty"`
+	Amount           int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	IdempotencyKey   string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // Optional; retries with the same key replay the original response
+	// When set, the matured investment is reinvested in the same type instead of
+	// being credited, up to max_rollovers times
+	AutoRollover bool   `protobuf:"varint,5,opt,name=auto_rollover,json=autoRollover,proto3" json:"auto_rollover,omitempty"`
+	RolloverMode string `protobuf:"bytes,6,opt,name=rollover_mode,json=rolloverMode,proto3" json:"rollover_mode,omitempty"`  // "compound" (principal plus return, the default) or "principal"
+	MaxRollovers int32  `protobuf:"varint,7,opt,name=max_rollovers,json=maxRollovers,proto3" json:"max_rollovers,omitempty"` // Defaults to the service's cap when 0
 }
 
 func (x *CreateInvestmentRequest) Reset() {
 	*x = CreateInvestmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mana_mana_proto_msgTypes[9]
+		mi := &file_proto_mana_mana_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -688,7 +831,7 @@ func (x *CreateInvestmentRequest) String() string {
 func (*CreateInvestmentRequest) ProtoMessage() {}
 
 func (x *CreateInvestmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mana_mana_proto_msgTypes[9]
+	mi := &file_proto_mana_mana_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,7 +844,7 @@ func (x *CreateInvestmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInvestmentRequest.ProtoReflect.Descriptor instead.
 func (*CreateInvestmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_mana_mana_proto_rawDescGZIP(), []int{9}
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateInvestmentRequest) GetWizardId() int64 {
@@ -725,6 +868,34 @@ func (x *CreateInvestmentRequest) GetAmount() int64 {
 	return 0
 }
 
+func (x *CreateInvestmentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateInvestmentRequest) GetAutoRollover() bool {
+	if x != nil {
+		return x.AutoRollover
+	}
+	return false
+}
+
+func (x *CreateInvestmentRequest) GetRolloverMode() string {
+	if x != nil {
+		return x.RolloverMode
+	}
+	return ""
+}
+
+func (x *CreateInvestmentRequest) GetMaxRollovers() int32 {
+	if x != nil {
+		return x.MaxRollovers
+	}
+	return 0
+}
+
 type CreateInvestmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -737,7 +908,7 @@ type CreateInvestmentResponse struct {
 func (x *CreateInvestmentResponse) Reset() {
 	*x = CreateInvestmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mana_mana_proto_msgTypes[10]
+		mi := &file_proto_mana_mana_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -750,7 +921,7 @@ func (x *CreateInvestmentResponse) String() string {
 func (*CreateInvestmentResponse) ProtoMessage() {}
 
 func (x *CreateInvestmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mana_mana_proto_msgTypes[10]
+	mi := &file_proto_mana_mana_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,7 +934,7 @@ func (x *CreateInvestmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInvestmentResponse.ProtoReflect.Descriptor instead.
 func (*CreateInvestmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_mana_mana_proto_rawDescGZIP(), []int{10}
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateInvestmentResponse) GetInvestmentId() int64 {
@@ -792,7 +963,7 @@ type GetInvestmentsRequest struct {
 func (x *GetInvestmentsRequest) Reset() {
 	*x = GetInvestmentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mana_mana_proto_msgTypes[11]
+		mi := &file_proto_mana_mana_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -805,7 +976,7 @@ func (x *GetInvestmentsRequest) String() string {
 func (*GetInvestmentsRequest) ProtoMessage() {}
 
 func (x *GetInvestmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mana_mana_proto_msgTypes[11]
+	mi := &file_proto_mana_mana_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -818,7 +989,7 @@ func (x *GetInvestmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInvestmentsRequest.ProtoReflect.Descriptor instead.
 func (*GetInvestmentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_mana_mana_proto_rawDescGZIP(), []int{11}
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetInvestmentsRequest) GetWizardId() int64 {
@@ -846,7 +1017,7 @@ type GetInvestmentsResponse struct {
 func (x *GetInvestmentsResponse) Reset() {
 	*x = GetInvestmentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mana_mana_proto_msgTypes[12]
+		mi := &file_proto_mana_mana_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -859,7 +1030,7 @@ func (x *GetInvestmentsResponse) String() string {
 func (*GetInvestmentsResponse) ProtoMessage() {}
 
 func (x *GetInvestmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mana_mana_proto_msgTypes[12]
+	mi := &file_proto_mana_mana_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +1043,7 @@ func (x *GetInvestmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInvestmentsResponse.ProtoReflect.Descriptor instead.
 func (*GetInvestmentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_mana_mana_proto_rawDescGZIP(), []int{12}
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetInvestmentsResponse) GetInvestments() []*Investment {
@@ -887,15 +1058,16 @@ type GetInvestmentTypesRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MinAmount int64 `protobuf:"varint,1,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"` // Optional filter by minimum amount
-	MaxAmount int64 `protobuf:"varint,2,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"` // Optional filter by maximum amount
-	RiskLevel int32 `protobuf:"varint,3,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"` // Optional filter by risk level
+	MinAmount       int64 `protobuf:"varint,1,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`                   // Optional filter by minimum amount
+	MaxAmount       int64 `protobuf:"varint,2,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`                   // Optional filter by maximum amount
+	RiskLevel       int32 `protobuf:"varint,3,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`                   // Optional filter by risk level
+	IncludeInactive bool  `protobuf:"varint,4,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"` // Also list deactivated types
 }
 
 func (x *GetInvestmentTypesRequest) Reset() {
 	*x = GetInvestmentTypesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mana_mana_proto_msgTypes[13]
+		mi := &file_proto_mana_mana_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -908,7 +1080,7 @@ func (x *GetInvestmentTypesRequest) String() string {
 func (*GetInvestmentTypesRequest) ProtoMessage() {}
 
 func (x *GetInvestmentTypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mana_mana_proto_msgTypes[13]
+	mi := &file_proto_mana_mana_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,7 +1093,7 @@ func (x *GetInvestmentTypesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInvestmentTypesRequest.ProtoReflect.Descriptor instead.
 func (*GetInvestmentTypesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_mana_mana_proto_rawDescGZIP(), []int{13}
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetInvestmentTypesRequest) GetMinAmount() int64 {
@@ -945,6 +1117,13 @@ func (x *GetInvestmentTypesRequest) GetRiskLevel() int32 {
 	return 0
 }
 
+func (x *GetInvestmentTypesRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
 type GetInvestmentTypesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -956,7 +1135,7 @@ type GetInvestmentTypesResponse struct {
 func (x *GetInvestmentTypesResponse) Reset() {
 	*x = GetInvestmentTypesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_mana_mana_proto_msgTypes[14]
+		mi := &file_proto_mana_mana_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -969,7 +1148,7 @@ func (x *GetInvestmentTypesResponse) String() string {
 func (*GetInvestmentTypesResponse) ProtoMessage() {}
 
 func (x *GetInvestmentTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mana_mana_proto_msgTypes[14]
+	mi := &file_proto_mana_mana_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,7 +1161,7 @@ func (x *GetInvestmentTypesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInvestmentTypesResponse.ProtoReflect.Descriptor instead.
 func (*GetInvestmentTypesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_mana_mana_proto_rawDescGZIP(), []int{14}
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetInvestmentTypesResponse) GetInvestmentTypes() []*InvestmentType {
@@ -992,273 +1171,1875 @@ func (x *GetInvestmentTypesResponse) GetInvestmentTypes() []*InvestmentType {
 	return nil
 }
 
-var File_proto_mana_mana_proto protoreflect.FileDescriptor
+type WithdrawInvestmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proto_mana_mana_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x2f, 0x6d, 0x61, 0x6e,
-	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6d, 0x61, 0x6e, 0x61, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc,
-	0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x6e, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72,
-	0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d,
-	0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x77,
-	0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x74, 0x6f, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x75, 0x0a,
-	0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x69, 0x7a,
-	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x72,
-	0x6f, 0x6d, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f,
-	0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x74, 0x6f, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x4d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x4d, 0x61,
-	0x6e, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64,
-	0x22, 0x32, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x22, 0x74, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x76, 0x0a, 0x18, 0x4c, 0x69,
-	0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d,
-	0x61, 0x6e, 0x61, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x84, 0x02, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
-	0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
-	0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0d, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73,
-	0x12, 0x28, 0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x62, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x69,
-	0x73, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x72, 0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xc2, 0x02, 0x0a, 0x0a, 0x49, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61,
-	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a,
-	0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x75,
-	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0e, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x7c,
-	0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a,
-	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69,
-	0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5a, 0x0a, 0x18,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76,
-	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x49, 0x6e, 0x76,
-	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0x78, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x5d,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x10,
-	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x49, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x69, 0x6e,
-	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x32, 0xf9, 0x03,
-	0x0a, 0x0b, 0x4d, 0x61, 0x6e, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a,
-	0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x12, 0x19, 0x2e,
-	0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x6e,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e,
-	0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e,
-	0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x6e, 0x61,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x10, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d,
-	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65,
-	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x78, 0x2f, 0x6d,
-	0x79, 0x73, 0x74, 0x69, 0x63, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	InvestmentId   int64  `protobuf:"varint,1,opt,name=investment_id,json=investmentId,proto3" json:"investment_id,omitempty"`
+	WizardId       int64  `protobuf:"varint,2,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`                  // Must own the investment
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // Optional; retries with the same key replay the original response
 }
 
-var (
-	file_proto_mana_mana_proto_rawDescOnce sync.Once
-	file_proto_mana_mana_proto_rawDescData = file_proto_mana_mana_proto_rawDesc
-)
+func (x *WithdrawInvestmentRequest) Reset() {
+	*x = WithdrawInvestmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_mana_mana_proto_rawDescGZIP() []byte {
-	file_proto_mana_mana_proto_rawDescOnce.Do(func() {
-		file_proto_mana_mana_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_mana_mana_proto_rawDescData)
-	})
-	return file_proto_mana_mana_proto_rawDescData
+func (x *WithdrawInvestmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_mana_mana_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_proto_mana_mana_proto_goTypes = []any{
-	(*ManaTransaction)(nil),            // 0: mana.ManaTransaction
-	(*TransferManaRequest)(nil),        // 1: mana.TransferManaRequest
-	(*TransferManaResponse)(nil),       // 2: mana.TransferManaResponse
-	(*GetManaBalanceRequest)(nil),      // 3: mana.GetManaBalanceRequest
-	(*GetManaBalanceResponse)(nil),     // 4: mana.GetManaBalanceResponse
-	(*ListTransactionsRequest)(nil),    // 5: mana.ListTransactionsRequest
-	(*ListTransactionsResponse)(nil),   // 6: mana.ListTransactionsResponse
-	(*InvestmentType)(nil),             // 7: mana.InvestmentType
-	(*Investment)(nil),                 // 8: mana.Investment
-	(*CreateInvestmentRequest)(nil),    // 9: mana.CreateInvestmentRequest
-	(*CreateInvestmentResponse)(nil),   // 10: mana.CreateInvestmentResponse
-	(*GetInvestmentsRequest)(nil),      // 11: mana.GetInvestmentsRequest
-	(*GetInvestmentsResponse)(nil),     // 12: mana.GetInvestmentsResponse
-	(*GetInvestmentTypesRequest)(nil),  // 13: mana.GetInvestmentTypesRequest
-	(*GetInvestmentTypesResponse)(nil), // 14: mana.GetInvestmentTypesResponse
-	(*timestamppb.Timestamp)(nil),      // 15: google.protobuf.Timestamp
+func (*WithdrawInvestmentRequest) ProtoMessage() {}
+
+func (x *WithdrawInvestmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_mana_mana_proto_depIdxs = []int32{
-	15, // 0: mana.ManaTransaction.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 1: mana.TransferManaResponse.transaction:type_name -> mana.ManaTransaction
-	0,  // 2: mana.ListTransactionsResponse.transactions:type_name -> mana.ManaTransaction
-	8,  // 3: mana.GetInvestmentsResponse.investments:type_name -> mana.Investment
-	7,  // 4: mana.GetInvestmentTypesResponse.investment_types:type_name -> mana.InvestmentType
-	1,  // 5: mana.ManaService.TransferMana:input_type -> mana.TransferManaRequest
-	3,  // 6: mana.ManaService.GetManaBalance:input_type -> mana.GetManaBalanceRequest
-	5,  // 7: mana.ManaService.ListTransactions:input_type -> mana.ListTransactionsRequest
-	9,  // 8: mana.ManaService.CreateInvestment:input_type -> mana.CreateInvestmentRequest
-	11, // 9: mana.ManaService.GetInvestments:input_type -> mana.GetInvestmentsRequest
-	13, // 10: mana.ManaService.GetInvestmentTypes:input_type -> mana.GetInvestmentTypesRequest
-	2,  // 11: mana.ManaService.TransferMana:output_type -> mana.TransferManaResponse
-	4,  // 12: mana.ManaService.GetManaBalance:output_type -> mana.GetManaBalanceResponse
-	6,  // 13: mana.ManaService.ListTransactions:output_type -> mana.ListTransactionsResponse
-	10, // 14: mana.ManaService.CreateInvestment:output_type -> mana.CreateInvestmentResponse
-	12, // 15: mana.ManaService.GetInvestments:output_type -> mana.GetInvestmentsResponse
-	14, // 16: mana.ManaService.GetInvestmentTypes:output_type -> mana.GetInvestmentTypesResponse
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+
+// Deprecated: Use WithdrawInvestmentRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawInvestmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{16}
 }
 
-func init() { file_proto_mana_mana_proto_init() }
-func file_proto_mana_mana_proto_init() {
-	if File_proto_mana_mana_proto != nil {
-		return
+func (x *WithdrawInvestmentRequest) GetInvestmentId() int64 {
+	if x != nil {
+		return x.InvestmentId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_mana_mana_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*ManaTransaction); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mana_mana_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*TransferManaRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mana_mana_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*TransferManaResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_mana_mana_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GetManaBalanceRequest); i {
+	return 0
+}
+
+func (x *WithdrawInvestmentRequest) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+func (x *WithdrawInvestmentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type WithdrawInvestmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestmentId   int64   `protobuf:"varint,1,opt,name=investment_id,json=investmentId,proto3" json:"investment_id,omitempty"`
+	PenaltyPercent float64 `protobuf:"fixed64,2,opt,name=penalty_percent,json=penaltyPercent,proto3" json:"penalty_percent,omitempty"`
+	PenaltyAmount  int64   `protobuf:"varint,3,opt,name=penalty_amount,json=penaltyAmount,proto3" json:"penalty_amount,omitempty"`
+	ReturnedAmount int64   `protobuf:"varint,4,opt,name=returned_amount,json=returnedAmount,proto3" json:"returned_amount,omitempty"`
+	Status         string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *WithdrawInvestmentResponse) Reset() {
+	*x = WithdrawInvestmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawInvestmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawInvestmentResponse) ProtoMessage() {}
+
+func (x *WithdrawInvestmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawInvestmentResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawInvestmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WithdrawInvestmentResponse) GetInvestmentId() int64 {
+	if x != nil {
+		return x.InvestmentId
+	}
+	return 0
+}
+
+func (x *WithdrawInvestmentResponse) GetPenaltyPercent() float64 {
+	if x != nil {
+		return x.PenaltyPercent
+	}
+	return 0
+}
+
+func (x *WithdrawInvestmentResponse) GetPenaltyAmount() int64 {
+	if x != nil {
+		return x.PenaltyAmount
+	}
+	return 0
+}
+
+func (x *WithdrawInvestmentResponse) GetReturnedAmount() int64 {
+	if x != nil {
+		return x.ReturnedAmount
+	}
+	return 0
+}
+
+func (x *WithdrawInvestmentResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetPortfolioSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WizardId int64 `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+}
+
+func (x *GetPortfolioSummaryRequest) Reset() {
+	*x = GetPortfolioSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPortfolioSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioSummaryRequest) ProtoMessage() {}
+
+func (x *GetPortfolioSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPortfolioSummaryRequest) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+// RiskLevelSummary covers the closed investments of one risk level
+type RiskLevelSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RiskLevel         int32   `protobuf:"varint,1,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	ClosedCount       int32   `protobuf:"varint,2,opt,name=closed_count,json=closedCount,proto3" json:"closed_count,omitempty"`
+	AverageReturnRate float64 `protobuf:"fixed64,3,opt,name=average_return_rate,json=averageReturnRate,proto3" json:"average_return_rate,omitempty"`
+}
+
+func (x *RiskLevelSummary) Reset() {
+	*x = RiskLevelSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RiskLevelSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskLevelSummary) ProtoMessage() {}
+
+func (x *RiskLevelSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskLevelSummary.ProtoReflect.Descriptor instead.
+func (*RiskLevelSummary) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RiskLevelSummary) GetRiskLevel() int32 {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return 0
+}
+
+func (x *RiskLevelSummary) GetClosedCount() int32 {
+	if x != nil {
+		return x.ClosedCount
+	}
+	return 0
+}
+
+func (x *RiskLevelSummary) GetAverageReturnRate() float64 {
+	if x != nil {
+		return x.AverageReturnRate
+	}
+	return 0
+}
+
+// PortfolioProjection is the expected outcome of the open investments at maturity,
+// from their types' base return rates and the variance of each risk level
+type PortfolioProjection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedValue   int64   `protobuf:"varint,1,opt,name=expected_value,json=expectedValue,proto3" json:"expected_value,omitempty"`
+	ExpectedProfit  int64   `protobuf:"varint,2,opt,name=expected_profit,json=expectedProfit,proto3" json:"expected_profit,omitempty"`
+	ProfitStdDev    float64 `protobuf:"fixed64,3,opt,name=profit_std_dev,json=profitStdDev,proto3" json:"profit_std_dev,omitempty"`
+	WorstCaseProfit int64   `protobuf:"varint,4,opt,name=worst_case_profit,json=worstCaseProfit,proto3" json:"worst_case_profit,omitempty"`
+	BestCaseProfit  int64   `protobuf:"varint,5,opt,name=best_case_profit,json=bestCaseProfit,proto3" json:"best_case_profit,omitempty"`
+}
+
+func (x *PortfolioProjection) Reset() {
+	*x = PortfolioProjection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortfolioProjection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioProjection) ProtoMessage() {}
+
+func (x *PortfolioProjection) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioProjection.ProtoReflect.Descriptor instead.
+func (*PortfolioProjection) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PortfolioProjection) GetExpectedValue() int64 {
+	if x != nil {
+		return x.ExpectedValue
+	}
+	return 0
+}
+
+func (x *PortfolioProjection) GetExpectedProfit() int64 {
+	if x != nil {
+		return x.ExpectedProfit
+	}
+	return 0
+}
+
+func (x *PortfolioProjection) GetProfitStdDev() float64 {
+	if x != nil {
+		return x.ProfitStdDev
+	}
+	return 0
+}
+
+func (x *PortfolioProjection) GetWorstCaseProfit() int64 {
+	if x != nil {
+		return x.WorstCaseProfit
+	}
+	return 0
+}
+
+func (x *PortfolioProjection) GetBestCaseProfit() int64 {
+	if x != nil {
+		return x.BestCaseProfit
+	}
+	return 0
+}
+
+type GetPortfolioSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WizardId      int64                `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	TotalInvested int64                `protobuf:"varint,2,opt,name=total_invested,json=totalInvested,proto3" json:"total_invested,omitempty"` // Principal of every funded investment
+	OpenPrincipal int64                `protobuf:"varint,3,opt,name=open_principal,json=openPrincipal,proto3" json:"open_principal,omitempty"`
+	OpenCount     int32                `protobuf:"varint,4,opt,name=open_count,json=openCount,proto3" json:"open_count,omitempty"`
+	ClosedCount   int32                `protobuf:"varint,5,opt,name=closed_count,json=closedCount,proto3" json:"closed_count,omitempty"`
+	RealizedPnl   int64                `protobuf:"varint,6,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`       // Returned minus principal over closed investments
+	UnrealizedPnl int64                `protobuf:"varint,7,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"` // Expected profit accrued so far on open investments
+	Wins          int32                `protobuf:"varint,8,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses        int32                `protobuf:"varint,9,opt,name=losses,proto3" json:"losses,omitempty"`
+	RiskLevels    []*RiskLevelSummary  `protobuf:"bytes,10,rep,name=risk_levels,json=riskLevels,proto3" json:"risk_levels,omitempty"`
+	Projection    *PortfolioProjection `protobuf:"bytes,11,opt,name=projection,proto3" json:"projection,omitempty"`
+}
+
+func (x *GetPortfolioSummaryResponse) Reset() {
+	*x = GetPortfolioSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPortfolioSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioSummaryResponse) ProtoMessage() {}
+
+func (x *GetPortfolioSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPortfolioSummaryResponse) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+func (x *GetPortfolioSummaryResponse) GetTotalInvested() int64 {
+	if x != nil {
+		return x.TotalInvested
+	}
+	return 0
+}
+
+func (x *GetPortfolioSummaryResponse) GetOpenPrincipal() int64 {
+	if x != nil {
+		return x.OpenPrincipal
+	}
+	return 0
+}
+
+func (x *GetPortfolioSummaryResponse) GetOpenCount() int32 {
+	if x != nil {
+		return x.OpenCount
+	}
+	return 0
+}
+
+func (x *GetPortfolioSummaryResponse) GetClosedCount() int32 {
+	if x != nil {
+		return x.ClosedCount
+	}
+	return 0
+}
+
+func (x *GetPortfolioSummaryResponse) GetRealizedPnl() int64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *GetPortfolioSummaryResponse) GetUnrealizedPnl() int64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *GetPortfolioSummaryResponse) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *GetPortfolioSummaryResponse) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *GetPortfolioSummaryResponse) GetRiskLevels() []*RiskLevelSummary {
+	if x != nil {
+		return x.RiskLevels
+	}
+	return nil
+}
+
+func (x *GetPortfolioSummaryResponse) GetProjection() *PortfolioProjection {
+	if x != nil {
+		return x.Projection
+	}
+	return nil
+}
+
+// SetInvestmentRolloverRequest turns auto-rollover on or off for an investment
+// that has not matured yet
+type SetInvestmentRolloverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestmentId int64 `protobuf:"varint,1,opt,name=investment_id,json=investmentId,proto3" json:"investment_id,omitempty"`
+	WizardId     int64 `protobuf:"varint,2,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"` // Must own the investment
+	AutoRollover bool  `protobuf:"varint,3,opt,name=auto_rollover,json=autoRollover,proto3" json:"auto_rollover,omitempty"`
+}
+
+func (x *SetInvestmentRolloverRequest) Reset() {
+	*x = SetInvestmentRolloverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInvestmentRolloverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInvestmentRolloverRequest) ProtoMessage() {}
+
+func (x *SetInvestmentRolloverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInvestmentRolloverRequest.ProtoReflect.Descriptor instead.
+func (*SetInvestmentRolloverRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetInvestmentRolloverRequest) GetInvestmentId() int64 {
+	if x != nil {
+		return x.InvestmentId
+	}
+	return 0
+}
+
+func (x *SetInvestmentRolloverRequest) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+func (x *SetInvestmentRolloverRequest) GetAutoRollover() bool {
+	if x != nil {
+		return x.AutoRollover
+	}
+	return false
+}
+
+type SetInvestmentRolloverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestmentId       int64 `protobuf:"varint,1,opt,name=investment_id,json=investmentId,proto3" json:"investment_id,omitempty"`
+	AutoRollover       bool  `protobuf:"varint,2,opt,name=auto_rollover,json=autoRollover,proto3" json:"auto_rollover,omitempty"`
+	RolloversRemaining int32 `protobuf:"varint,3,opt,name=rollovers_remaining,json=rolloversRemaining,proto3" json:"rollovers_remaining,omitempty"`
+}
+
+func (x *SetInvestmentRolloverResponse) Reset() {
+	*x = SetInvestmentRolloverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInvestmentRolloverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInvestmentRolloverResponse) ProtoMessage() {}
+
+func (x *SetInvestmentRolloverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInvestmentRolloverResponse.ProtoReflect.Descriptor instead.
+func (*SetInvestmentRolloverResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetInvestmentRolloverResponse) GetInvestmentId() int64 {
+	if x != nil {
+		return x.InvestmentId
+	}
+	return 0
+}
+
+func (x *SetInvestmentRolloverResponse) GetAutoRollover() bool {
+	if x != nil {
+		return x.AutoRollover
+	}
+	return false
+}
+
+func (x *SetInvestmentRolloverResponse) GetRolloversRemaining() int32 {
+	if x != nil {
+		return x.RolloversRemaining
+	}
+	return 0
+}
+
+// MarketPrice is the price of an investment type's mystic market at one tick.
+// An investment returns the market's move between the ticks it started and
+// matured in.
+type MarketPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TickAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=tick_at,json=tickAt,proto3" json:"tick_at,omitempty"`
+	Price  float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Regime string                 `protobuf:"bytes,3,opt,name=regime,proto3" json:"regime,omitempty"` // calm, bull or bear
+}
+
+func (x *MarketPrice) Reset() {
+	*x = MarketPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketPrice) ProtoMessage() {}
+
+func (x *MarketPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketPrice.ProtoReflect.Descriptor instead.
+func (*MarketPrice) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MarketPrice) GetTickAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TickAt
+	}
+	return nil
+}
+
+func (x *MarketPrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *MarketPrice) GetRegime() string {
+	if x != nil {
+		return x.Regime
+	}
+	return ""
+}
+
+type GetMarketHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestmentTypeId int64 `protobuf:"varint,1,opt,name=investment_type_id,json=investmentTypeId,proto3" json:"investment_type_id,omitempty"`
+	Limit            int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Number of latest ticks to return; defaults to 168, at most 1000
+}
+
+func (x *GetMarketHistoryRequest) Reset() {
+	*x = GetMarketHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketHistoryRequest) ProtoMessage() {}
+
+func (x *GetMarketHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetMarketHistoryRequest) GetInvestmentTypeId() int64 {
+	if x != nil {
+		return x.InvestmentTypeId
+	}
+	return 0
+}
+
+func (x *GetMarketHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetMarketHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestmentTypeId int64          `protobuf:"varint,1,opt,name=investment_type_id,json=investmentTypeId,proto3" json:"investment_type_id,omitempty"`
+	Regime           string         `protobuf:"bytes,2,opt,name=regime,proto3" json:"regime,omitempty"` // Current regime
+	Prices           []*MarketPrice `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"` // Oldest first
+}
+
+func (x *GetMarketHistoryResponse) Reset() {
+	*x = GetMarketHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketHistoryResponse) ProtoMessage() {}
+
+func (x *GetMarketHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetMarketHistoryResponse) GetInvestmentTypeId() int64 {
+	if x != nil {
+		return x.InvestmentTypeId
+	}
+	return 0
+}
+
+func (x *GetMarketHistoryResponse) GetRegime() string {
+	if x != nil {
+		return x.Regime
+	}
+	return ""
+}
+
+func (x *GetMarketHistoryResponse) GetPrices() []*MarketPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// InvestmentTypeTerms are the admin-editable terms of an investment type.
+// max_amount 0 means no upper limit.
+type InvestmentTypeTerms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name               string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string              `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	MinAmount          int64               `protobuf:"varint,3,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount          int64               `protobuf:"varint,4,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	DurationHours      int32               `protobuf:"varint,5,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	BaseReturnRate     float64             `protobuf:"fixed64,6,opt,name=base_return_rate,json=baseReturnRate,proto3" json:"base_return_rate,omitempty"`
+	RiskLevel          int32               `protobuf:"varint,7,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	EarlyExitPenalties []*EarlyExitPenalty `protobuf:"bytes,8,rep,name=early_exit_penalties,json=earlyExitPenalties,proto3" json:"early_exit_penalties,omitempty"`
+}
+
+func (x *InvestmentTypeTerms) Reset() {
+	*x = InvestmentTypeTerms{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvestmentTypeTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvestmentTypeTerms) ProtoMessage() {}
+
+func (x *InvestmentTypeTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvestmentTypeTerms.ProtoReflect.Descriptor instead.
+func (*InvestmentTypeTerms) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *InvestmentTypeTerms) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InvestmentTypeTerms) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InvestmentTypeTerms) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *InvestmentTypeTerms) GetMaxAmount() int64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *InvestmentTypeTerms) GetDurationHours() int32 {
+	if x != nil {
+		return x.DurationHours
+	}
+	return 0
+}
+
+func (x *InvestmentTypeTerms) GetBaseReturnRate() float64 {
+	if x != nil {
+		return x.BaseReturnRate
+	}
+	return 0
+}
+
+func (x *InvestmentTypeTerms) GetRiskLevel() int32 {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return 0
+}
+
+func (x *InvestmentTypeTerms) GetEarlyExitPenalties() []*EarlyExitPenalty {
+	if x != nil {
+		return x.EarlyExitPenalties
+	}
+	return nil
+}
+
+type CreateInvestmentTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Terms     *InvestmentTypeTerms `protobuf:"bytes,1,opt,name=terms,proto3" json:"terms,omitempty"`
+	ChangedBy string               `protobuf:"bytes,2,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"` // Who made the change, for the audit log
+	Reason    string               `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CreateInvestmentTypeRequest) Reset() {
+	*x = CreateInvestmentTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInvestmentTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvestmentTypeRequest) ProtoMessage() {}
+
+func (x *CreateInvestmentTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvestmentTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvestmentTypeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateInvestmentTypeRequest) GetTerms() *InvestmentTypeTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *CreateInvestmentTypeRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *CreateInvestmentTypeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// UpdateInvestmentTypeRequest replaces the terms of a type with a new version.
+// Existing investments keep the version they were opened under.
+type UpdateInvestmentTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestmentTypeId int64                `protobuf:"varint,1,opt,name=investment_type_id,json=investmentTypeId,proto3" json:"investment_type_id,omitempty"`
+	Terms            *InvestmentTypeTerms `protobuf:"bytes,2,opt,name=terms,proto3" json:"terms,omitempty"`
+	ChangedBy        string               `protobuf:"bytes,3,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	Reason           string               `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *UpdateInvestmentTypeRequest) Reset() {
+	*x = UpdateInvestmentTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateInvestmentTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInvestmentTypeRequest) ProtoMessage() {}
+
+func (x *UpdateInvestmentTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInvestmentTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInvestmentTypeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateInvestmentTypeRequest) GetInvestmentTypeId() int64 {
+	if x != nil {
+		return x.InvestmentTypeId
+	}
+	return 0
+}
+
+func (x *UpdateInvestmentTypeRequest) GetTerms() *InvestmentTypeTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *UpdateInvestmentTypeRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *UpdateInvestmentTypeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetInvestmentTypeActiveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestmentTypeId int64  `protobuf:"varint,1,opt,name=investment_type_id,json=investmentTypeId,proto3" json:"investment_type_id,omitempty"`
+	ChangedBy        string `protobuf:"bytes,2,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	Reason           string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SetInvestmentTypeActiveRequest) Reset() {
+	*x = SetInvestmentTypeActiveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInvestmentTypeActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInvestmentTypeActiveRequest) ProtoMessage() {}
+
+func (x *SetInvestmentTypeActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInvestmentTypeActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetInvestmentTypeActiveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetInvestmentTypeActiveRequest) GetInvestmentTypeId() int64 {
+	if x != nil {
+		return x.InvestmentTypeId
+	}
+	return 0
+}
+
+func (x *SetInvestmentTypeActiveRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *SetInvestmentTypeActiveRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetInvestmentTypeHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvestmentTypeId int64 `protobuf:"varint,1,opt,name=investment_type_id,json=investmentTypeId,proto3" json:"investment_type_id,omitempty"`
+}
+
+func (x *GetInvestmentTypeHistoryRequest) Reset() {
+	*x = GetInvestmentTypeHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvestmentTypeHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestmentTypeHistoryRequest) ProtoMessage() {}
+
+func (x *GetInvestmentTypeHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestmentTypeHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetInvestmentTypeHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetInvestmentTypeHistoryRequest) GetInvestmentTypeId() int64 {
+	if x != nil {
+		return x.InvestmentTypeId
+	}
+	return 0
+}
+
+// InvestmentTypeChange is one audit log entry with the terms in effect after it
+type InvestmentTypeChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version   int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Action    string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // created, updated, activated or deactivated
+	ChangedBy string                 `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	Reason    string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Terms     *InvestmentTypeTerms   `protobuf:"bytes,6,opt,name=terms,proto3" json:"terms,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *InvestmentTypeChange) Reset() {
+	*x = InvestmentTypeChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvestmentTypeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvestmentTypeChange) ProtoMessage() {}
+
+func (x *InvestmentTypeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvestmentTypeChange.ProtoReflect.Descriptor instead.
+func (*InvestmentTypeChange) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InvestmentTypeChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InvestmentTypeChange) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *InvestmentTypeChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *InvestmentTypeChange) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *InvestmentTypeChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *InvestmentTypeChange) GetTerms() *InvestmentTypeTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *InvestmentTypeChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetInvestmentTypeHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*InvestmentTypeChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *GetInvestmentTypeHistoryResponse) Reset() {
+	*x = GetInvestmentTypeHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_mana_mana_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvestmentTypeHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestmentTypeHistoryResponse) ProtoMessage() {}
+
+func (x *GetInvestmentTypeHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mana_mana_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestmentTypeHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetInvestmentTypeHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mana_mana_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetInvestmentTypeHistoryResponse) GetChanges() []*InvestmentTypeChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+var File_proto_mana_mana_proto protoreflect.FileDescriptor
+
+var file_proto_mana_mana_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x2f, 0x6d, 0x61, 0x6e,
+	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6d, 0x61, 0x6e, 0x61, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc,
+	0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x6e, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d,
+	0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x6f, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9e, 0x01,
+	0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66,
+	0x72, 0x6f, 0x6d, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74,
+	0x6f, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x74, 0x6f, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x83,
+	0x01, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x74,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x22, 0x76, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x4d, 0x61,
+	0x6e, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x85, 0x03, 0x0a,
+	0x0e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x69, 0x73, 0x6b, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x48, 0x0a, 0x14, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x65, 0x78, 0x69,
+	0x74, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x45, 0x61, 0x72, 0x6c, 0x79, 0x45, 0x78,
+	0x69, 0x74, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x52, 0x12, 0x65, 0x61, 0x72, 0x6c, 0x79,
+	0x45, 0x78, 0x69, 0x74, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x22, 0x60, 0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x79, 0x45, 0x78, 0x69,
+	0x74, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x61, 0x66, 0x74, 0x65, 0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xb5, 0x04, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x10, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x69, 0x73, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x72, 0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74,
+	0x6f, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x73,
+	0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x12, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x6f,
+	0x76, 0x65, 0x72, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x94,
+	0x02, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61,
+	0x75, 0x74, 0x6f, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x76, 0x65, 0x72, 0x73, 0x22, 0x5a, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x4c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x4c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xa3, 0x01,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x69, 0x73,
+	0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72,
+	0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x22, 0x5d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61,
+	0x6e, 0x61, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x19, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0xd2, 0x01, 0x0a, 0x1a,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x39, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x10,
+	0x52, 0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x11, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x61,
+	0x74, 0x65, 0x22, 0xe1, 0x01, 0x0a, 0x13, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x53, 0x74, 0x64, 0x44, 0x65, 0x76,
+	0x12, 0x2a, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x77, 0x6f, 0x72,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10,
+	0x62, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x22, 0xb4, 0x03, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72,
+	0x64, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70,
+	0x65, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x70, 0x6e, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x69, 0x73,
+	0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0a, 0x72, 0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01,
+	0x0a, 0x1c, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x76, 0x65, 0x72, 0x22, 0x9a, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x65, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65,
+	0x72, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x5f, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x22, 0x70, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06,
+	0x74, 0x69, 0x63, 0x6b, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x22, 0xc3, 0x02, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x72, 0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x48, 0x0a,
+	0x14, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61,
+	0x6e, 0x61, 0x2e, 0x45, 0x61, 0x72, 0x6c, 0x79, 0x45, 0x78, 0x69, 0x74, 0x50, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x52, 0x12, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x45, 0x78, 0x69, 0x74, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x54, 0x65, 0x72, 0x6d,
+	0x73, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0xb3, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a,
+	0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d,
+	0x61, 0x6e, 0x61, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4f, 0x0a,
+	0x1f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x22, 0xfb,
+	0x01, 0x0a, 0x14, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x2f, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x58, 0x0a, 0x20,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x32, 0xb0, 0x0a, 0x0a, 0x0b, 0x4d, 0x61, 0x6e, 0x61, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x4d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x6e,
+	0x61, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1f, 0x2e,
+	0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x59, 0x0a, 0x12, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x6e, 0x61,
+	0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x15, 0x53,
+	0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x76, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e,
+	0x53, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x2e, 0x6d,
+	0x61, 0x6e, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x21, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x16, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x49,
+	0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6d, 0x61, 0x6e,
+	0x61, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x00, 0x12, 0x58, 0x0a, 0x18, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24,
+	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x49, 0x6e, 0x76, 0x65,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x78, 0x2f, 0x6d,
+	0x79, 0x73, 0x74, 0x69, 0x63, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_mana_mana_proto_rawDescOnce sync.Once
+	file_proto_mana_mana_proto_rawDescData = file_proto_mana_mana_proto_rawDesc
+)
+
+func file_proto_mana_mana_proto_rawDescGZIP() []byte {
+	file_proto_mana_mana_proto_rawDescOnce.Do(func() {
+		file_proto_mana_mana_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_mana_mana_proto_rawDescData)
+	})
+	return file_proto_mana_mana_proto_rawDescData
+}
+
+var file_proto_mana_mana_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_proto_mana_mana_proto_goTypes = []any{
+	(*ManaTransaction)(nil),                  // 0: mana.ManaTransaction
+	(*TransferManaRequest)(nil),              // 1: mana.TransferManaRequest
+	(*TransferManaResponse)(nil),             // 2: mana.TransferManaResponse
+	(*GetManaBalanceRequest)(nil),            // 3: mana.GetManaBalanceRequest
+	(*GetManaBalanceResponse)(nil),           // 4: mana.GetManaBalanceResponse
+	(*ListTransactionsRequest)(nil),          // 5: mana.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),         // 6: mana.ListTransactionsResponse
+	(*InvestmentType)(nil),                   // 7: mana.InvestmentType
+	(*EarlyExitPenalty)(nil),                 // 8: mana.EarlyExitPenalty
+	(*Investment)(nil),                       // 9: mana.Investment
+	(*CreateInvestmentRequest)(nil),          // 10: mana.CreateInvestmentRequest
+	(*CreateInvestmentResponse)(nil),         // 11: mana.CreateInvestmentResponse
+	(*GetInvestmentsRequest)(nil),            // 12: mana.GetInvestmentsRequest
+	(*GetInvestmentsResponse)(nil),           // 13: mana.GetInvestmentsResponse
+	(*GetInvestmentTypesRequest)(nil),        // 14: mana.GetInvestmentTypesRequest
+	(*GetInvestmentTypesResponse)(nil),       // 15: mana.GetInvestmentTypesResponse
+	(*WithdrawInvestmentRequest)(nil),        // 16: mana.WithdrawInvestmentRequest
+	(*WithdrawInvestmentResponse)(nil),       // 17: mana.WithdrawInvestmentResponse
+	(*GetPortfolioSummaryRequest)(nil),       // 18: mana.GetPortfolioSummaryRequest
+	(*RiskLevelSummary)(nil),                 // 19: mana.RiskLevelSummary
+	(*PortfolioProjection)(nil),              // 20: mana.PortfolioProjection
+	(*GetPortfolioSummaryResponse)(nil),      // 21: mana.GetPortfolioSummaryResponse
+	(*SetInvestmentRolloverRequest)(nil),     // 22: mana.SetInvestmentRolloverRequest
+	(*SetInvestmentRolloverResponse)(nil),    // 23: mana.SetInvestmentRolloverResponse
+	(*MarketPrice)(nil),                      // 24: mana.MarketPrice
+	(*GetMarketHistoryRequest)(nil),          // 25: mana.GetMarketHistoryRequest
+	(*GetMarketHistoryResponse)(nil),         // 26: mana.GetMarketHistoryResponse
+	(*InvestmentTypeTerms)(nil),              // 27: mana.InvestmentTypeTerms
+	(*CreateInvestmentTypeRequest)(nil),      // 28: mana.CreateInvestmentTypeRequest
+	(*UpdateInvestmentTypeRequest)(nil),      // 29: mana.UpdateInvestmentTypeRequest
+	(*SetInvestmentTypeActiveRequest)(nil),   // 30: mana.SetInvestmentTypeActiveRequest
+	(*GetInvestmentTypeHistoryRequest)(nil),  // 31: mana.GetInvestmentTypeHistoryRequest
+	(*InvestmentTypeChange)(nil),             // 32: mana.InvestmentTypeChange
+	(*GetInvestmentTypeHistoryResponse)(nil), // 33: mana.GetInvestmentTypeHistoryResponse
+	(*timestamppb.Timestamp)(nil),            // 34: google.protobuf.Timestamp
+}
+var file_proto_mana_mana_proto_depIdxs = []int32{
+	34, // 0: mana.ManaTransaction.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: mana.TransferManaResponse.transaction:type_name -> mana.ManaTransaction
+	0,  // 2: mana.ListTransactionsResponse.transactions:type_name -> mana.ManaTransaction
+	8,  // 3: mana.InvestmentType.early_exit_penalties:type_name -> mana.EarlyExitPenalty
+	9,  // 4: mana.GetInvestmentsResponse.investments:type_name -> mana.Investment
+	7,  // 5: mana.GetInvestmentTypesResponse.investment_types:type_name -> mana.InvestmentType
+	19, // 6: mana.GetPortfolioSummaryResponse.risk_levels:type_name -> mana.RiskLevelSummary
+	20, // 7: mana.GetPortfolioSummaryResponse.projection:type_name -> mana.PortfolioProjection
+	34, // 8: mana.MarketPrice.tick_at:type_name -> google.protobuf.Timestamp
+	24, // 9: mana.GetMarketHistoryResponse.prices:type_name -> mana.MarketPrice
+	8,  // 10: mana.InvestmentTypeTerms.early_exit_penalties:type_name -> mana.EarlyExitPenalty
+	27, // 11: mana.CreateInvestmentTypeRequest.terms:type_name -> mana.InvestmentTypeTerms
+	27, // 12: mana.UpdateInvestmentTypeRequest.terms:type_name -> mana.InvestmentTypeTerms
+	27, // 13: mana.InvestmentTypeChange.terms:type_name -> mana.InvestmentTypeTerms
+	34, // 14: mana.InvestmentTypeChange.created_at:type_name -> google.protobuf.Timestamp
+	32, // 15: mana.GetInvestmentTypeHistoryResponse.changes:type_name -> mana.InvestmentTypeChange
+	1,  // 16: mana.ManaService.TransferMana:input_type -> mana.TransferManaRequest
+	3,  // 17: mana.ManaService.GetManaBalance:input_type -> mana.GetManaBalanceRequest
+	5,  // 18: mana.ManaService.ListTransactions:input_type -> mana.ListTransactionsRequest
+	10, // 19: mana.ManaService.CreateInvestment:input_type -> mana.CreateInvestmentRequest
+	12, // 20: mana.ManaService.GetInvestments:input_type -> mana.GetInvestmentsRequest
+	14, // 21: mana.ManaService.GetInvestmentTypes:input_type -> mana.GetInvestmentTypesRequest
+	16, // 22: mana.ManaService.WithdrawInvestment:input_type -> mana.WithdrawInvestmentRequest
+	18, // 23: mana.ManaService.GetPortfolioSummary:input_type -> mana.GetPortfolioSummaryRequest
+	22, // 24: mana.ManaService.SetInvestmentRollover:input_type -> mana.SetInvestmentRolloverRequest
+	25, // 25: mana.ManaService.GetMarketHistory:input_type -> mana.GetMarketHistoryRequest
+	28, // 26: mana.ManaService.CreateInvestmentType:input_type -> mana.CreateInvestmentTypeRequest
+	29, // 27: mana.ManaService.UpdateInvestmentType:input_type -> mana.UpdateInvestmentTypeRequest
+	30, // 28: mana.ManaService.ActivateInvestmentType:input_type -> mana.SetInvestmentTypeActiveRequest
+	30, // 29: mana.ManaService.DeactivateInvestmentType:input_type -> mana.SetInvestmentTypeActiveRequest
+	31, // 30: mana.ManaService.GetInvestmentTypeHistory:input_type -> mana.GetInvestmentTypeHistoryRequest
+	2,  // 31: mana.ManaService.TransferMana:output_type -> mana.TransferManaResponse
+	4,  // 32: mana.ManaService.GetManaBalance:output_type -> mana.GetManaBalanceResponse
+	6,  // 33: mana.ManaService.ListTransactions:output_type -> mana.ListTransactionsResponse
+	11, // 34: mana.ManaService.CreateInvestment:output_type -> mana.CreateInvestmentResponse
+	13, // 35: mana.ManaService.GetInvestments:output_type -> mana.GetInvestmentsResponse
+	15, // 36: mana.ManaService.GetInvestmentTypes:output_type -> mana.GetInvestmentTypesResponse
+	17, // 37: mana.ManaService.WithdrawInvestment:output_type -> mana.WithdrawInvestmentResponse
+	21, // 38: mana.ManaService.GetPortfolioSummary:output_type -> mana.GetPortfolioSummaryResponse
+	23, // 39: mana.ManaService.SetInvestmentRollover:output_type -> mana.SetInvestmentRolloverResponse
+	26, // 40: mana.ManaService.GetMarketHistory:output_type -> mana.GetMarketHistoryResponse
+	7,  // 41: mana.ManaService.CreateInvestmentType:output_type -> mana.InvestmentType
+	7,  // 42: mana.ManaService.UpdateInvestmentType:output_type -> mana.InvestmentType
+	7,  // 43: mana.ManaService.ActivateInvestmentType:output_type -> mana.InvestmentType
+	7,  // 44: mana.ManaService.DeactivateInvestmentType:output_type -> mana.InvestmentType
+	33, // 45: mana.ManaService.GetInvestmentTypeHistory:output_type -> mana.GetInvestmentTypeHistoryResponse
+	31, // [31:46] is the sub-list for method output_type
+	16, // [16:31] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_proto_mana_mana_proto_init() }
+func file_proto_mana_mana_proto_init() {
+	if File_proto_mana_mana_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_mana_mana_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ManaTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*TransferManaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*TransferManaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetManaBalanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1318,7 +3099,7 @@ func file_proto_mana_mana_proto_init() {
 			}
 		}
 		file_proto_mana_mana_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*Investment); i {
+			switch v := v.(*EarlyExitPenalty); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1330,7 +3111,7 @@ func file_proto_mana_mana_proto_init() {
 			}
 		}
 		file_proto_mana_mana_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateInvestmentRequest); i {
+			switch v := v.(*Investment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1342,7 +3123,7 @@ func file_proto_mana_mana_proto_init() {
 			}
 		}
 		file_proto_mana_mana_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateInvestmentResponse); i {
+			switch v := v.(*CreateInvestmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1354,7 +3135,7 @@ func file_proto_mana_mana_proto_init() {
 			}
 		}
 		file_proto_mana_mana_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*GetInvestmentsRequest); i {
+			switch v := v.(*CreateInvestmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1366,7 +3147,7 @@ func file_proto_mana_mana_proto_init() {
 			}
 		}
 		file_proto_mana_mana_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*GetInvestmentsResponse); i {
+			switch v := v.(*GetInvestmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1378,7 +3159,7 @@ func file_proto_mana_mana_proto_init() {
 			}
 		}
 		file_proto_mana_mana_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*GetInvestmentTypesRequest); i {
+			switch v := v.(*GetInvestmentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1390,6 +3171,18 @@ func file_proto_mana_mana_proto_init() {
 			}
 		}
 		file_proto_mana_mana_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*GetInvestmentTypesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[15].Exporter = func(v any, i int) any {
 			switch v := v.(*GetInvestmentTypesResponse); i {
 			case 0:
 				return &v.state
@@ -1401,6 +3194,222 @@ func file_proto_mana_mana_proto_init() {
 				return nil
 			}
 		}
+		file_proto_mana_mana_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*WithdrawInvestmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*WithdrawInvestmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPortfolioSummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*RiskLevelSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*PortfolioProjection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPortfolioSummaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*SetInvestmentRolloverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*SetInvestmentRolloverResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*MarketPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMarketHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMarketHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*InvestmentTypeTerms); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateInvestmentTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateInvestmentTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*SetInvestmentTypeActiveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetInvestmentTypeHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*InvestmentTypeChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_mana_mana_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*GetInvestmentTypeHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1408,7 +3417,7 @@ func file_proto_mana_mana_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_mana_mana_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ManaService_TransferMana_FullMethodName       = "/mana.ManaService/TransferMana"
-	ManaService_GetManaBalance_FullMethodName     = "/mana.ManaService/GetManaBalance"
-	ManaService_ListTransactions_FullMethodName   = "/mana.ManaService/ListTransactions"
-	ManaService_CreateInvestment_FullMethodName   = "/mana.ManaService/CreateInvestment"
-	ManaService_GetInvestments_FullMethodName     = "/mana.ManaService/GetInvestments"
-	ManaService_GetInvestmentTypes_FullMethodName = "/mana.ManaService/GetInvestmentTypes"
+	ManaService_TransferMana_FullMethodName             = "/mana.ManaService/TransferMana"
+	ManaService_GetManaBalance_FullMethodName           = "/mana.ManaService/GetManaBalance"
+	ManaService_ListTransactions_FullMethodName         = "/mana.ManaService/ListTransactions"
+	ManaService_CreateInvestment_FullMethodName         = "/mana.ManaService/CreateInvestment"
+	ManaService_GetInvestments_FullMethodName           = "/mana.ManaService/GetInvestments"
+	ManaService_GetInvestmentTypes_FullMethodName       = "/mana.ManaService/GetInvestmentTypes"
+	ManaService_WithdrawInvestment_FullMethodName       = "/mana.ManaService/WithdrawInvestment"
+	ManaService_GetPortfolioSummary_FullMethodName      = "/mana.ManaService/GetPortfolioSummary"
+	ManaService_SetInvestmentRollover_FullMethodName    = "/mana.ManaService/SetInvestmentRollover"
+	ManaService_GetMarketHistory_FullMethodName         = "/mana.ManaService/GetMarketHistory"
+	ManaService_CreateInvestmentType_FullMethodName     = "/mana.ManaService/CreateInvestmentType"
+	ManaService_UpdateInvestmentType_FullMethodName     = "/mana.ManaService/UpdateInvestmentType"
+	ManaService_ActivateInvestmentType_FullMethodName   = "/mana.ManaService/ActivateInvestmentType"
+	ManaService_DeactivateInvestmentType_FullMethodName = "/mana.ManaService/DeactivateInvestmentType"
+	ManaService_GetInvestmentTypeHistory_FullMethodName = "/mana.ManaService/GetInvestmentTypeHistory"
 )
 
 // ManaServiceClient is the client API for ManaService service.
@@ -37,6 +46,16 @@ type ManaServiceClient interface {
 	CreateInvestment(ctx context.Context, in *CreateInvestmentRequest, opts ...grpc.CallOption) (*CreateInvestmentResponse, error)
 	GetInvestments(ctx context.Context, in *GetInvestmentsRequest, opts ...grpc.CallOption) (*GetInvestmentsResponse, error)
 	GetInvestmentTypes(ctx context.Context, in *GetInvestmentTypesRequest, opts ...grpc.CallOption) (*GetInvestmentTypesResponse, error)
+	WithdrawInvestment(ctx context.Context, in *WithdrawInvestmentRequest, opts ...grpc.CallOption) (*WithdrawInvestmentResponse, error)
+	GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error)
+	SetInvestmentRollover(ctx context.Context, in *SetInvestmentRolloverRequest, opts ...grpc.CallOption) (*SetInvestmentRolloverResponse, error)
+	GetMarketHistory(ctx context.Context, in *GetMarketHistoryRequest, opts ...grpc.CallOption) (*GetMarketHistoryResponse, error)
+	// Investment type administration
+	CreateInvestmentType(ctx context.Context, in *CreateInvestmentTypeRequest, opts ...grpc.CallOption) (*InvestmentType, error)
+	UpdateInvestmentType(ctx context.Context, in *UpdateInvestmentTypeRequest, opts ...grpc.CallOption) (*InvestmentType, error)
+	ActivateInvestmentType(ctx context.Context, in *SetInvestmentTypeActiveRequest, opts ...grpc.CallOption) (*InvestmentType, error)
+	DeactivateInvestmentType(ctx context.Context, in *SetInvestmentTypeActiveRequest, opts ...grpc.CallOption) (*InvestmentType, error)
+	GetInvestmentTypeHistory(ctx context.Context, in *GetInvestmentTypeHistoryRequest, opts ...grpc.CallOption) (*GetInvestmentTypeHistoryResponse, error)
 }
 
 type manaServiceClient struct {
@@ -107,6 +126,96 @@ func (c *manaServiceClient) GetInvestmentTypes(ctx context.Context, in *GetInves
 	return out, nil
 }
 
+func (c *manaServiceClient) WithdrawInvestment(ctx context.Context, in *WithdrawInvestmentRequest, opts ...grpc.CallOption) (*WithdrawInvestmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawInvestmentResponse)
+	err := c.cc.Invoke(ctx, ManaService_WithdrawInvestment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manaServiceClient) GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortfolioSummaryResponse)
+	err := c.cc.Invoke(ctx, ManaService_GetPortfolioSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manaServiceClient) SetInvestmentRollover(ctx context.Context, in *SetInvestmentRolloverRequest, opts ...grpc.CallOption) (*SetInvestmentRolloverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetInvestmentRolloverResponse)
+	err := c.cc.Invoke(ctx, ManaService_SetInvestmentRollover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manaServiceClient) GetMarketHistory(ctx context.Context, in *GetMarketHistoryRequest, opts ...grpc.CallOption) (*GetMarketHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarketHistoryResponse)
+	err := c.cc.Invoke(ctx, ManaService_GetMarketHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manaServiceClient) CreateInvestmentType(ctx context.Context, in *CreateInvestmentTypeRequest, opts ...grpc.CallOption) (*InvestmentType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvestmentType)
+	err := c.cc.Invoke(ctx, ManaService_CreateInvestmentType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manaServiceClient) UpdateInvestmentType(ctx context.Context, in *UpdateInvestmentTypeRequest, opts ...grpc.CallOption) (*InvestmentType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvestmentType)
+	err := c.cc.Invoke(ctx, ManaService_UpdateInvestmentType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manaServiceClient) ActivateInvestmentType(ctx context.Context, in *SetInvestmentTypeActiveRequest, opts ...grpc.CallOption) (*InvestmentType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvestmentType)
+	err := c.cc.Invoke(ctx, ManaService_ActivateInvestmentType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manaServiceClient) DeactivateInvestmentType(ctx context.Context, in *SetInvestmentTypeActiveRequest, opts ...grpc.CallOption) (*InvestmentType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvestmentType)
+	err := c.cc.Invoke(ctx, ManaService_DeactivateInvestmentType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manaServiceClient) GetInvestmentTypeHistory(ctx context.Context, in *GetInvestmentTypeHistoryRequest, opts ...grpc.CallOption) (*GetInvestmentTypeHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvestmentTypeHistoryResponse)
+	err := c.cc.Invoke(ctx, ManaService_GetInvestmentTypeHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ManaServiceServer is the server API for ManaService service.
 // All implementations must embed UnimplementedManaServiceServer
 // for forward compatibility.
@@ -117,6 +226,16 @@ type ManaServiceServer interface {
 	CreateInvestment(context.Context, *CreateInvestmentRequest) (*CreateInvestmentResponse, error)
 	GetInvestments(context.Context, *GetInvestmentsRequest) (*GetInvestmentsResponse, error)
 	GetInvestmentTypes(context.Context, *GetInvestmentTypesRequest) (*GetInvestmentTypesResponse, error)
+	WithdrawInvestment(context.Context, *WithdrawInvestmentRequest) (*WithdrawInvestmentResponse, error)
+	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
+	SetInvestmentRollover(context.Context, *SetInvestmentRolloverRequest) (*SetInvestmentRolloverResponse, error)
+	GetMarketHistory(context.Context, *GetMarketHistoryRequest) (*GetMarketHistoryResponse, error)
+	// Investment type administration
+	CreateInvestmentType(context.Context, *CreateInvestmentTypeRequest) (*InvestmentType, error)
+	UpdateInvestmentType(context.Context, *UpdateInvestmentTypeRequest) (*InvestmentType, error)
+	ActivateInvestmentType(context.Context, *SetInvestmentTypeActiveRequest) (*InvestmentType, error)
+	DeactivateInvestmentType(context.Context, *SetInvestmentTypeActiveRequest) (*InvestmentType, error)
+	GetInvestmentTypeHistory(context.Context, *GetInvestmentTypeHistoryRequest) (*GetInvestmentTypeHistoryResponse, error)
 	mustEmbedUnimplementedManaServiceServer()
 }
 
@@ -145,6 +264,33 @@ func (UnimplementedManaServiceServer) GetInvestments(context.Context, *GetInvest
 func (UnimplementedManaServiceServer) GetInvestmentTypes(context.Context, *GetInvestmentTypesRequest) (*GetInvestmentTypesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInvestmentTypes not implemented")
 }
+func (UnimplementedManaServiceServer) WithdrawInvestment(context.Context, *WithdrawInvestmentRequest) (*WithdrawInvestmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawInvestment not implemented")
+}
+func (UnimplementedManaServiceServer) GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
+}
+func (UnimplementedManaServiceServer) SetInvestmentRollover(context.Context, *SetInvestmentRolloverRequest) (*SetInvestmentRolloverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInvestmentRollover not implemented")
+}
+func (UnimplementedManaServiceServer) GetMarketHistory(context.Context, *GetMarketHistoryRequest) (*GetMarketHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketHistory not implemented")
+}
+func (UnimplementedManaServiceServer) CreateInvestmentType(context.Context, *CreateInvestmentTypeRequest) (*InvestmentType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvestmentType not implemented")
+}
+func (UnimplementedManaServiceServer) UpdateInvestmentType(context.Context, *UpdateInvestmentTypeRequest) (*InvestmentType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInvestmentType not implemented")
+}
+func (UnimplementedManaServiceServer) ActivateInvestmentType(context.Context, *SetInvestmentTypeActiveRequest) (*InvestmentType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateInvestmentType not implemented")
+}
+func (UnimplementedManaServiceServer) DeactivateInvestmentType(context.Context, *SetInvestmentTypeActiveRequest) (*InvestmentType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateInvestmentType not implemented")
+}
+func (UnimplementedManaServiceServer) GetInvestmentTypeHistory(context.Context, *GetInvestmentTypeHistoryRequest) (*GetInvestmentTypeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvestmentTypeHistory not implemented")
+}
 func (UnimplementedManaServiceServer) mustEmbedUnimplementedManaServiceServer() {}
 func (UnimplementedManaServiceServer) testEmbeddedByValue()                     {}
 
@@ -274,6 +420,168 @@ func _ManaService_GetInvestmentTypes_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ManaService_WithdrawInvestment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawInvestmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManaServiceServer).WithdrawInvestment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManaService_WithdrawInvestment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManaServiceServer).WithdrawInvestment(ctx, req.(*WithdrawInvestmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManaService_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManaServiceServer).GetPortfolioSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManaService_GetPortfolioSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManaServiceServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManaService_SetInvestmentRollover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInvestmentRolloverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManaServiceServer).SetInvestmentRollover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManaService_SetInvestmentRollover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManaServiceServer).SetInvestmentRollover(ctx, req.(*SetInvestmentRolloverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManaService_GetMarketHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManaServiceServer).GetMarketHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManaService_GetMarketHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManaServiceServer).GetMarketHistory(ctx, req.(*GetMarketHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManaService_CreateInvestmentType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvestmentTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManaServiceServer).CreateInvestmentType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManaService_CreateInvestmentType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManaServiceServer).CreateInvestmentType(ctx, req.(*CreateInvestmentTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManaService_UpdateInvestmentType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInvestmentTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManaServiceServer).UpdateInvestmentType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManaService_UpdateInvestmentType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManaServiceServer).UpdateInvestmentType(ctx, req.(*UpdateInvestmentTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManaService_ActivateInvestmentType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInvestmentTypeActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManaServiceServer).ActivateInvestmentType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManaService_ActivateInvestmentType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManaServiceServer).ActivateInvestmentType(ctx, req.(*SetInvestmentTypeActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManaService_DeactivateInvestmentType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInvestmentTypeActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManaServiceServer).DeactivateInvestmentType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManaService_DeactivateInvestmentType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManaServiceServer).DeactivateInvestmentType(ctx, req.(*SetInvestmentTypeActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManaService_GetInvestmentTypeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvestmentTypeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManaServiceServer).GetInvestmentTypeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManaService_GetInvestmentTypeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManaServiceServer).GetInvestmentTypeHistory(ctx, req.(*GetInvestmentTypeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ManaService_ServiceDesc is the grpc.ServiceDesc for ManaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +613,42 @@ var ManaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInvestmentTypes",
 			Handler:    _ManaService_GetInvestmentTypes_Handler,
 		},
+		{
+			MethodName: "WithdrawInvestment",
+			Handler:    _ManaService_WithdrawInvestment_Handler,
+		},
+		{
+			MethodName: "GetPortfolioSummary",
+			Handler:    _ManaService_GetPortfolioSummary_Handler,
+		},
+		{
+			MethodName: "SetInvestmentRollover",
+			Handler:    _ManaService_SetInvestmentRollover_Handler,
+		},
+		{
+			MethodName: "GetMarketHistory",
+			Handler:    _ManaService_GetMarketHistory_Handler,
+		},
+		{
+			MethodName: "CreateInvestmentType",
+			Handler:    _ManaService_CreateInvestmentType_Handler,
+		},
+		{
+			MethodName: "UpdateInvestmentType",
+			Handler:    _ManaService_UpdateInvestmentType_Handler,
+		},
+		{
+			MethodName: "ActivateInvestmentType",
+			Handler:    _ManaService_ActivateInvestmentType_Handler,
+		},
+		{
+			MethodName: "DeactivateInvestmentType",
+			Handler:    _ManaService_DeactivateInvestmentType_Handler,
+		},
+		{
+			MethodName: "GetInvestmentTypeHistory",
+			Handler:    _ManaService_GetInvestmentTypeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/mana/mana.proto",
@@ -4,7 +4,7 @@
 // - protoc             v5.29.3
 // source: proto/realm/realm.proto
 
-package auth
+package realm
 
 import (
 	context "context"
@@ -19,17 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RealmService_CreateRealm_FullMethodName    = "/realm.RealmService/CreateRealm"
-	RealmService_GetRealm_FullMethodName       = "/realm.RealmService/GetRealm"
-	RealmService_ListRealms_FullMethodName     = "/realm.RealmService/ListRealms"
-	RealmService_UpdateRealm_FullMethodName    = "/realm.RealmService/UpdateRealm"
-	RealmService_DeleteRealm_FullMethodName    = "/realm.RealmService/DeleteRealm"
-	RealmService_CreateProperty_FullMethodName = "/realm.RealmService/CreateProperty"
-	RealmService_GetProperty_FullMethodName    = "/realm.RealmService/GetProperty"
-	RealmService_ListProperties_FullMethodName = "/realm.RealmService/ListProperties"
-	RealmService_UpdateProperty_FullMethodName = "/realm.RealmService/UpdateProperty"
-	RealmService_DeleteProperty_FullMethodName = "/realm.RealmService/DeleteProperty"
-	RealmService_BuyProperty_FullMethodName    = "/realm.RealmService/BuyProperty"
+	RealmService_CreateRealm_FullMethodName        = "/realm.RealmService/CreateRealm"
+	RealmService_GetRealm_FullMethodName           = "/realm.RealmService/GetRealm"
+	RealmService_ListRealms_FullMethodName         = "/realm.RealmService/ListRealms"
+	RealmService_UpdateRealm_FullMethodName        = "/realm.RealmService/UpdateRealm"
+	RealmService_DeleteRealm_FullMethodName        = "/realm.RealmService/DeleteRealm"
+	RealmService_CreateProperty_FullMethodName     = "/realm.RealmService/CreateProperty"
+	RealmService_GetProperty_FullMethodName        = "/realm.RealmService/GetProperty"
+	RealmService_ListProperties_FullMethodName     = "/realm.RealmService/ListProperties"
+	RealmService_UpdateProperty_FullMethodName     = "/realm.RealmService/UpdateProperty"
+	RealmService_DeleteProperty_FullMethodName     = "/realm.RealmService/DeleteProperty"
+	RealmService_SetPropertyListing_FullMethodName = "/realm.RealmService/SetPropertyListing"
+	RealmService_BuyProperty_FullMethodName        = "/realm.RealmService/BuyProperty"
 )
 
 // RealmServiceClient is the client API for RealmService service.
@@ -46,6 +47,7 @@ type RealmServiceClient interface {
 	ListProperties(ctx context.Context, in *ListPropertiesRequest, opts ...grpc.CallOption) (*ListPropertiesResponse, error)
 	UpdateProperty(ctx context.Context, in *UpdatePropertyRequest, opts ...grpc.CallOption) (*Property, error)
 	DeleteProperty(ctx context.Context, in *DeletePropertyRequest, opts ...grpc.CallOption) (*DeletePropertyResponse, error)
+	SetPropertyListing(ctx context.Context, in *SetPropertyListingRequest, opts ...grpc.CallOption) (*Property, error)
 	BuyProperty(ctx context.Context, in *BuyPropertyRequest, opts ...grpc.CallOption) (*BuyPropertyResponse, error)
 }
 
@@ -157,6 +159,16 @@ func (c *realmServiceClient) DeleteProperty(ctx context.Context, in *DeletePrope
 	return out, nil
 }
 
+func (c *realmServiceClient) SetPropertyListing(ctx context.Context, in *SetPropertyListingRequest, opts ...grpc.CallOption) (*Property, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Property)
+	err := c.cc.Invoke(ctx, RealmService_SetPropertyListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *realmServiceClient) BuyProperty(ctx context.Context, in *BuyPropertyRequest, opts ...grpc.CallOption) (*BuyPropertyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BuyPropertyResponse)
@@ -181,6 +193,7 @@ type RealmServiceServer interface {
 	ListProperties(context.Context, *ListPropertiesRequest) (*ListPropertiesResponse, error)
 	UpdateProperty(context.Context, *UpdatePropertyRequest) (*Property, error)
 	DeleteProperty(context.Context, *DeletePropertyRequest) (*DeletePropertyResponse, error)
+	SetPropertyListing(context.Context, *SetPropertyListingRequest) (*Property, error)
 	BuyProperty(context.Context, *BuyPropertyRequest) (*BuyPropertyResponse, error)
 	mustEmbedUnimplementedRealmServiceServer()
 }
@@ -222,6 +235,9 @@ func (UnimplementedRealmServiceServer) UpdateProperty(context.Context, *UpdatePr
 func (UnimplementedRealmServiceServer) DeleteProperty(context.Context, *DeletePropertyRequest) (*DeletePropertyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProperty not implemented")
 }
+func (UnimplementedRealmServiceServer) SetPropertyListing(context.Context, *SetPropertyListingRequest) (*Property, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPropertyListing not implemented")
+}
 func (UnimplementedRealmServiceServer) BuyProperty(context.Context, *BuyPropertyRequest) (*BuyPropertyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BuyProperty not implemented")
 }
@@ -426,6 +442,24 @@ func _RealmService_DeleteProperty_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RealmService_SetPropertyListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPropertyListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmServiceServer).SetPropertyListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealmService_SetPropertyListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmServiceServer).SetPropertyListing(ctx, req.(*SetPropertyListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RealmService_BuyProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BuyPropertyRequest)
 	if err := dec(in); err != nil {
@@ -491,6 +525,10 @@ var RealmService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProperty",
 			Handler:    _RealmService_DeleteProperty_Handler,
 		},
+		{
+			MethodName: "SetPropertyListing",
+			Handler:    _RealmService_SetPropertyListing_Handler,
+		},
 		{
 			MethodName: "BuyProperty",
 			Handler:    _RealmService_BuyProperty_Handler,
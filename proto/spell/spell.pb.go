@@ -4,7 +4,7 @@
 // 	protoc        v5.29.3
 // source: proto/spell/spell.proto
 
-package auth
+package spell
 
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -26,12 +26,18 @@ type Spell struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ManaCost    int64                  `protobuf:"varint,4,opt,name=mana_cost,json=manaCost,proto3" json:"mana_cost,omitempty"`
-	Realm       string                 `protobuf:"bytes,5,opt,name=realm,proto3" json:"realm,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ManaCost        int64                  `protobuf:"varint,4,opt,name=mana_cost,json=manaCost,proto3" json:"mana_cost,omitempty"` // Mana spent on each cast
+	Element         string                 `protobuf:"bytes,5,opt,name=element,proto3" json:"element,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SpellSchool     string                 `protobuf:"bytes,7,opt,name=spell_school,json=spellSchool,proto3" json:"spell_school,omitempty"`
+	PowerLevel      int32                  `protobuf:"varint,8,opt,name=power_level,json=powerLevel,proto3" json:"power_level,omitempty"`
+	ManaCostToLearn int64                  `protobuf:"varint,9,opt,name=mana_cost_to_learn,json=manaCostToLearn,proto3" json:"mana_cost_to_learn,omitempty"`
+	Requirements    string                 `protobuf:"bytes,10,opt,name=requirements,proto3" json:"requirements,omitempty"`
+	Effects         string                 `protobuf:"bytes,11,opt,name=effects,proto3" json:"effects,omitempty"`
+	Rarity          string                 `protobuf:"bytes,12,opt,name=rarity,proto3" json:"rarity,omitempty"`
 }
 
 func (x *Spell) Reset() {
@@ -94,9 +100,9 @@ func (x *Spell) GetManaCost() int64 {
 	return 0
 }
 
-func (x *Spell) GetRealm() string {
+func (x *Spell) GetElement() string {
 	if x != nil {
-		return x.Realm
+		return x.Element
 	}
 	return ""
 }
@@ -108,15 +114,63 @@ func (x *Spell) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Spell) GetSpellSchool() string {
+	if x != nil {
+		return x.SpellSchool
+	}
+	return ""
+}
+
+func (x *Spell) GetPowerLevel() int32 {
+	if x != nil {
+		return x.PowerLevel
+	}
+	return 0
+}
+
+func (x *Spell) GetManaCostToLearn() int64 {
+	if x != nil {
+		return x.ManaCostToLearn
+	}
+	return 0
+}
+
+func (x *Spell) GetRequirements() string {
+	if x != nil {
+		return x.Requirements
+	}
+	return ""
+}
+
+func (x *Spell) GetEffects() string {
+	if x != nil {
+		return x.Effects
+	}
+	return ""
+}
+
+func (x *Spell) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
 type CreateSpellRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	ManaCost    int64  `protobuf:"varint,3,opt,name=mana_cost,json=manaCost,proto3" json:"mana_cost,omitempty"`
-	Realm       string `protobuf:"bytes,4,opt,name=realm,proto3" json:"realm,omitempty"`
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ManaCost        int64  `protobuf:"varint,3,opt,name=mana_cost,json=manaCost,proto3" json:"mana_cost,omitempty"`
+	Element         string `protobuf:"bytes,4,opt,name=element,proto3" json:"element,omitempty"`
+	SpellSchool     string `protobuf:"bytes,5,opt,name=spell_school,json=spellSchool,proto3" json:"spell_school,omitempty"`
+	PowerLevel      int32  `protobuf:"varint,6,opt,name=power_level,json=powerLevel,proto3" json:"power_level,omitempty"`
+	ManaCostToLearn int64  `protobuf:"varint,7,opt,name=mana_cost_to_learn,json=manaCostToLearn,proto3" json:"mana_cost_to_learn,omitempty"`
+	Requirements    string `protobuf:"bytes,8,opt,name=requirements,proto3" json:"requirements,omitempty"`
+	Effects         string `protobuf:"bytes,9,opt,name=effects,proto3" json:"effects,omitempty"`
+	Rarity          string `protobuf:"bytes,10,opt,name=rarity,proto3" json:"rarity,omitempty"`
 }
 
 func (x *CreateSpellRequest) Reset() {
@@ -172,9 +226,51 @@ func (x *CreateSpellRequest) GetManaCost() int64 {
 	return 0
 }
 
-func (x *CreateSpellRequest) GetRealm() string {
+func (x *CreateSpellRequest) GetElement() string {
 	if x != nil {
-		return x.Realm
+		return x.Element
+	}
+	return ""
+}
+
+func (x *CreateSpellRequest) GetSpellSchool() string {
+	if x != nil {
+		return x.SpellSchool
+	}
+	return ""
+}
+
+func (x *CreateSpellRequest) GetPowerLevel() int32 {
+	if x != nil {
+		return x.PowerLevel
+	}
+	return 0
+}
+
+func (x *CreateSpellRequest) GetManaCostToLearn() int64 {
+	if x != nil {
+		return x.ManaCostToLearn
+	}
+	return 0
+}
+
+func (x *CreateSpellRequest) GetRequirements() string {
+	if x != nil {
+		return x.Requirements
+	}
+	return ""
+}
+
+func (x *CreateSpellRequest) GetEffects() string {
+	if x != nil {
+		return x.Effects
+	}
+	return ""
+}
+
+func (x *CreateSpellRequest) GetRarity() string {
+	if x != nil {
+		return x.Rarity
 	}
 	return ""
 }
@@ -231,9 +327,10 @@ type ListSpellsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Realm      string `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
-	PageSize   int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageNumber int32  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	Element     string `protobuf:"bytes,1,opt,name=element,proto3" json:"element,omitempty"`
+	PageSize    int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageNumber  int32  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	SpellSchool string `protobuf:"bytes,4,opt,name=spell_school,json=spellSchool,proto3" json:"spell_school,omitempty"`
 }
 
 func (x *ListSpellsRequest) Reset() {
@@ -268,9 +365,9 @@ func (*ListSpellsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_spell_spell_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListSpellsRequest) GetRealm() string {
+func (x *ListSpellsRequest) GetElement() string {
 	if x != nil {
-		return x.Realm
+		return x.Element
 	}
 	return ""
 }
@@ -289,6 +386,13 @@ func (x *ListSpellsRequest) GetPageNumber() int32 {
 	return 0
 }
 
+func (x *ListSpellsRequest) GetSpellSchool() string {
+	if x != nil {
+		return x.SpellSchool
+	}
+	return ""
+}
+
 type ListSpellsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -349,8 +453,9 @@ type LearnSpellRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WizardId int64 `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
-	SpellId  int64 `protobuf:"varint,2,opt,name=spell_id,json=spellId,proto3" json:"spell_id,omitempty"`
+	WizardId       int64  `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	SpellId        int64  `protobuf:"varint,2,opt,name=spell_id,json=spellId,proto3" json:"spell_id,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // Optional; retries with the same key replay the original response
 }
 
 func (x *LearnSpellRequest) Reset() {
@@ -399,13 +504,22 @@ func (x *LearnSpellRequest) GetSpellId() int64 {
 	return 0
 }
 
+func (x *LearnSpellRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type LearnSpellResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ManaSpent     int64  `protobuf:"varint,3,opt,name=mana_spent,json=manaSpent,proto3" json:"mana_spent,omitempty"`
+	RemainingMana int64  `protobuf:"varint,4,opt,name=remaining_mana,json=remainingMana,proto3" json:"remaining_mana,omitempty"`
 }
 
 func (x *LearnSpellResponse) Reset() {
@@ -454,14 +568,29 @@ func (x *LearnSpellResponse) GetMessage() string {
 	return ""
 }
 
+func (x *LearnSpellResponse) GetManaSpent() int64 {
+	if x != nil {
+		return x.ManaSpent
+	}
+	return 0
+}
+
+func (x *LearnSpellResponse) GetRemainingMana() int64 {
+	if x != nil {
+		return x.RemainingMana
+	}
+	return 0
+}
+
 type CastSpellRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WizardId int64 `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
-	SpellId  int64 `protobuf:"varint,2,opt,name=spell_id,json=spellId,proto3" json:"spell_id,omitempty"`
-	TargetId int64 `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	WizardId       int64  `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	SpellId        int64  `protobuf:"varint,2,opt,name=spell_id,json=spellId,proto3" json:"spell_id,omitempty"`
+	TargetId       int64  `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`                  // Optional target wizard
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // Optional; retries with the same key replay the original response
 }
 
 func (x *CastSpellRequest) Reset() {
@@ -517,14 +646,25 @@ func (x *CastSpellRequest) GetTargetId() int64 {
 	return 0
 }
 
+func (x *CastSpellRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type CastSpellResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success  bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ManaCost int64  `protobuf:"varint,3,opt,name=mana_cost,json=manaCost,proto3" json:"mana_cost,omitempty"`
+	Success          bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message          string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ManaCost         int64  `protobuf:"varint,3,opt,name=mana_cost,json=manaCost,proto3" json:"mana_cost,omitempty"`
+	RemainingMana    int64  `protobuf:"varint,4,opt,name=remaining_mana,json=remainingMana,proto3" json:"remaining_mana,omitempty"`
+	TimesCast        int32  `protobuf:"varint,5,opt,name=times_cast,json=timesCast,proto3" json:"times_cast,omitempty"`
+	MasteryLevel     int32  `protobuf:"varint,6,opt,name=mastery_level,json=masteryLevel,proto3" json:"mastery_level,omitempty"`
+	MasteryIncreased bool   `protobuf:"varint,7,opt,name=mastery_increased,json=masteryIncreased,proto3" json:"mastery_increased,omitempty"`
 }
 
 func (x *CastSpellResponse) Reset() {
@@ -580,6 +720,207 @@ func (x *CastSpellResponse) GetManaCost() int64 {
 	return 0
 }
 
+func (x *CastSpellResponse) GetRemainingMana() int64 {
+	if x != nil {
+		return x.RemainingMana
+	}
+	return 0
+}
+
+func (x *CastSpellResponse) GetTimesCast() int32 {
+	if x != nil {
+		return x.TimesCast
+	}
+	return 0
+}
+
+func (x *CastSpellResponse) GetMasteryLevel() int32 {
+	if x != nil {
+		return x.MasteryLevel
+	}
+	return 0
+}
+
+func (x *CastSpellResponse) GetMasteryIncreased() bool {
+	if x != nil {
+		return x.MasteryIncreased
+	}
+	return false
+}
+
+type WizardSpell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Spell               *Spell                 `protobuf:"bytes,1,opt,name=spell,proto3" json:"spell,omitempty"`
+	MasteryLevel        int32                  `protobuf:"varint,2,opt,name=mastery_level,json=masteryLevel,proto3" json:"mastery_level,omitempty"`
+	TimesCast           int32                  `protobuf:"varint,3,opt,name=times_cast,json=timesCast,proto3" json:"times_cast,omitempty"`
+	LearnedFromWizardId int64                  `protobuf:"varint,4,opt,name=learned_from_wizard_id,json=learnedFromWizardId,proto3" json:"learned_from_wizard_id,omitempty"`
+	LearnedAt           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=learned_at,json=learnedAt,proto3" json:"learned_at,omitempty"`
+}
+
+func (x *WizardSpell) Reset() {
+	*x = WizardSpell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_spell_spell_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WizardSpell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WizardSpell) ProtoMessage() {}
+
+func (x *WizardSpell) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_spell_spell_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WizardSpell.ProtoReflect.Descriptor instead.
+func (*WizardSpell) Descriptor() ([]byte, []int) {
+	return file_proto_spell_spell_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WizardSpell) GetSpell() *Spell {
+	if x != nil {
+		return x.Spell
+	}
+	return nil
+}
+
+func (x *WizardSpell) GetMasteryLevel() int32 {
+	if x != nil {
+		return x.MasteryLevel
+	}
+	return 0
+}
+
+func (x *WizardSpell) GetTimesCast() int32 {
+	if x != nil {
+		return x.TimesCast
+	}
+	return 0
+}
+
+func (x *WizardSpell) GetLearnedFromWizardId() int64 {
+	if x != nil {
+		return x.LearnedFromWizardId
+	}
+	return 0
+}
+
+func (x *WizardSpell) GetLearnedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LearnedAt
+	}
+	return nil
+}
+
+type GetWizardSpellsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WizardId int64 `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+}
+
+func (x *GetWizardSpellsRequest) Reset() {
+	*x = GetWizardSpellsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_spell_spell_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWizardSpellsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWizardSpellsRequest) ProtoMessage() {}
+
+func (x *GetWizardSpellsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_spell_spell_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWizardSpellsRequest.ProtoReflect.Descriptor instead.
+func (*GetWizardSpellsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_spell_spell_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetWizardSpellsRequest) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+type GetWizardSpellsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Spells []*WizardSpell `protobuf:"bytes,1,rep,name=spells,proto3" json:"spells,omitempty"`
+}
+
+func (x *GetWizardSpellsResponse) Reset() {
+	*x = GetWizardSpellsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_spell_spell_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWizardSpellsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWizardSpellsResponse) ProtoMessage() {}
+
+func (x *GetWizardSpellsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_spell_spell_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWizardSpellsResponse.ProtoReflect.Descriptor instead.
+func (*GetWizardSpellsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_spell_spell_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetWizardSpellsResponse) GetSpells() []*WizardSpell {
+	if x != nil {
+		return x.Spells
+	}
+	return nil
+}
+
 var File_proto_spell_spell_proto protoreflect.FileDescriptor
 
 var file_proto_spell_spell_proto_rawDesc = []byte{
@@ -587,88 +928,162 @@ var file_proto_spell_spell_proto_rawDesc = []byte{
 	0x65, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x73, 0x70, 0x65, 0x6c, 0x6c,
 	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xbb, 0x01, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x6f, 0x22, 0x86, 0x03, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
 	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x6e, 0x61, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x61, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
-	0x65, 0x61, 0x6c, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
-	0x7d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
-	0x61, 0x6e, 0x61, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x6d, 0x61, 0x6e, 0x61, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c,
-	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0x21,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x67, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x1b, 0x0a, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x5b, 0x0a, 0x12, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x24, 0x0a, 0x06, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x06,
-	0x73, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x72, 0x6e,
-	0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x65,
-	0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x70, 0x65,
-	0x6c, 0x6c, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x53, 0x70, 0x65,
-	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x67,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x61, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x68,
+	0x6f, 0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x6c, 0x6c,
+	0x53, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x6e, 0x61, 0x5f,
+	0x63, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x6e, 0x61, 0x43, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x4c,
+	0x65, 0x61, 0x72, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x22, 0xc8, 0x02, 0x0a, 0x12, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x6e, 0x61, 0x5f,
+	0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x61,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x6f, 0x6f,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x6e, 0x61, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f,
+	0x74, 0x6f, 0x5f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x6d, 0x61, 0x6e, 0x61, 0x43, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x5f,
+	0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x70,
+	0x65, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x22, 0x5b, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x06, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x06, 0x73,
+	0x70, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x74, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x53,
+	0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x6c,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x70, 0x65, 0x6c,
+	0x6c, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x8e, 0x01, 0x0a,
+	0x12, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x6e, 0x61, 0x5f,
+	0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x6e,
+	0x61, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x6e, 0x61, 0x22, 0x90, 0x01,
 	0x0a, 0x10, 0x43, 0x61, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12,
 	0x19, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x07, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61,
 	0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x11, 0x43, 0x61, 0x73, 0x74, 0x53,
-	0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x6e, 0x61, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x61, 0x43, 0x6f, 0x73, 0x74, 0x32, 0xc8, 0x02,
-	0x0a, 0x0c, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x38,
-	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x12, 0x19, 0x2e,
-	0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x6c,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c,
-	0x2e, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53,
-	0x70, 0x65, 0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x73,
-	0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x65,
-	0x6c, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x12,
-	0x18, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x53, 0x70, 0x65,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x70, 0x65, 0x6c,
-	0x6c, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x43, 0x61, 0x73, 0x74, 0x53, 0x70,
-	0x65, 0x6c, 0x6c, 0x12, 0x17, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x43, 0x61, 0x73, 0x74,
-	0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73,
-	0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6c, 0x69, 0x6e, 0x6f, 0x75, 0x72, 0x65, 0x64,
-	0x64, 0x69, 0x6e, 0x65, 0x31, 0x2f, 0x6d, 0x79, 0x73, 0x74, 0x69, 0x63, 0x66, 0x75, 0x6e, 0x64,
-	0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x22, 0xfc, 0x01, 0x0a, 0x11, 0x43, 0x61, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x6e, 0x61, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d,
+	0x61, 0x6e, 0x61, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x6e, 0x61, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x5f, 0x63, 0x61, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x43, 0x61, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x6e,
+	0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x64, 0x22,
+	0xe5, 0x01, 0x0a, 0x0b, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x12,
+	0x22, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x73, 0x70,
+	0x65, 0x6c, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x5f, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x43, 0x61, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x6c, 0x65, 0x61, 0x72, 0x6e,
+	0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x6c, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6c, 0x65,
+	0x61, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0x35, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x57, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x45,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x53, 0x70, 0x65, 0x6c, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x70, 0x65,
+	0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x65, 0x6c,
+	0x6c, 0x2e, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x06, 0x73,
+	0x70, 0x65, 0x6c, 0x6c, 0x73, 0x32, 0x9c, 0x03, 0x0a, 0x0c, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x70, 0x65, 0x6c, 0x6c, 0x12, 0x19, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0c, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x22, 0x00,
+	0x12, 0x32, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x73,
+	0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x53, 0x70, 0x65,
+	0x6c, 0x6c, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6c,
+	0x6c, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x70, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73,
+	0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x65, 0x61,
+	0x72, 0x6e, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e,
+	0x4c, 0x65, 0x61, 0x72, 0x6e, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x53,
+	0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x09, 0x43, 0x61, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x12, 0x17, 0x2e, 0x73, 0x70,
+	0x65, 0x6c, 0x6c, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x43, 0x61, 0x73,
+	0x74, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x53, 0x70, 0x65,
+	0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x65, 0x6c, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x53, 0x70, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x78, 0x2f, 0x6d, 0x79, 0x73, 0x74, 0x69, 0x63,
+	0x66, 0x75, 0x6e, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x65, 0x6c,
+	0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -683,37 +1098,45 @@ func file_proto_spell_spell_proto_rawDescGZIP() []byte {
 	return file_proto_spell_spell_proto_rawDescData
 }
 
-var file_proto_spell_spell_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_spell_spell_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_proto_spell_spell_proto_goTypes = []any{
-	(*Spell)(nil),                 // 0: spell.Spell
-	(*CreateSpellRequest)(nil),    // 1: spell.CreateSpellRequest
-	(*GetSpellRequest)(nil),       // 2: spell.GetSpellRequest
-	(*ListSpellsRequest)(nil),     // 3: spell.ListSpellsRequest
-	(*ListSpellsResponse)(nil),    // 4: spell.ListSpellsResponse
-	(*LearnSpellRequest)(nil),     // 5: spell.LearnSpellRequest
-	(*LearnSpellResponse)(nil),    // 6: spell.LearnSpellResponse
-	(*CastSpellRequest)(nil),      // 7: spell.CastSpellRequest
-	(*CastSpellResponse)(nil),     // 8: spell.CastSpellResponse
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*Spell)(nil),                   // 0: spell.Spell
+	(*CreateSpellRequest)(nil),      // 1: spell.CreateSpellRequest
+	(*GetSpellRequest)(nil),         // 2: spell.GetSpellRequest
+	(*ListSpellsRequest)(nil),       // 3: spell.ListSpellsRequest
+	(*ListSpellsResponse)(nil),      // 4: spell.ListSpellsResponse
+	(*LearnSpellRequest)(nil),       // 5: spell.LearnSpellRequest
+	(*LearnSpellResponse)(nil),      // 6: spell.LearnSpellResponse
+	(*CastSpellRequest)(nil),        // 7: spell.CastSpellRequest
+	(*CastSpellResponse)(nil),       // 8: spell.CastSpellResponse
+	(*WizardSpell)(nil),             // 9: spell.WizardSpell
+	(*GetWizardSpellsRequest)(nil),  // 10: spell.GetWizardSpellsRequest
+	(*GetWizardSpellsResponse)(nil), // 11: spell.GetWizardSpellsResponse
+	(*timestamppb.Timestamp)(nil),   // 12: google.protobuf.Timestamp
 }
 var file_proto_spell_spell_proto_depIdxs = []int32{
-	9, // 0: spell.Spell.created_at:type_name -> google.protobuf.Timestamp
-	0, // 1: spell.ListSpellsResponse.spells:type_name -> spell.Spell
-	1, // 2: spell.SpellService.CreateSpell:input_type -> spell.CreateSpellRequest
-	2, // 3: spell.SpellService.GetSpell:input_type -> spell.GetSpellRequest
-	3, // 4: spell.SpellService.ListSpells:input_type -> spell.ListSpellsRequest
-	5, // 5: spell.SpellService.LearnSpell:input_type -> spell.LearnSpellRequest
-	7, // 6: spell.SpellService.CastSpell:input_type -> spell.CastSpellRequest
-	0, // 7: spell.SpellService.CreateSpell:output_type -> spell.Spell
-	0, // 8: spell.SpellService.GetSpell:output_type -> spell.Spell
-	4, // 9: spell.SpellService.ListSpells:output_type -> spell.ListSpellsResponse
-	6, // 10: spell.SpellService.LearnSpell:output_type -> spell.LearnSpellResponse
-	8, // 11: spell.SpellService.CastSpell:output_type -> spell.CastSpellResponse
-	7, // [7:12] is the sub-list for method output_type
-	2, // [2:7] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	12, // 0: spell.Spell.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: spell.ListSpellsResponse.spells:type_name -> spell.Spell
+	0,  // 2: spell.WizardSpell.spell:type_name -> spell.Spell
+	12, // 3: spell.WizardSpell.learned_at:type_name -> google.protobuf.Timestamp
+	9,  // 4: spell.GetWizardSpellsResponse.spells:type_name -> spell.WizardSpell
+	1,  // 5: spell.SpellService.CreateSpell:input_type -> spell.CreateSpellRequest
+	2,  // 6: spell.SpellService.GetSpell:input_type -> spell.GetSpellRequest
+	3,  // 7: spell.SpellService.ListSpells:input_type -> spell.ListSpellsRequest
+	5,  // 8: spell.SpellService.LearnSpell:input_type -> spell.LearnSpellRequest
+	7,  // 9: spell.SpellService.CastSpell:input_type -> spell.CastSpellRequest
+	10, // 10: spell.SpellService.GetWizardSpells:input_type -> spell.GetWizardSpellsRequest
+	0,  // 11: spell.SpellService.CreateSpell:output_type -> spell.Spell
+	0,  // 12: spell.SpellService.GetSpell:output_type -> spell.Spell
+	4,  // 13: spell.SpellService.ListSpells:output_type -> spell.ListSpellsResponse
+	6,  // 14: spell.SpellService.LearnSpell:output_type -> spell.LearnSpellResponse
+	8,  // 15: spell.SpellService.CastSpell:output_type -> spell.CastSpellResponse
+	11, // 16: spell.SpellService.GetWizardSpells:output_type -> spell.GetWizardSpellsResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_proto_spell_spell_proto_init() }
@@ -830,6 +1253,42 @@ func file_proto_spell_spell_proto_init() {
 				return nil
 			}
 		}
+		file_proto_spell_spell_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*WizardSpell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_spell_spell_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GetWizardSpellsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_spell_spell_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GetWizardSpellsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -837,7 +1296,7 @@ func file_proto_spell_spell_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_spell_spell_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
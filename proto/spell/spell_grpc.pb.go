@@ -4,7 +4,7 @@
 // - protoc             v5.29.3
 // source: proto/spell/spell.proto
 
-package auth
+package spell
 
 import (
 	context "context"
@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SpellService_CreateSpell_FullMethodName = "/spell.SpellService/CreateSpell"
-	SpellService_GetSpell_FullMethodName    = "/spell.SpellService/GetSpell"
-	SpellService_ListSpells_FullMethodName  = "/spell.SpellService/ListSpells"
-	SpellService_LearnSpell_FullMethodName  = "/spell.SpellService/LearnSpell"
-	SpellService_CastSpell_FullMethodName   = "/spell.SpellService/CastSpell"
+	SpellService_CreateSpell_FullMethodName     = "/spell.SpellService/CreateSpell"
+	SpellService_GetSpell_FullMethodName        = "/spell.SpellService/GetSpell"
+	SpellService_ListSpells_FullMethodName      = "/spell.SpellService/ListSpells"
+	SpellService_LearnSpell_FullMethodName      = "/spell.SpellService/LearnSpell"
+	SpellService_CastSpell_FullMethodName       = "/spell.SpellService/CastSpell"
+	SpellService_GetWizardSpells_FullMethodName = "/spell.SpellService/GetWizardSpells"
 )
 
 // SpellServiceClient is the client API for SpellService service.
@@ -35,6 +36,7 @@ type SpellServiceClient interface {
 	ListSpells(ctx context.Context, in *ListSpellsRequest, opts ...grpc.CallOption) (*ListSpellsResponse, error)
 	LearnSpell(ctx context.Context, in *LearnSpellRequest, opts ...grpc.CallOption) (*LearnSpellResponse, error)
 	CastSpell(ctx context.Context, in *CastSpellRequest, opts ...grpc.CallOption) (*CastSpellResponse, error)
+	GetWizardSpells(ctx context.Context, in *GetWizardSpellsRequest, opts ...grpc.CallOption) (*GetWizardSpellsResponse, error)
 }
 
 type spellServiceClient struct {
@@ -95,6 +97,16 @@ func (c *spellServiceClient) CastSpell(ctx context.Context, in *CastSpellRequest
 	return out, nil
 }
 
+func (c *spellServiceClient) GetWizardSpells(ctx context.Context, in *GetWizardSpellsRequest, opts ...grpc.CallOption) (*GetWizardSpellsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWizardSpellsResponse)
+	err := c.cc.Invoke(ctx, SpellService_GetWizardSpells_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SpellServiceServer is the server API for SpellService service.
 // All implementations must embed UnimplementedSpellServiceServer
 // for forward compatibility.
@@ -104,6 +116,7 @@ type SpellServiceServer interface {
 	ListSpells(context.Context, *ListSpellsRequest) (*ListSpellsResponse, error)
 	LearnSpell(context.Context, *LearnSpellRequest) (*LearnSpellResponse, error)
 	CastSpell(context.Context, *CastSpellRequest) (*CastSpellResponse, error)
+	GetWizardSpells(context.Context, *GetWizardSpellsRequest) (*GetWizardSpellsResponse, error)
 	mustEmbedUnimplementedSpellServiceServer()
 }
 
@@ -129,6 +142,9 @@ func (UnimplementedSpellServiceServer) LearnSpell(context.Context, *LearnSpellRe
 func (UnimplementedSpellServiceServer) CastSpell(context.Context, *CastSpellRequest) (*CastSpellResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CastSpell not implemented")
 }
+func (UnimplementedSpellServiceServer) GetWizardSpells(context.Context, *GetWizardSpellsRequest) (*GetWizardSpellsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWizardSpells not implemented")
+}
 func (UnimplementedSpellServiceServer) mustEmbedUnimplementedSpellServiceServer() {}
 func (UnimplementedSpellServiceServer) testEmbeddedByValue()                      {}
 
@@ -240,6 +256,24 @@ func _SpellService_CastSpell_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpellService_GetWizardSpells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWizardSpellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpellServiceServer).GetWizardSpells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpellService_GetWizardSpells_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpellServiceServer).GetWizardSpells(ctx, req.(*GetWizardSpellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SpellService_ServiceDesc is the grpc.ServiceDesc for SpellService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +301,10 @@ var SpellService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CastSpell",
 			Handler:    _SpellService_CastSpell_Handler,
 		},
+		{
+			MethodName: "GetWizardSpells",
+			Handler:    _SpellService_GetWizardSpells_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/spell/spell.proto",
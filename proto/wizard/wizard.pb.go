@@ -740,6 +740,11 @@ type Job struct {
 	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	IsActive            bool                   `protobuf:"varint,20,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	PartyRule           *PartyRule             `protobuf:"bytes,21,opt,name=party_rule,json=partyRule,proto3" json:"party_rule,omitempty"`     // Set when the job only takes parties
+	Affinity            *JobAffinity           `protobuf:"bytes,22,opt,name=affinity,proto3" json:"affinity,omitempty"`                        // Set by ListJobs when asked about a wizard
+	Posting             *JobPosting            `protobuf:"bytes,23,opt,name=posting,proto3" json:"posting,omitempty"`                          // Set when a wizard posted the job
+	TemplateId          int64                  `protobuf:"varint,24,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // Set when a job template posted the job
+	ExpiresAt           *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`     // When a templated job comes off the board
 }
 
 func (x *Job) Reset() {
@@ -914,28 +919,58 @@ func (x *Job) GetIsActive() bool {
 	return false
 }
 
-type JobAssignment struct {
+func (x *Job) GetPartyRule() *PartyRule {
+	if x != nil {
+		return x.PartyRule
+	}
+	return nil
+}
+
+func (x *Job) GetAffinity() *JobAffinity {
+	if x != nil {
+		return x.Affinity
+	}
+	return nil
+}
+
+func (x *Job) GetPosting() *JobPosting {
+	if x != nil {
+		return x.Posting
+	}
+	return nil
+}
+
+func (x *Job) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *Job) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// A job posted by a wizard is paid for out of mana they hold in escrow, and is
+// worked by the applicants they accept
+type JobPosting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	JobId       int64                  `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	WizardId    int64                  `protobuf:"varint,3,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
-	WizardName  string                 `protobuf:"bytes,4,opt,name=wizard_name,json=wizardName,proto3" json:"wizard_name,omitempty"`
-	AssignedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
-	StartedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	Status      string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	ManaEarned  int32                  `protobuf:"varint,9,opt,name=mana_earned,json=manaEarned,proto3" json:"mana_earned,omitempty"`
-	ExpEarned   int32                  `protobuf:"varint,10,opt,name=exp_earned,json=expEarned,proto3" json:"exp_earned,omitempty"`
-	Notes       string                 `protobuf:"bytes,11,opt,name=notes,proto3" json:"notes,omitempty"`
-	Job         *Job                   `protobuf:"bytes,12,opt,name=job,proto3" json:"job,omitempty"`
-	Progress    *JobProgress           `protobuf:"bytes,13,opt,name=progress,proto3" json:"progress,omitempty"`
+	PosterWizardId  int64                  `protobuf:"varint,1,opt,name=poster_wizard_id,json=posterWizardId,proto3" json:"poster_wizard_id,omitempty"`
+	RewardPerWizard int32                  `protobuf:"varint,2,opt,name=reward_per_wizard,json=rewardPerWizard,proto3" json:"reward_per_wizard,omitempty"` // Most mana one wizard can be paid from escrow
+	EscrowRemaining int64                  `protobuf:"varint,3,opt,name=escrow_remaining,json=escrowRemaining,proto3" json:"escrow_remaining,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "open", "filled" or "expired"
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ClosedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
 }
 
-func (x *JobAssignment) Reset() {
-	*x = JobAssignment{}
+func (x *JobPosting) Reset() {
+	*x = JobPosting{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_wizard_wizard_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -943,13 +978,13 @@ func (x *JobAssignment) Reset() {
 	}
 }
 
-func (x *JobAssignment) String() string {
+func (x *JobPosting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobAssignment) ProtoMessage() {}
+func (*JobPosting) ProtoMessage() {}
 
-func (x *JobAssignment) ProtoReflect() protoreflect.Message {
+func (x *JobPosting) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_wizard_wizard_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -961,119 +996,71 @@ func (x *JobAssignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobAssignment.ProtoReflect.Descriptor instead.
-func (*JobAssignment) Descriptor() ([]byte, []int) {
+// Deprecated: Use JobPosting.ProtoReflect.Descriptor instead.
+func (*JobPosting) Descriptor() ([]byte, []int) {
 	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *JobAssignment) GetId() int64 {
+func (x *JobPosting) GetPosterWizardId() int64 {
 	if x != nil {
-		return x.Id
+		return x.PosterWizardId
 	}
 	return 0
 }
 
-func (x *JobAssignment) GetJobId() int64 {
+func (x *JobPosting) GetRewardPerWizard() int32 {
 	if x != nil {
-		return x.JobId
+		return x.RewardPerWizard
 	}
 	return 0
 }
 
-func (x *JobAssignment) GetWizardId() int64 {
+func (x *JobPosting) GetEscrowRemaining() int64 {
 	if x != nil {
-		return x.WizardId
+		return x.EscrowRemaining
 	}
 	return 0
 }
 
-func (x *JobAssignment) GetWizardName() string {
-	if x != nil {
-		return x.WizardName
-	}
-	return ""
-}
-
-func (x *JobAssignment) GetAssignedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.AssignedAt
-	}
-	return nil
-}
-
-func (x *JobAssignment) GetStartedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartedAt
-	}
-	return nil
-}
-
-func (x *JobAssignment) GetCompletedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CompletedAt
-	}
-	return nil
-}
-
-func (x *JobAssignment) GetStatus() string {
+func (x *JobPosting) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *JobAssignment) GetManaEarned() int32 {
-	if x != nil {
-		return x.ManaEarned
-	}
-	return 0
-}
-
-func (x *JobAssignment) GetExpEarned() int32 {
-	if x != nil {
-		return x.ExpEarned
-	}
-	return 0
-}
-
-func (x *JobAssignment) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
-}
-
-func (x *JobAssignment) GetJob() *Job {
+func (x *JobPosting) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Job
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *JobAssignment) GetProgress() *JobProgress {
+func (x *JobPosting) GetClosedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Progress
+		return x.ClosedAt
 	}
 	return nil
 }
 
-type JobProgress struct {
+type JobApplication struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AssignmentId       int64                  `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
-	StartedAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	LastUpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_updated_at,json=lastUpdatedAt,proto3" json:"last_updated_at,omitempty"`
-	ProgressPercentage int32                  `protobuf:"varint,5,opt,name=progress_percentage,json=progressPercentage,proto3" json:"progress_percentage,omitempty"`
-	TimeWorkedMinutes  int32                  `protobuf:"varint,6,opt,name=time_worked_minutes,json=timeWorkedMinutes,proto3" json:"time_worked_minutes,omitempty"`
-	IsActive           bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobId        int64                  `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	WizardId     int64                  `protobuf:"varint,3,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	WizardName   string                 `protobuf:"bytes,4,opt,name=wizard_name,json=wizardName,proto3" json:"wizard_name,omitempty"`
+	Status       string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // "pending", "accepted", "rejected" or "expired"
+	Message      string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	AssignmentId int64                  `protobuf:"varint,7,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"` // Set once the application is accepted
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
 }
 
-func (x *JobProgress) Reset() {
-	*x = JobProgress{}
+func (x *JobApplication) Reset() {
+	*x = JobApplication{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_wizard_wizard_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1081,13 +1068,13 @@ func (x *JobProgress) Reset() {
 	}
 }
 
-func (x *JobProgress) String() string {
+func (x *JobApplication) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobProgress) ProtoMessage() {}
+func (*JobApplication) ProtoMessage() {}
 
-func (x *JobProgress) ProtoReflect() protoreflect.Message {
+func (x *JobApplication) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_wizard_wizard_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1099,92 +1086,92 @@ func (x *JobProgress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobProgress.ProtoReflect.Descriptor instead.
-func (*JobProgress) Descriptor() ([]byte, []int) {
+// Deprecated: Use JobApplication.ProtoReflect.Descriptor instead.
+func (*JobApplication) Descriptor() ([]byte, []int) {
 	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *JobProgress) GetId() int64 {
+func (x *JobApplication) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *JobProgress) GetAssignmentId() int64 {
+func (x *JobApplication) GetJobId() int64 {
 	if x != nil {
-		return x.AssignmentId
+		return x.JobId
 	}
 	return 0
 }
 
-func (x *JobProgress) GetStartedAt() *timestamppb.Timestamp {
+func (x *JobApplication) GetWizardId() int64 {
 	if x != nil {
-		return x.StartedAt
+		return x.WizardId
 	}
-	return nil
+	return 0
 }
 
-func (x *JobProgress) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *JobApplication) GetWizardName() string {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.WizardName
 	}
-	return nil
+	return ""
 }
 
-func (x *JobProgress) GetProgressPercentage() int32 {
+func (x *JobApplication) GetStatus() string {
 	if x != nil {
-		return x.ProgressPercentage
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *JobProgress) GetTimeWorkedMinutes() int32 {
+func (x *JobApplication) GetMessage() string {
 	if x != nil {
-		return x.TimeWorkedMinutes
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *JobProgress) GetIsActive() bool {
+func (x *JobApplication) GetAssignmentId() int64 {
 	if x != nil {
-		return x.IsActive
+		return x.AssignmentId
 	}
-	return false
+	return 0
 }
 
-func (x *JobProgress) GetCreatedAt() *timestamppb.Timestamp {
+func (x *JobApplication) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-// Job requests
-type CreateJobRequest struct {
+func (x *JobApplication) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+// What a job would pay a wizard, given how well their element suits the job's
+type JobAffinity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RealmId             int64  `protobuf:"varint,1,opt,name=realm_id,json=realmId,proto3" json:"realm_id,omitempty"`
-	RealmName           string `protobuf:"bytes,2,opt,name=realm_name,json=realmName,proto3" json:"realm_name,omitempty"`
-	Title               string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description         string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	RequiredElement     string `protobuf:"bytes,5,opt,name=required_element,json=requiredElement,proto3" json:"required_element,omitempty"`
-	RequiredLevel       int32  `protobuf:"varint,6,opt,name=required_level,json=requiredLevel,proto3" json:"required_level,omitempty"`
-	ManaRewardPerHour   int32  `protobuf:"varint,7,opt,name=mana_reward_per_hour,json=manaRewardPerHour,proto3" json:"mana_reward_per_hour,omitempty"`
-	ExpRewardPerHour    int32  `protobuf:"varint,8,opt,name=exp_reward_per_hour,json=expRewardPerHour,proto3" json:"exp_reward_per_hour,omitempty"`
-	DurationMinutes     int32  `protobuf:"varint,9,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
-	MaxWizards          int32  `protobuf:"varint,10,opt,name=max_wizards,json=maxWizards,proto3" json:"max_wizards,omitempty"`
-	Difficulty          string `protobuf:"bytes,11,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	JobType             string `protobuf:"bytes,12,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
-	Location            string `protobuf:"bytes,13,opt,name=location,proto3" json:"location,omitempty"`
-	SpecialRequirements string `protobuf:"bytes,14,opt,name=special_requirements,json=specialRequirements,proto3" json:"special_requirements,omitempty"`
-	CreatedByWizardId   int64  `protobuf:"varint,15,opt,name=created_by_wizard_id,json=createdByWizardId,proto3" json:"created_by_wizard_id,omitempty"`
+	WizardId                 int64   `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	WizardElement            string  `protobuf:"bytes,2,opt,name=wizard_element,json=wizardElement,proto3" json:"wizard_element,omitempty"`
+	Eligible                 bool    `protobuf:"varint,3,opt,name=eligible,proto3" json:"eligible,omitempty"` // False if the wizard's element has no affinity with the job's
+	RewardFactor             float64 `protobuf:"fixed64,4,opt,name=reward_factor,json=rewardFactor,proto3" json:"reward_factor,omitempty"`
+	SpeedFactor              float64 `protobuf:"fixed64,5,opt,name=speed_factor,json=speedFactor,proto3" json:"speed_factor,omitempty"`
+	EffectiveDurationMinutes int32   `protobuf:"varint,6,opt,name=effective_duration_minutes,json=effectiveDurationMinutes,proto3" json:"effective_duration_minutes,omitempty"`
+	EffectiveManaReward      int32   `protobuf:"varint,7,opt,name=effective_mana_reward,json=effectiveManaReward,proto3" json:"effective_mana_reward,omitempty"` // For the whole job, after realm boost and affinity
+	EffectiveExpReward       int32   `protobuf:"varint,8,opt,name=effective_exp_reward,json=effectiveExpReward,proto3" json:"effective_exp_reward,omitempty"`
 }
 
-func (x *CreateJobRequest) Reset() {
-	*x = CreateJobRequest{}
+func (x *JobAffinity) Reset() {
+	*x = JobAffinity{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_wizard_wizard_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1192,13 +1179,13 @@ func (x *CreateJobRequest) Reset() {
 	}
 }
 
-func (x *CreateJobRequest) String() string {
+func (x *JobAffinity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJobRequest) ProtoMessage() {}
+func (*JobAffinity) ProtoMessage() {}
 
-func (x *CreateJobRequest) ProtoReflect() protoreflect.Message {
+func (x *JobAffinity) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_wizard_wizard_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1210,141 +1197,162 @@ func (x *CreateJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJobRequest.ProtoReflect.Descriptor instead.
-func (*CreateJobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JobAffinity.ProtoReflect.Descriptor instead.
+func (*JobAffinity) Descriptor() ([]byte, []int) {
 	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateJobRequest) GetRealmId() int64 {
+func (x *JobAffinity) GetWizardId() int64 {
 	if x != nil {
-		return x.RealmId
+		return x.WizardId
 	}
 	return 0
 }
 
-func (x *CreateJobRequest) GetRealmName() string {
+func (x *JobAffinity) GetWizardElement() string {
 	if x != nil {
-		return x.RealmName
+		return x.WizardElement
 	}
 	return ""
 }
 
-func (x *CreateJobRequest) GetTitle() string {
+func (x *JobAffinity) GetEligible() bool {
 	if x != nil {
-		return x.Title
+		return x.Eligible
 	}
-	return ""
+	return false
 }
 
-func (x *CreateJobRequest) GetDescription() string {
+func (x *JobAffinity) GetRewardFactor() float64 {
 	if x != nil {
-		return x.Description
+		return x.RewardFactor
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateJobRequest) GetRequiredElement() string {
+func (x *JobAffinity) GetSpeedFactor() float64 {
 	if x != nil {
-		return x.RequiredElement
+		return x.SpeedFactor
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateJobRequest) GetRequiredLevel() int32 {
+func (x *JobAffinity) GetEffectiveDurationMinutes() int32 {
 	if x != nil {
-		return x.RequiredLevel
+		return x.EffectiveDurationMinutes
 	}
 	return 0
 }
 
-func (x *CreateJobRequest) GetManaRewardPerHour() int32 {
+func (x *JobAffinity) GetEffectiveManaReward() int32 {
 	if x != nil {
-		return x.ManaRewardPerHour
+		return x.EffectiveManaReward
 	}
 	return 0
 }
 
-func (x *CreateJobRequest) GetExpRewardPerHour() int32 {
+func (x *JobAffinity) GetEffectiveExpReward() int32 {
 	if x != nil {
-		return x.ExpRewardPerHour
+		return x.EffectiveExpReward
 	}
 	return 0
 }
 
-func (x *CreateJobRequest) GetDurationMinutes() int32 {
-	if x != nil {
-		return x.DurationMinutes
-	}
-	return 0
+// A party job is worked by a group of wizards that applies together
+type PartyRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinSize     int32            `protobuf:"varint,1,opt,name=min_size,json=minSize,proto3" json:"min_size,omitempty"`
+	Composition map[string]int32 `protobuf:"bytes,2,rep,name=composition,proto3" json:"composition,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Least number of members of each element
+	RewardSplit string           `protobuf:"bytes,3,opt,name=reward_split,json=rewardSplit,proto3" json:"reward_split,omitempty"`                                                                       // "equal" or "level_weighted"
 }
 
-func (x *CreateJobRequest) GetMaxWizards() int32 {
-	if x != nil {
-		return x.MaxWizards
+func (x *PartyRule) Reset() {
+	*x = PartyRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *CreateJobRequest) GetDifficulty() string {
-	if x != nil {
-		return x.Difficulty
-	}
-	return ""
+func (x *PartyRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateJobRequest) GetJobType() string {
-	if x != nil {
-		return x.JobType
+func (*PartyRule) ProtoMessage() {}
+
+func (x *PartyRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateJobRequest) GetLocation() string {
+// Deprecated: Use PartyRule.ProtoReflect.Descriptor instead.
+func (*PartyRule) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PartyRule) GetMinSize() int32 {
 	if x != nil {
-		return x.Location
+		return x.MinSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateJobRequest) GetSpecialRequirements() string {
+func (x *PartyRule) GetComposition() map[string]int32 {
 	if x != nil {
-		return x.SpecialRequirements
+		return x.Composition
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateJobRequest) GetCreatedByWizardId() int64 {
+func (x *PartyRule) GetRewardSplit() string {
 	if x != nil {
-		return x.CreatedByWizardId
+		return x.RewardSplit
 	}
-	return 0
+	return ""
 }
 
-type GetJobRequest struct {
+type JobParty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobId       int64                  `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status      string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // "in_progress", "completed" or "cancelled"
+	RewardSplit string                 `protobuf:"bytes,4,opt,name=reward_split,json=rewardSplit,proto3" json:"reward_split,omitempty"`
+	Assignments []*JobAssignment       `protobuf:"bytes,5,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
 }
 
-func (x *GetJobRequest) Reset() {
-	*x = GetJobRequest{}
+func (x *JobParty) Reset() {
+	*x = JobParty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[15]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetJobRequest) String() string {
+func (x *JobParty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobRequest) ProtoMessage() {}
+func (*JobParty) ProtoMessage() {}
 
-func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[15]
+func (x *JobParty) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1355,116 +1363,85 @@ func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
-func (*GetJobRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use JobParty.ProtoReflect.Descriptor instead.
+func (*JobParty) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetJobRequest) GetId() int64 {
+func (x *JobParty) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type ListJobsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PageSize   int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageNumber int32  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	Realm      string `protobuf:"bytes,3,opt,name=realm,proto3" json:"realm,omitempty"`
-	Element    string `protobuf:"bytes,4,opt,name=element,proto3" json:"element,omitempty"`
-	Difficulty string `protobuf:"bytes,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	OnlyActive bool   `protobuf:"varint,6,opt,name=only_active,json=onlyActive,proto3" json:"only_active,omitempty"`
-}
-
-func (x *ListJobsRequest) Reset() {
-	*x = ListJobsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListJobsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListJobsRequest) ProtoMessage() {}
-
-func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
-func (*ListJobsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *ListJobsRequest) GetPageSize() int32 {
+func (x *JobParty) GetJobId() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.JobId
 	}
 	return 0
 }
 
-func (x *ListJobsRequest) GetPageNumber() int32 {
+func (x *JobParty) GetStatus() string {
 	if x != nil {
-		return x.PageNumber
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *ListJobsRequest) GetRealm() string {
+func (x *JobParty) GetRewardSplit() string {
 	if x != nil {
-		return x.Realm
+		return x.RewardSplit
 	}
 	return ""
 }
 
-func (x *ListJobsRequest) GetElement() string {
+func (x *JobParty) GetAssignments() []*JobAssignment {
 	if x != nil {
-		return x.Element
+		return x.Assignments
 	}
-	return ""
+	return nil
 }
 
-func (x *ListJobsRequest) GetDifficulty() string {
+func (x *JobParty) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Difficulty
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ListJobsRequest) GetOnlyActive() bool {
+func (x *JobParty) GetCompletedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OnlyActive
+		return x.CompletedAt
 	}
-	return false
+	return nil
 }
 
-type ListJobsResponse struct {
+type JobAssignment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Jobs       []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
-	TotalCount int32  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobId        int64                  `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	WizardId     int64                  `protobuf:"varint,3,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	WizardName   string                 `protobuf:"bytes,4,opt,name=wizard_name,json=wizardName,proto3" json:"wizard_name,omitempty"`
+	AssignedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	StartedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Status       string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	ManaEarned   int32                  `protobuf:"varint,9,opt,name=mana_earned,json=manaEarned,proto3" json:"mana_earned,omitempty"`
+	ExpEarned    int32                  `protobuf:"varint,10,opt,name=exp_earned,json=expEarned,proto3" json:"exp_earned,omitempty"`
+	Notes        string                 `protobuf:"bytes,11,opt,name=notes,proto3" json:"notes,omitempty"`
+	Job          *Job                   `protobuf:"bytes,12,opt,name=job,proto3" json:"job,omitempty"`
+	Progress     *JobProgress           `protobuf:"bytes,13,opt,name=progress,proto3" json:"progress,omitempty"`
+	PartyId      int64                  `protobuf:"varint,14,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`                 // 0 unless the wizard works the job in a party
+	RewardFactor float64                `protobuf:"fixed64,15,opt,name=reward_factor,json=rewardFactor,proto3" json:"reward_factor,omitempty"` // Element affinity the assignment was taken on
+	SpeedFactor  float64                `protobuf:"fixed64,16,opt,name=speed_factor,json=speedFactor,proto3" json:"speed_factor,omitempty"`
 }
 
-func (x *ListJobsResponse) Reset() {
-	*x = ListJobsResponse{}
+func (x *JobAssignment) Reset() {
+	*x = JobAssignment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_wizard_wizard_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1472,13 +1449,13 @@ func (x *ListJobsResponse) Reset() {
 	}
 }
 
-func (x *ListJobsResponse) String() string {
+func (x *JobAssignment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJobsResponse) ProtoMessage() {}
+func (*JobAssignment) ProtoMessage() {}
 
-func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+func (x *JobAssignment) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_wizard_wizard_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1490,145 +1467,159 @@ func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
-func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use JobAssignment.ProtoReflect.Descriptor instead.
+func (*JobAssignment) Descriptor() ([]byte, []int) {
 	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListJobsResponse) GetJobs() []*Job {
+func (x *JobAssignment) GetId() int64 {
 	if x != nil {
-		return x.Jobs
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *ListJobsResponse) GetTotalCount() int32 {
+func (x *JobAssignment) GetJobId() int64 {
 	if x != nil {
-		return x.TotalCount
+		return x.JobId
 	}
 	return 0
 }
 
-type UpdateJobRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *JobAssignment) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
 
-	Id                int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title             string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description       string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ManaRewardPerHour int32  `protobuf:"varint,4,opt,name=mana_reward_per_hour,json=manaRewardPerHour,proto3" json:"mana_reward_per_hour,omitempty"`
-	ExpRewardPerHour  int32  `protobuf:"varint,5,opt,name=exp_reward_per_hour,json=expRewardPerHour,proto3" json:"exp_reward_per_hour,omitempty"`
-	MaxWizards        int32  `protobuf:"varint,6,opt,name=max_wizards,json=maxWizards,proto3" json:"max_wizards,omitempty"`
-	IsActive          bool   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+func (x *JobAssignment) GetWizardName() string {
+	if x != nil {
+		return x.WizardName
+	}
+	return ""
 }
 
-func (x *UpdateJobRequest) Reset() {
-	*x = UpdateJobRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JobAssignment) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
 	}
+	return nil
 }
 
-func (x *UpdateJobRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JobAssignment) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
 }
 
-func (*UpdateJobRequest) ProtoMessage() {}
+func (x *JobAssignment) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
 
-func (x *UpdateJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JobAssignment) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateJobRequest.ProtoReflect.Descriptor instead.
-func (*UpdateJobRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{18}
+func (x *JobAssignment) GetManaEarned() int32 {
+	if x != nil {
+		return x.ManaEarned
+	}
+	return 0
 }
 
-func (x *UpdateJobRequest) GetId() int64 {
+func (x *JobAssignment) GetExpEarned() int32 {
 	if x != nil {
-		return x.Id
+		return x.ExpEarned
 	}
 	return 0
 }
 
-func (x *UpdateJobRequest) GetTitle() string {
+func (x *JobAssignment) GetNotes() string {
 	if x != nil {
-		return x.Title
+		return x.Notes
 	}
 	return ""
 }
 
-func (x *UpdateJobRequest) GetDescription() string {
+func (x *JobAssignment) GetJob() *Job {
 	if x != nil {
-		return x.Description
+		return x.Job
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateJobRequest) GetManaRewardPerHour() int32 {
+func (x *JobAssignment) GetProgress() *JobProgress {
 	if x != nil {
-		return x.ManaRewardPerHour
+		return x.Progress
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateJobRequest) GetExpRewardPerHour() int32 {
+func (x *JobAssignment) GetPartyId() int64 {
 	if x != nil {
-		return x.ExpRewardPerHour
+		return x.PartyId
 	}
 	return 0
 }
 
-func (x *UpdateJobRequest) GetMaxWizards() int32 {
+func (x *JobAssignment) GetRewardFactor() float64 {
 	if x != nil {
-		return x.MaxWizards
+		return x.RewardFactor
 	}
 	return 0
 }
 
-func (x *UpdateJobRequest) GetIsActive() bool {
+func (x *JobAssignment) GetSpeedFactor() float64 {
 	if x != nil {
-		return x.IsActive
+		return x.SpeedFactor
 	}
-	return false
+	return 0
 }
 
-type DeleteJobRequest struct {
+type JobProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId       int64                  `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	StartedAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	LastUpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_updated_at,json=lastUpdatedAt,proto3" json:"last_updated_at,omitempty"`
+	ProgressPercentage int32                  `protobuf:"varint,5,opt,name=progress_percentage,json=progressPercentage,proto3" json:"progress_percentage,omitempty"`
+	TimeWorkedMinutes  int32                  `protobuf:"varint,6,opt,name=time_worked_minutes,json=timeWorkedMinutes,proto3" json:"time_worked_minutes,omitempty"`
+	IsActive           bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Moves back by the time spent paused each time the assignment is resumed
+	ExpectedEndTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expected_end_time,json=expectedEndTime,proto3" json:"expected_end_time,omitempty"`
+	PausedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
+	PausedMinutes   int32                  `protobuf:"varint,11,opt,name=paused_minutes,json=pausedMinutes,proto3" json:"paused_minutes,omitempty"`
 }
 
-func (x *DeleteJobRequest) Reset() {
-	*x = DeleteJobRequest{}
+func (x *JobProgress) Reset() {
+	*x = JobProgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[19]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteJobRequest) String() string {
+func (x *JobProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJobRequest) ProtoMessage() {}
+func (*JobProgress) ProtoMessage() {}
 
-func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[19]
+func (x *JobProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1639,92 +1630,131 @@ func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
-func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use JobProgress.ProtoReflect.Descriptor instead.
+func (*JobProgress) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeleteJobRequest) GetId() int64 {
+func (x *JobProgress) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteJobResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+func (x *JobProgress) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
 }
 
-func (x *DeleteJobResponse) Reset() {
-	*x = DeleteJobResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JobProgress) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
 	}
+	return nil
 }
 
-func (x *DeleteJobResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JobProgress) GetLastUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedAt
+	}
+	return nil
 }
 
-func (*DeleteJobResponse) ProtoMessage() {}
+func (x *JobProgress) GetProgressPercentage() int32 {
+	if x != nil {
+		return x.ProgressPercentage
+	}
+	return 0
+}
 
-func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JobProgress) GetTimeWorkedMinutes() int32 {
+	if x != nil {
+		return x.TimeWorkedMinutes
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
-func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{20}
+func (x *JobProgress) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
 }
 
-func (x *DeleteJobResponse) GetSuccess() bool {
+func (x *JobProgress) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Success
+		return x.CreatedAt
 	}
-	return false
+	return nil
 }
 
-// Job assignment requests
-type AssignWizardToJobRequest struct {
+func (x *JobProgress) GetExpectedEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedEndTime
+	}
+	return nil
+}
+
+func (x *JobProgress) GetPausedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedAt
+	}
+	return nil
+}
+
+func (x *JobProgress) GetPausedMinutes() int32 {
+	if x != nil {
+		return x.PausedMinutes
+	}
+	return 0
+}
+
+// Job requests
+type CreateJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobId    int64 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	WizardId int64 `protobuf:"varint,2,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	RealmId             int64      `protobuf:"varint,1,opt,name=realm_id,json=realmId,proto3" json:"realm_id,omitempty"`
+	RealmName           string     `protobuf:"bytes,2,opt,name=realm_name,json=realmName,proto3" json:"realm_name,omitempty"`
+	Title               string     `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description         string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	RequiredElement     string     `protobuf:"bytes,5,opt,name=required_element,json=requiredElement,proto3" json:"required_element,omitempty"`
+	RequiredLevel       int32      `protobuf:"varint,6,opt,name=required_level,json=requiredLevel,proto3" json:"required_level,omitempty"`
+	ManaRewardPerHour   int32      `protobuf:"varint,7,opt,name=mana_reward_per_hour,json=manaRewardPerHour,proto3" json:"mana_reward_per_hour,omitempty"`
+	ExpRewardPerHour    int32      `protobuf:"varint,8,opt,name=exp_reward_per_hour,json=expRewardPerHour,proto3" json:"exp_reward_per_hour,omitempty"`
+	DurationMinutes     int32      `protobuf:"varint,9,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	MaxWizards          int32      `protobuf:"varint,10,opt,name=max_wizards,json=maxWizards,proto3" json:"max_wizards,omitempty"`
+	Difficulty          string     `protobuf:"bytes,11,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	JobType             string     `protobuf:"bytes,12,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Location            string     `protobuf:"bytes,13,opt,name=location,proto3" json:"location,omitempty"`
+	SpecialRequirements string     `protobuf:"bytes,14,opt,name=special_requirements,json=specialRequirements,proto3" json:"special_requirements,omitempty"`
+	CreatedByWizardId   int64      `protobuf:"varint,15,opt,name=created_by_wizard_id,json=createdByWizardId,proto3" json:"created_by_wizard_id,omitempty"`
+	PartyRule           *PartyRule `protobuf:"bytes,16,opt,name=party_rule,json=partyRule,proto3" json:"party_rule,omitempty"` // Optional: makes the job a party job
+	// Optional: when a job posted by a wizard stops taking applications
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (x *AssignWizardToJobRequest) Reset() {
-	*x = AssignWizardToJobRequest{}
+func (x *CreateJobRequest) Reset() {
+	*x = CreateJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[21]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssignWizardToJobRequest) String() string {
+func (x *CreateJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignWizardToJobRequest) ProtoMessage() {}
+func (*CreateJobRequest) ProtoMessage() {}
 
-func (x *AssignWizardToJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[21]
+func (x *CreateJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,130 +1765,155 @@ func (x *AssignWizardToJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignWizardToJobRequest.ProtoReflect.Descriptor instead.
-func (*AssignWizardToJobRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CreateJobRequest.ProtoReflect.Descriptor instead.
+func (*CreateJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AssignWizardToJobRequest) GetJobId() int64 {
+func (x *CreateJobRequest) GetRealmId() int64 {
 	if x != nil {
-		return x.JobId
+		return x.RealmId
 	}
 	return 0
 }
 
-func (x *AssignWizardToJobRequest) GetWizardId() int64 {
+func (x *CreateJobRequest) GetRealmName() string {
 	if x != nil {
-		return x.WizardId
+		return x.RealmName
 	}
-	return 0
+	return ""
 }
 
-type GetJobAssignmentsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	WizardId   int64  `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
-	JobId      int64  `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	PageSize   int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageNumber int32  `protobuf:"varint,5,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+func (x *CreateJobRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *GetJobAssignmentsRequest) Reset() {
-	*x = GetJobAssignmentsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateJobRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
+	return ""
 }
 
-func (x *GetJobAssignmentsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateJobRequest) GetRequiredElement() string {
+	if x != nil {
+		return x.RequiredElement
+	}
+	return ""
 }
 
-func (*GetJobAssignmentsRequest) ProtoMessage() {}
+func (x *CreateJobRequest) GetRequiredLevel() int32 {
+	if x != nil {
+		return x.RequiredLevel
+	}
+	return 0
+}
 
-func (x *GetJobAssignmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateJobRequest) GetManaRewardPerHour() int32 {
+	if x != nil {
+		return x.ManaRewardPerHour
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetJobAssignmentsRequest.ProtoReflect.Descriptor instead.
-func (*GetJobAssignmentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{22}
+func (x *CreateJobRequest) GetExpRewardPerHour() int32 {
+	if x != nil {
+		return x.ExpRewardPerHour
+	}
+	return 0
 }
 
-func (x *GetJobAssignmentsRequest) GetWizardId() int64 {
+func (x *CreateJobRequest) GetDurationMinutes() int32 {
 	if x != nil {
-		return x.WizardId
+		return x.DurationMinutes
 	}
 	return 0
 }
 
-func (x *GetJobAssignmentsRequest) GetJobId() int64 {
+func (x *CreateJobRequest) GetMaxWizards() int32 {
 	if x != nil {
-		return x.JobId
+		return x.MaxWizards
 	}
 	return 0
 }
 
-func (x *GetJobAssignmentsRequest) GetStatus() string {
+func (x *CreateJobRequest) GetDifficulty() string {
 	if x != nil {
-		return x.Status
+		return x.Difficulty
 	}
 	return ""
 }
 
-func (x *GetJobAssignmentsRequest) GetPageSize() int32 {
+func (x *CreateJobRequest) GetJobType() string {
 	if x != nil {
-		return x.PageSize
+		return x.JobType
 	}
-	return 0
+	return ""
 }
 
-func (x *GetJobAssignmentsRequest) GetPageNumber() int32 {
+func (x *CreateJobRequest) GetLocation() string {
 	if x != nil {
-		return x.PageNumber
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetSpecialRequirements() string {
+	if x != nil {
+		return x.SpecialRequirements
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetCreatedByWizardId() int64 {
+	if x != nil {
+		return x.CreatedByWizardId
 	}
 	return 0
 }
 
-type GetJobAssignmentsResponse struct {
+func (x *CreateJobRequest) GetPartyRule() *PartyRule {
+	if x != nil {
+		return x.PartyRule
+	}
+	return nil
+}
+
+func (x *CreateJobRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type GetJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Assignments []*JobAssignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
-	TotalCount  int32            `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetJobAssignmentsResponse) Reset() {
-	*x = GetJobAssignmentsResponse{}
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[23]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetJobAssignmentsResponse) String() string {
+func (x *GetJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobAssignmentsResponse) ProtoMessage() {}
+func (*GetJobRequest) ProtoMessage() {}
 
-func (x *GetJobAssignmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[23]
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1869,50 +1924,49 @@ func (x *GetJobAssignmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobAssignmentsResponse.ProtoReflect.Descriptor instead.
-func (*GetJobAssignmentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *GetJobAssignmentsResponse) GetAssignments() []*JobAssignment {
-	if x != nil {
-		return x.Assignments
-	}
-	return nil
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetJobAssignmentsResponse) GetTotalCount() int32 {
+func (x *GetJobRequest) GetId() int64 {
 	if x != nil {
-		return x.TotalCount
+		return x.Id
 	}
 	return 0
 }
 
-type CompleteJobAssignmentRequest struct {
+type ListJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	PageSize   int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageNumber int32  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	Realm      string `protobuf:"bytes,3,opt,name=realm,proto3" json:"realm,omitempty"`
+	Element    string `protobuf:"bytes,4,opt,name=element,proto3" json:"element,omitempty"`
+	Difficulty string `protobuf:"bytes,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	OnlyActive bool   `protobuf:"varint,6,opt,name=only_active,json=onlyActive,proto3" json:"only_active,omitempty"`
+	WizardId   int64  `protobuf:"varint,7,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"` // Optional: include what each job would pay this wizard
 }
 
-func (x *CompleteJobAssignmentRequest) Reset() {
-	*x = CompleteJobAssignmentRequest{}
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[24]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CompleteJobAssignmentRequest) String() string {
+func (x *ListJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteJobAssignmentRequest) ProtoMessage() {}
+func (*ListJobsRequest) ProtoMessage() {}
 
-func (x *CompleteJobAssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[24]
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1923,101 +1977,86 @@ func (x *CompleteJobAssignmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteJobAssignmentRequest.ProtoReflect.Descriptor instead.
-func (*CompleteJobAssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CompleteJobAssignmentRequest) GetAssignmentId() int64 {
+func (x *ListJobsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.AssignmentId
+		return x.PageSize
 	}
 	return 0
 }
 
-type CancelJobAssignmentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	AssignmentId int64  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
-	Reason       string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-}
-
-func (x *CancelJobAssignmentRequest) Reset() {
-	*x = CancelJobAssignmentRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListJobsRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
 	}
+	return 0
 }
 
-func (x *CancelJobAssignmentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListJobsRequest) GetRealm() string {
+	if x != nil {
+		return x.Realm
+	}
+	return ""
 }
 
-func (*CancelJobAssignmentRequest) ProtoMessage() {}
-
-func (x *CancelJobAssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListJobsRequest) GetElement() string {
+	if x != nil {
+		return x.Element
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CancelJobAssignmentRequest.ProtoReflect.Descriptor instead.
-func (*CancelJobAssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{25}
+func (x *ListJobsRequest) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
 }
 
-func (x *CancelJobAssignmentRequest) GetAssignmentId() int64 {
+func (x *ListJobsRequest) GetOnlyActive() bool {
 	if x != nil {
-		return x.AssignmentId
+		return x.OnlyActive
 	}
-	return 0
+	return false
 }
 
-func (x *CancelJobAssignmentRequest) GetReason() string {
+func (x *ListJobsRequest) GetWizardId() int64 {
 	if x != nil {
-		return x.Reason
+		return x.WizardId
 	}
-	return ""
+	return 0
 }
 
-// Job progress requests
-type UpdateJobProgressRequest struct {
+type ListJobsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssignmentId       int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
-	ProgressPercentage int32 `protobuf:"varint,2,opt,name=progress_percentage,json=progressPercentage,proto3" json:"progress_percentage,omitempty"`
-	TimeWorkedMinutes  int32 `protobuf:"varint,3,opt,name=time_worked_minutes,json=timeWorkedMinutes,proto3" json:"time_worked_minutes,omitempty"`
+	Jobs       []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	TotalCount int32  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 }
 
-func (x *UpdateJobProgressRequest) Reset() {
-	*x = UpdateJobProgressRequest{}
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[26]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateJobProgressRequest) String() string {
+func (x *ListJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJobProgressRequest) ProtoMessage() {}
+func (*ListJobsResponse) ProtoMessage() {}
 
-func (x *UpdateJobProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[26]
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2028,57 +2067,56 @@ func (x *UpdateJobProgressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJobProgressRequest.ProtoReflect.Descriptor instead.
-func (*UpdateJobProgressRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *UpdateJobProgressRequest) GetAssignmentId() int64 {
-	if x != nil {
-		return x.AssignmentId
-	}
-	return 0
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateJobProgressRequest) GetProgressPercentage() int32 {
+func (x *ListJobsResponse) GetJobs() []*Job {
 	if x != nil {
-		return x.ProgressPercentage
+		return x.Jobs
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateJobProgressRequest) GetTimeWorkedMinutes() int32 {
+func (x *ListJobsResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.TimeWorkedMinutes
+		return x.TotalCount
 	}
 	return 0
 }
 
-type GetJobProgressRequest struct {
+type UpdateJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Id                int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title             string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ManaRewardPerHour int32  `protobuf:"varint,4,opt,name=mana_reward_per_hour,json=manaRewardPerHour,proto3" json:"mana_reward_per_hour,omitempty"`
+	ExpRewardPerHour  int32  `protobuf:"varint,5,opt,name=exp_reward_per_hour,json=expRewardPerHour,proto3" json:"exp_reward_per_hour,omitempty"`
+	MaxWizards        int32  `protobuf:"varint,6,opt,name=max_wizards,json=maxWizards,proto3" json:"max_wizards,omitempty"`
+	IsActive          bool   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 }
 
-func (x *GetJobProgressRequest) Reset() {
-	*x = GetJobProgressRequest{}
+func (x *UpdateJobRequest) Reset() {
+	*x = UpdateJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[27]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetJobProgressRequest) String() string {
+func (x *UpdateJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobProgressRequest) ProtoMessage() {}
+func (*UpdateJobRequest) ProtoMessage() {}
 
-func (x *GetJobProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[27]
+func (x *UpdateJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2089,124 +2127,85 @@ func (x *GetJobProgressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobProgressRequest.ProtoReflect.Descriptor instead.
-func (*GetJobProgressRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use UpdateJobRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetJobProgressRequest) GetAssignmentId() int64 {
+func (x *UpdateJobRequest) GetId() int64 {
 	if x != nil {
-		return x.AssignmentId
+		return x.Id
 	}
 	return 0
 }
 
-// Activity log requests
-type GetActivitiesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId       int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	WizardId     int64  `protobuf:"varint,2,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
-	ActivityType string `protobuf:"bytes,3,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
-	PageSize     int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageNumber   int32  `protobuf:"varint,5,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-}
-
-func (x *GetActivitiesRequest) Reset() {
-	*x = GetActivitiesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetActivitiesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetActivitiesRequest) ProtoMessage() {}
-
-func (x *GetActivitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UpdateJobRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetActivitiesRequest.ProtoReflect.Descriptor instead.
-func (*GetActivitiesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{28}
+	return ""
 }
 
-func (x *GetActivitiesRequest) GetUserId() int64 {
+func (x *UpdateJobRequest) GetDescription() string {
 	if x != nil {
-		return x.UserId
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *GetActivitiesRequest) GetWizardId() int64 {
+func (x *UpdateJobRequest) GetManaRewardPerHour() int32 {
 	if x != nil {
-		return x.WizardId
+		return x.ManaRewardPerHour
 	}
 	return 0
 }
 
-func (x *GetActivitiesRequest) GetActivityType() string {
+func (x *UpdateJobRequest) GetExpRewardPerHour() int32 {
 	if x != nil {
-		return x.ActivityType
+		return x.ExpRewardPerHour
 	}
-	return ""
+	return 0
 }
 
-func (x *GetActivitiesRequest) GetPageSize() int32 {
+func (x *UpdateJobRequest) GetMaxWizards() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.MaxWizards
 	}
 	return 0
 }
 
-func (x *GetActivitiesRequest) GetPageNumber() int32 {
+func (x *UpdateJobRequest) GetIsActive() bool {
 	if x != nil {
-		return x.PageNumber
+		return x.IsActive
 	}
-	return 0
+	return false
 }
 
-type GetActivitiesResponse struct {
+type DeleteJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Activities []*ActivityLog `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
-	TotalCount int32          `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetActivitiesResponse) Reset() {
-	*x = GetActivitiesResponse{}
+func (x *DeleteJobRequest) Reset() {
+	*x = DeleteJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[29]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetActivitiesResponse) String() string {
+func (x *DeleteJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActivitiesResponse) ProtoMessage() {}
+func (*DeleteJobRequest) ProtoMessage() {}
 
-func (x *GetActivitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[29]
+func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2217,56 +2216,43 @@ func (x *GetActivitiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActivitiesResponse.ProtoReflect.Descriptor instead.
-func (*GetActivitiesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *GetActivitiesResponse) GetActivities() []*ActivityLog {
-	if x != nil {
-		return x.Activities
-	}
-	return nil
+// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetActivitiesResponse) GetTotalCount() int32 {
+func (x *DeleteJobRequest) GetId() int64 {
 	if x != nil {
-		return x.TotalCount
+		return x.Id
 	}
 	return 0
 }
 
-type ActivityLog struct {
+type DeleteJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId              int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	WizardId            int64                  `protobuf:"varint,3,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
-	ActivityType        string                 `protobuf:"bytes,4,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
-	ActivityDescription string                 `protobuf:"bytes,5,opt,name=activity_description,json=activityDescription,proto3" json:"activity_description,omitempty"`
-	Metadata            string                 `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *ActivityLog) Reset() {
-	*x = ActivityLog{}
+func (x *DeleteJobResponse) Reset() {
+	*x = DeleteJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[30]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActivityLog) String() string {
+func (x *DeleteJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityLog) ProtoMessage() {}
+func (*DeleteJobResponse) ProtoMessage() {}
 
-func (x *ActivityLog) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[30]
+func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2277,84 +2263,100 @@ func (x *ActivityLog) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityLog.ProtoReflect.Descriptor instead.
-func (*ActivityLog) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ActivityLog) GetId() int64 {
+func (x *DeleteJobResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *ActivityLog) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Job assignment requests
+type AssignWizardToJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId    int64 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	WizardId int64 `protobuf:"varint,2,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
 }
 
-func (x *ActivityLog) GetWizardId() int64 {
-	if x != nil {
-		return x.WizardId
+func (x *AssignWizardToJobRequest) Reset() {
+	*x = AssignWizardToJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ActivityLog) GetActivityType() string {
-	if x != nil {
-		return x.ActivityType
-	}
-	return ""
+func (x *AssignWizardToJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ActivityLog) GetActivityDescription() string {
-	if x != nil {
-		return x.ActivityDescription
+func (*AssignWizardToJobRequest) ProtoMessage() {}
+
+func (x *AssignWizardToJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ActivityLog) GetMetadata() string {
+// Deprecated: Use AssignWizardToJobRequest.ProtoReflect.Descriptor instead.
+func (*AssignWizardToJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AssignWizardToJobRequest) GetJobId() int64 {
 	if x != nil {
-		return x.Metadata
+		return x.JobId
 	}
-	return ""
+	return 0
 }
 
-func (x *ActivityLog) GetCreatedAt() *timestamppb.Timestamp {
+func (x *AssignWizardToJobRequest) GetWizardId() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.WizardId
 	}
-	return nil
+	return 0
 }
 
-// Realm messages
-type GetRealmsRequest struct {
+type AssignPartyToJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	JobId     int64   `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	WizardIds []int64 `protobuf:"varint,2,rep,packed,name=wizard_ids,json=wizardIds,proto3" json:"wizard_ids,omitempty"`
 }
 
-func (x *GetRealmsRequest) Reset() {
-	*x = GetRealmsRequest{}
+func (x *AssignPartyToJobRequest) Reset() {
+	*x = AssignPartyToJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[31]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRealmsRequest) String() string {
+func (x *AssignPartyToJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRealmsRequest) ProtoMessage() {}
+func (*AssignPartyToJobRequest) ProtoMessage() {}
 
-func (x *GetRealmsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[31]
+func (x *AssignPartyToJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2365,36 +2367,50 @@ func (x *GetRealmsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRealmsRequest.ProtoReflect.Descriptor instead.
-func (*GetRealmsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use AssignPartyToJobRequest.ProtoReflect.Descriptor instead.
+func (*AssignPartyToJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{27}
 }
 
-type GetRealmsResponse struct {
+func (x *AssignPartyToJobRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *AssignPartyToJobRequest) GetWizardIds() []int64 {
+	if x != nil {
+		return x.WizardIds
+	}
+	return nil
+}
+
+type GetJobPartyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Realms []*Realm `protobuf:"bytes,1,rep,name=realms,proto3" json:"realms,omitempty"`
+	PartyId int64 `protobuf:"varint,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
 }
 
-func (x *GetRealmsResponse) Reset() {
-	*x = GetRealmsResponse{}
+func (x *GetJobPartyRequest) Reset() {
+	*x = GetJobPartyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[32]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRealmsResponse) String() string {
+func (x *GetJobPartyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRealmsResponse) ProtoMessage() {}
+func (*GetJobPartyRequest) ProtoMessage() {}
 
-func (x *GetRealmsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[32]
+func (x *GetJobPartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2405,45 +2421,45 @@ func (x *GetRealmsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRealmsResponse.ProtoReflect.Descriptor instead.
-func (*GetRealmsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use GetJobPartyRequest.ProtoReflect.Descriptor instead.
+func (*GetJobPartyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetRealmsResponse) GetRealms() []*Realm {
+func (x *GetJobPartyRequest) GetPartyId() int64 {
 	if x != nil {
-		return x.Realms
+		return x.PartyId
 	}
-	return nil
+	return 0
 }
 
-type Realm struct {
+type ApplyToJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	JobId    int64  `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	WizardId int64  `protobuf:"varint,2,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	Message  string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *Realm) Reset() {
-	*x = Realm{}
+func (x *ApplyToJobRequest) Reset() {
+	*x = ApplyToJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[33]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Realm) String() string {
+func (x *ApplyToJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Realm) ProtoMessage() {}
+func (*ApplyToJobRequest) ProtoMessage() {}
 
-func (x *Realm) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[33]
+func (x *ApplyToJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2454,58 +2470,59 @@ func (x *Realm) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Realm.ProtoReflect.Descriptor instead.
-func (*Realm) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ApplyToJobRequest.ProtoReflect.Descriptor instead.
+func (*ApplyToJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Realm) GetId() int64 {
+func (x *ApplyToJobRequest) GetJobId() int64 {
 	if x != nil {
-		return x.Id
+		return x.JobId
 	}
 	return 0
 }
 
-func (x *Realm) GetName() string {
+func (x *ApplyToJobRequest) GetWizardId() int64 {
 	if x != nil {
-		return x.Name
+		return x.WizardId
 	}
-	return ""
+	return 0
 }
 
-func (x *Realm) GetDescription() string {
+func (x *ApplyToJobRequest) GetMessage() string {
 	if x != nil {
-		return x.Description
+		return x.Message
 	}
 	return ""
 }
 
-// Mana management messages
-type GetManaBalanceRequest struct {
+type ListJobApplicationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WizardId int64 `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	JobId    int64  `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`          // Applications to a job, or
+	WizardId int64  `protobuf:"varint,2,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"` // a wizard's applications
+	Status   string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *GetManaBalanceRequest) Reset() {
-	*x = GetManaBalanceRequest{}
+func (x *ListJobApplicationsRequest) Reset() {
+	*x = ListJobApplicationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[34]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetManaBalanceRequest) String() string {
+func (x *ListJobApplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetManaBalanceRequest) ProtoMessage() {}
+func (*ListJobApplicationsRequest) ProtoMessage() {}
 
-func (x *GetManaBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[34]
+func (x *ListJobApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2516,43 +2533,57 @@ func (x *GetManaBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetManaBalanceRequest.ProtoReflect.Descriptor instead.
-func (*GetManaBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ListJobApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetManaBalanceRequest) GetWizardId() int64 {
+func (x *ListJobApplicationsRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *ListJobApplicationsRequest) GetWizardId() int64 {
 	if x != nil {
 		return x.WizardId
 	}
 	return 0
 }
 
-type GetManaBalanceResponse struct {
+func (x *ListJobApplicationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListJobApplicationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Applications []*JobApplication `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
 }
 
-func (x *GetManaBalanceResponse) Reset() {
-	*x = GetManaBalanceResponse{}
+func (x *ListJobApplicationsResponse) Reset() {
+	*x = ListJobApplicationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[35]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetManaBalanceResponse) String() string {
+func (x *ListJobApplicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetManaBalanceResponse) ProtoMessage() {}
+func (*ListJobApplicationsResponse) ProtoMessage() {}
 
-func (x *GetManaBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[35]
+func (x *ListJobApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2563,45 +2594,44 @@ func (x *GetManaBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetManaBalanceResponse.ProtoReflect.Descriptor instead.
-func (*GetManaBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ListJobApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetManaBalanceResponse) GetBalance() int64 {
+func (x *ListJobApplicationsResponse) GetApplications() []*JobApplication {
 	if x != nil {
-		return x.Balance
+		return x.Applications
 	}
-	return 0
+	return nil
 }
 
-type UpdateManaBalanceRequest struct {
+type AcceptJobApplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WizardId int64  `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
-	Amount   int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // Can be positive (add) or negative (subtract)
-	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`  // Optional reason for the update
+	ApplicationId  int64 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	PosterWizardId int64 `protobuf:"varint,2,opt,name=poster_wizard_id,json=posterWizardId,proto3" json:"poster_wizard_id,omitempty"` // The wizard deciding, who must have posted the job
 }
 
-func (x *UpdateManaBalanceRequest) Reset() {
-	*x = UpdateManaBalanceRequest{}
+func (x *AcceptJobApplicationRequest) Reset() {
+	*x = AcceptJobApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[36]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateManaBalanceRequest) String() string {
+func (x *AcceptJobApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateManaBalanceRequest) ProtoMessage() {}
+func (*AcceptJobApplicationRequest) ProtoMessage() {}
 
-func (x *UpdateManaBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[36]
+func (x *AcceptJobApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2612,58 +2642,51 @@ func (x *UpdateManaBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateManaBalanceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateManaBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use AcceptJobApplicationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptJobApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *UpdateManaBalanceRequest) GetWizardId() int64 {
+func (x *AcceptJobApplicationRequest) GetApplicationId() int64 {
 	if x != nil {
-		return x.WizardId
+		return x.ApplicationId
 	}
 	return 0
 }
 
-func (x *UpdateManaBalanceRequest) GetAmount() int64 {
+func (x *AcceptJobApplicationRequest) GetPosterWizardId() int64 {
 	if x != nil {
-		return x.Amount
+		return x.PosterWizardId
 	}
 	return 0
 }
 
-func (x *UpdateManaBalanceRequest) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-type UpdateManaBalanceResponse struct {
+type RejectJobApplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NewBalance int64 `protobuf:"varint,1,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
-	Success    bool  `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	ApplicationId  int64 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	PosterWizardId int64 `protobuf:"varint,2,opt,name=poster_wizard_id,json=posterWizardId,proto3" json:"poster_wizard_id,omitempty"` // The wizard deciding, who must have posted the job
 }
 
-func (x *UpdateManaBalanceResponse) Reset() {
-	*x = UpdateManaBalanceResponse{}
+func (x *RejectJobApplicationRequest) Reset() {
+	*x = RejectJobApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[37]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateManaBalanceResponse) String() string {
+func (x *RejectJobApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateManaBalanceResponse) ProtoMessage() {}
+func (*RejectJobApplicationRequest) ProtoMessage() {}
 
-func (x *UpdateManaBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[37]
+func (x *RejectJobApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2674,53 +2697,70 @@ func (x *UpdateManaBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateManaBalanceResponse.ProtoReflect.Descriptor instead.
-func (*UpdateManaBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use RejectJobApplicationRequest.ProtoReflect.Descriptor instead.
+func (*RejectJobApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *UpdateManaBalanceResponse) GetNewBalance() int64 {
+func (x *RejectJobApplicationRequest) GetApplicationId() int64 {
 	if x != nil {
-		return x.NewBalance
+		return x.ApplicationId
 	}
 	return 0
 }
 
-func (x *UpdateManaBalanceResponse) GetSuccess() bool {
+func (x *RejectJobApplicationRequest) GetPosterWizardId() int64 {
 	if x != nil {
-		return x.Success
+		return x.PosterWizardId
 	}
-	return false
+	return 0
 }
 
-type TransferManaRequest struct {
+// JobTemplateSpec is the admin-editable part of a job template. Set exactly one
+// of interval_minutes and cron.
+type JobTemplateSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FromWizardId int64  `protobuf:"varint,1,opt,name=from_wizard_id,json=fromWizardId,proto3" json:"from_wizard_id,omitempty"`
-	ToWizardId   int64  `protobuf:"varint,2,opt,name=to_wizard_id,json=toWizardId,proto3" json:"to_wizard_id,omitempty"`
-	Amount       int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Reason       string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // Optional reason for the transfer
-}
-
-func (x *TransferManaRequest) Reset() {
-	*x = TransferManaRequest{}
+	RealmId                int64  `protobuf:"varint,1,opt,name=realm_id,json=realmId,proto3" json:"realm_id,omitempty"` // 0 posts the job in every realm
+	Title                  string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description            string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RequiredElement        string `protobuf:"bytes,4,opt,name=required_element,json=requiredElement,proto3" json:"required_element,omitempty"` // Empty takes the element of each realm
+	RequiredLevel          int32  `protobuf:"varint,5,opt,name=required_level,json=requiredLevel,proto3" json:"required_level,omitempty"`
+	ManaRewardPerHour      int32  `protobuf:"varint,6,opt,name=mana_reward_per_hour,json=manaRewardPerHour,proto3" json:"mana_reward_per_hour,omitempty"`
+	ExpRewardPerHour       int32  `protobuf:"varint,7,opt,name=exp_reward_per_hour,json=expRewardPerHour,proto3" json:"exp_reward_per_hour,omitempty"`
+	DurationMinutes        int32  `protobuf:"varint,8,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	MaxWizards             int32  `protobuf:"varint,9,opt,name=max_wizards,json=maxWizards,proto3" json:"max_wizards,omitempty"`
+	Difficulty             string `protobuf:"bytes,10,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	JobType                string `protobuf:"bytes,11,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Location               string `protobuf:"bytes,12,opt,name=location,proto3" json:"location,omitempty"`
+	SpecialRequirements    string `protobuf:"bytes,13,opt,name=special_requirements,json=specialRequirements,proto3" json:"special_requirements,omitempty"`
+	IntervalMinutes        int32  `protobuf:"varint,14,opt,name=interval_minutes,json=intervalMinutes,proto3" json:"interval_minutes,omitempty"`
+	Cron                   string `protobuf:"bytes,15,opt,name=cron,proto3" json:"cron,omitempty"`                                                                      // Five-field cron expression or @hourly style descriptor, in UTC
+	PostingLifetimeMinutes int32  `protobuf:"varint,16,opt,name=posting_lifetime_minutes,json=postingLifetimeMinutes,proto3" json:"posting_lifetime_minutes,omitempty"` // How long each job stays on the board
+	// Optional: the window the template posts jobs in
+	ActiveFrom  *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`
+	ActiveUntil *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=active_until,json=activeUntil,proto3" json:"active_until,omitempty"`
+}
+
+func (x *JobTemplateSpec) Reset() {
+	*x = JobTemplateSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[38]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferManaRequest) String() string {
+func (x *JobTemplateSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferManaRequest) ProtoMessage() {}
+func (*JobTemplateSpec) ProtoMessage() {}
 
-func (x *TransferManaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[38]
+func (x *JobTemplateSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2731,65 +2771,170 @@ func (x *TransferManaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferManaRequest.ProtoReflect.Descriptor instead.
-func (*TransferManaRequest) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use JobTemplateSpec.ProtoReflect.Descriptor instead.
+func (*JobTemplateSpec) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *TransferManaRequest) GetFromWizardId() int64 {
+func (x *JobTemplateSpec) GetRealmId() int64 {
 	if x != nil {
-		return x.FromWizardId
+		return x.RealmId
 	}
 	return 0
 }
 
-func (x *TransferManaRequest) GetToWizardId() int64 {
+func (x *JobTemplateSpec) GetTitle() string {
 	if x != nil {
-		return x.ToWizardId
+		return x.Title
+	}
+	return ""
+}
+
+func (x *JobTemplateSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JobTemplateSpec) GetRequiredElement() string {
+	if x != nil {
+		return x.RequiredElement
+	}
+	return ""
+}
+
+func (x *JobTemplateSpec) GetRequiredLevel() int32 {
+	if x != nil {
+		return x.RequiredLevel
 	}
 	return 0
 }
 
-func (x *TransferManaRequest) GetAmount() int64 {
+func (x *JobTemplateSpec) GetManaRewardPerHour() int32 {
 	if x != nil {
-		return x.Amount
+		return x.ManaRewardPerHour
 	}
 	return 0
 }
 
-func (x *TransferManaRequest) GetReason() string {
+func (x *JobTemplateSpec) GetExpRewardPerHour() int32 {
 	if x != nil {
-		return x.Reason
+		return x.ExpRewardPerHour
+	}
+	return 0
+}
+
+func (x *JobTemplateSpec) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *JobTemplateSpec) GetMaxWizards() int32 {
+	if x != nil {
+		return x.MaxWizards
+	}
+	return 0
+}
+
+func (x *JobTemplateSpec) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
 	}
 	return ""
 }
 
-type TransferManaResponse struct {
+func (x *JobTemplateSpec) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *JobTemplateSpec) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *JobTemplateSpec) GetSpecialRequirements() string {
+	if x != nil {
+		return x.SpecialRequirements
+	}
+	return ""
+}
+
+func (x *JobTemplateSpec) GetIntervalMinutes() int32 {
+	if x != nil {
+		return x.IntervalMinutes
+	}
+	return 0
+}
+
+func (x *JobTemplateSpec) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *JobTemplateSpec) GetPostingLifetimeMinutes() int32 {
+	if x != nil {
+		return x.PostingLifetimeMinutes
+	}
+	return 0
+}
+
+func (x *JobTemplateSpec) GetActiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return nil
+}
+
+func (x *JobTemplateSpec) GetActiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveUntil
+	}
+	return nil
+}
+
+// A job template posts fresh jobs on a schedule
+type JobTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec      *JobTemplateSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	RealmName string                 `protobuf:"bytes,3,opt,name=realm_name,json=realmName,proto3" json:"realm_name,omitempty"` // Empty when the template posts in every realm
+	IsActive  bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`   // False once paused or past active_until
+	NextRunAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	LastRunAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
 
-func (x *TransferManaResponse) Reset() {
-	*x = TransferManaResponse{}
+func (x *JobTemplate) Reset() {
+	*x = JobTemplate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_wizard_wizard_proto_msgTypes[39]
+		mi := &file_proto_wizard_wizard_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferManaResponse) String() string {
+func (x *JobTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferManaResponse) ProtoMessage() {}
+func (*JobTemplate) ProtoMessage() {}
 
-func (x *TransferManaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_wizard_wizard_proto_msgTypes[39]
+func (x *JobTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2800,652 +2945,4296 @@ func (x *TransferManaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferManaResponse.ProtoReflect.Descriptor instead.
-func (*TransferManaResponse) Descriptor() ([]byte, []int) {
-	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use JobTemplate.ProtoReflect.Descriptor instead.
+func (*JobTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *TransferManaResponse) GetSuccess() bool {
+func (x *JobTemplate) GetId() int64 {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-func (x *TransferManaResponse) GetMessage() string {
+func (x *JobTemplate) GetSpec() *JobTemplateSpec {
 	if x != nil {
-		return x.Message
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *JobTemplate) GetRealmName() string {
+	if x != nil {
+		return x.RealmName
 	}
 	return ""
 }
 
-var File_proto_wizard_wizard_proto protoreflect.FileDescriptor
+func (x *JobTemplate) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
 
-var file_proto_wizard_wizard_proto_rawDesc = []byte{
-	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2f, 0x77,
-	0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x77, 0x69, 0x7a,
-	0x61, 0x72, 0x64, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf6, 0x02, 0x0a, 0x06, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61,
-	0x6c, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x61, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x6e, 0x61, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x23, 0x0a, 0x05, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x67,
-	0x75, 0x69, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
-	0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x2b, 0x0a,
-	0x05, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x13, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
-	0x65, 0x61, 0x6c, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x22,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x69, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61,
-	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65,
-	0x61, 0x6c, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x81, 0x01,
-	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72,
-	0x65, 0x61, 0x6c, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c,
-	0x6d, 0x22, 0x60, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x77, 0x69, 0x7a, 0x61,
-	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x77, 0x69, 0x7a, 0x61,
-	0x72, 0x64, 0x2e, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x07, 0x77, 0x69, 0x7a, 0x61, 0x72,
-	0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x69, 0x7a,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x4e, 0x0a, 0x10,
-	0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x11,
-	0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x82,
-	0x06, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x45, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61,
-	0x6e, 0x61, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f,
-	0x75, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x6e, 0x61, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x2d, 0x0a, 0x13, 0x65,
-	0x78, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f,
-	0x75, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69,
-	0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x7a,
-	0x61, 0x72, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x57,
-	0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x6c, 0x79, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
-	0x6c, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69,
-	0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x14,
-	0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x70, 0x65, 0x63,
-	0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x2f, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x77, 0x69,
-	0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64,
-	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x12,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x22, 0xe9, 0x03, 0x0a, 0x0d, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x7a,
-	0x61, 0x72, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e,
-	0x61, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x6d, 0x61, 0x6e, 0x61, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78,
-	0x70, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x65, 0x78, 0x70, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74,
-	0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12,
-	0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x77,
-	0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x2f,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0xfa, 0x02, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f,
-	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x12, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x6f, 0x72,
-	0x6b, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x64, 0x4d, 0x69, 0x6e,
-	0x75, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xbd, 0x04, 0x0a,
-	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f,
-	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25,
-	0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x6e, 0x61, 0x5f, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50,
-	0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x2d, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x5f, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65,
-	0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64,
-	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
-	0x79, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x14, 0x73, 0x70, 0x65, 0x63,
-	0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
-	0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x42, 0x79, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x1f, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc0, 0x01,
-	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x6e, 0x6c, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x22, 0x54, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x52,
-	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x6e, 0x61, 0x5f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x11, 0x6d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72,
-	0x48, 0x6f, 0x75, 0x72, 0x12, 0x2d, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x48,
-	0x6f, 0x75, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72,
-	0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x57, 0x69, 0x7a,
-	0x61, 0x72, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x22, 0x4e, 0x0a, 0x18, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x57, 0x69,
-	0x7a, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72,
-	0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61,
-	0x72, 0x64, 0x49, 0x64, 0x22, 0xa4, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x15,
-	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x75, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x43, 0x0a, 0x1c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
-	0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62,
-	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x6f,
-	0x72, 0x6b, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x64, 0x4d, 0x69,
-	0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
-	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x6d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
-	0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x4c, 0x6f, 0x67, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x82, 0x02, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x4c, 0x6f, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x31, 0x0a, 0x14, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x6c,
-	0x6d, 0x52, 0x06, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x22, 0x4d, 0x0a, 0x05, 0x52, 0x65, 0x61,
-	0x6c, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x32,
-	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x22, 0x67, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x19, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e,
-	0x65, 0x77, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x4d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x66,
-	0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49,
-	0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x57, 0x69, 0x7a, 0x61, 0x72,
-	0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d,
-	0x61, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32,
-	0xf0, 0x0c, 0x0a, 0x0d, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61, 0x72,
-	0x64, 0x12, 0x1b, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
-	0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x22, 0x00,
-	0x12, 0x37, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x12, 0x18, 0x2e,
-	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
-	0x2e, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x2e, 0x77, 0x69, 0x7a, 0x61,
-	0x72, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e,
-	0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
-	0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1a, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61,
-	0x72, 0x64, 0x12, 0x1b, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
-	0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x37, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x18, 0x2e, 0x77,
-	0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e,
-	0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x19, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e,
-	0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0e, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x57, 0x69, 0x7a, 0x61, 0x72,
-	0x64, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62,
-	0x12, 0x18, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x77, 0x69, 0x7a,
-	0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x06, 0x47, 0x65, 0x74,
-	0x4a, 0x6f, 0x62, 0x12, 0x15, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74,
-	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x77, 0x69, 0x7a,
-	0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08, 0x4c, 0x69, 0x73,
-	0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x17, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x18, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0b, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x22, 0x00,
-	0x12, 0x42, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x18, 0x2e,
-	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x57, 0x69,
-	0x7a, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x4a, 0x6f, 0x62, 0x12, 0x20, 0x2e, 0x77, 0x69, 0x7a, 0x61,
-	0x72, 0x64, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x54,
-	0x6f, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x77, 0x69,
-	0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x77, 0x69, 0x7a, 0x61,
-	0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x77, 0x69,
-	0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x56, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x77, 0x69, 0x7a, 0x61,
-	0x72, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x15, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x22, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a,
-	0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x20, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62,
-	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x2e, 0x77,
-	0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x77, 0x69,
-	0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x12,
-	0x18, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c,
-	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x69, 0x7a, 0x61,
-	0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e,
-	0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72,
-	0x64, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20,
-	0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
-	0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x4d, 0x61, 0x6e, 0x61, 0x12, 0x1b, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x78, 0x2f, 0x6d, 0x79, 0x73, 0x74, 0x69, 0x63, 0x66, 0x75,
-	0x6e, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *JobTemplate) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
 }
 
-var (
-	file_proto_wizard_wizard_proto_rawDescOnce sync.Once
-	file_proto_wizard_wizard_proto_rawDescData = file_proto_wizard_wizard_proto_rawDesc
-)
+func (x *JobTemplate) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
 
-func file_proto_wizard_wizard_proto_rawDescGZIP() []byte {
-	file_proto_wizard_wizard_proto_rawDescOnce.Do(func() {
-		file_proto_wizard_wizard_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_wizard_wizard_proto_rawDescData)
-	})
-	return file_proto_wizard_wizard_proto_rawDescData
+func (x *JobTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-var file_proto_wizard_wizard_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
-var file_proto_wizard_wizard_proto_goTypes = []any{
-	(*Wizard)(nil),                       // 0: wizard.Wizard
-	(*Guild)(nil),                        // 1: wizard.Guild
-	(*CreateWizardRequest)(nil),          // 2: wizard.CreateWizardRequest
-	(*GetWizardRequest)(nil),             // 3: wizard.GetWizardRequest
-	(*UpdateWizardRequest)(nil),          // 4: wizard.UpdateWizardRequest
-	(*ListWizardsRequest)(nil),           // 5: wizard.ListWizardsRequest
-	(*ListWizardsResponse)(nil),          // 6: wizard.ListWizardsResponse
-	(*DeleteWizardRequest)(nil),          // 7: wizard.DeleteWizardRequest
-	(*DeleteWizardResponse)(nil),         // 8: wizard.DeleteWizardResponse
-	(*JoinGuildRequest)(nil),             // 9: wizard.JoinGuildRequest
-	(*LeaveGuildRequest)(nil),            // 10: wizard.LeaveGuildRequest
-	(*Job)(nil),                          // 11: wizard.Job
-	(*JobAssignment)(nil),                // 12: wizard.JobAssignment
-	(*JobProgress)(nil),                  // 13: wizard.JobProgress
-	(*CreateJobRequest)(nil),             // 14: wizard.CreateJobRequest
-	(*GetJobRequest)(nil),                // 15: wizard.GetJobRequest
-	(*ListJobsRequest)(nil),              // 16: wizard.ListJobsRequest
-	(*ListJobsResponse)(nil),             // 17: wizard.ListJobsResponse
-	(*UpdateJobRequest)(nil),             // 18: wizard.UpdateJobRequest
-	(*DeleteJobRequest)(nil),             // 19: wizard.DeleteJobRequest
-	(*DeleteJobResponse)(nil),            // 20: wizard.DeleteJobResponse
-	(*AssignWizardToJobRequest)(nil),     // 21: wizard.AssignWizardToJobRequest
-	(*GetJobAssignmentsRequest)(nil),     // 22: wizard.GetJobAssignmentsRequest
-	(*GetJobAssignmentsResponse)(nil),    // 23: wizard.GetJobAssignmentsResponse
-	(*CompleteJobAssignmentRequest)(nil), // 24: wizard.CompleteJobAssignmentRequest
-	(*CancelJobAssignmentRequest)(nil),   // 25: wizard.CancelJobAssignmentRequest
-	(*UpdateJobProgressRequest)(nil),     // 26: wizard.UpdateJobProgressRequest
-	(*GetJobProgressRequest)(nil),        // 27: wizard.GetJobProgressRequest
-	(*GetActivitiesRequest)(nil),         // 28: wizard.GetActivitiesRequest
-	(*GetActivitiesResponse)(nil),        // 29: wizard.GetActivitiesResponse
-	(*ActivityLog)(nil),                  // 30: wizard.ActivityLog
-	(*GetRealmsRequest)(nil),             // 31: wizard.GetRealmsRequest
-	(*GetRealmsResponse)(nil),            // 32: wizard.GetRealmsResponse
-	(*Realm)(nil),                        // 33: wizard.Realm
-	(*GetManaBalanceRequest)(nil),        // 34: wizard.GetManaBalanceRequest
-	(*GetManaBalanceResponse)(nil),       // 35: wizard.GetManaBalanceResponse
-	(*UpdateManaBalanceRequest)(nil),     // 36: wizard.UpdateManaBalanceRequest
-	(*UpdateManaBalanceResponse)(nil),    // 37: wizard.UpdateManaBalanceResponse
-	(*TransferManaRequest)(nil),          // 38: wizard.TransferManaRequest
-	(*TransferManaResponse)(nil),         // 39: wizard.TransferManaResponse
-	(*timestamppb.Timestamp)(nil),        // 40: google.protobuf.Timestamp
+func (x *JobTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
-var file_proto_wizard_wizard_proto_depIdxs = []int32{
-	1,  // 0: wizard.Wizard.guild:type_name -> wizard.Guild
-	40, // 1: wizard.Wizard.created_at:type_name -> google.protobuf.Timestamp
-	40, // 2: wizard.Wizard.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: wizard.ListWizardsResponse.wizards:type_name -> wizard.Wizard
-	40, // 4: wizard.Job.created_at:type_name -> google.protobuf.Timestamp
-	40, // 5: wizard.Job.updated_at:type_name -> google.protobuf.Timestamp
-	40, // 6: wizard.JobAssignment.assigned_at:type_name -> google.protobuf.Timestamp
-	40, // 7: wizard.JobAssignment.started_at:type_name -> google.protobuf.Timestamp
-	40, // 8: wizard.JobAssignment.completed_at:type_name -> google.protobuf.Timestamp
-	11, // 9: wizard.JobAssignment.job:type_name -> wizard.Job
-	13, // 10: wizard.JobAssignment.progress:type_name -> wizard.JobProgress
-	40, // 11: wizard.JobProgress.started_at:type_name -> google.protobuf.Timestamp
-	40, // 12: wizard.JobProgress.last_updated_at:type_name -> google.protobuf.Timestamp
-	40, // 13: wizard.JobProgress.created_at:type_name -> google.protobuf.Timestamp
-	11, // 14: wizard.ListJobsResponse.jobs:type_name -> wizard.Job
-	12, // 15: wizard.GetJobAssignmentsResponse.assignments:type_name -> wizard.JobAssignment
-	30, // 16: wizard.GetActivitiesResponse.activities:type_name -> wizard.ActivityLog
-	40, // 17: wizard.ActivityLog.created_at:type_name -> google.protobuf.Timestamp
-	33, // 18: wizard.GetRealmsResponse.realms:type_name -> wizard.Realm
-	2,  // 19: wizard.WizardService.CreateWizard:input_type -> wizard.CreateWizardRequest
-	3,  // 20: wizard.WizardService.GetWizard:input_type -> wizard.GetWizardRequest
-	4,  // 21: wizard.WizardService.UpdateWizard:input_type -> wizard.UpdateWizardRequest
-	5,  // 22: wizard.WizardService.ListWizards:input_type -> wizard.ListWizardsRequest
-	7,  // 23: wizard.WizardService.DeleteWizard:input_type -> wizard.DeleteWizardRequest
-	9,  // 24: wizard.WizardService.JoinGuild:input_type -> wizard.JoinGuildRequest
-	10, // 25: wizard.WizardService.LeaveGuild:input_type -> wizard.LeaveGuildRequest
-	14, // 26: wizard.WizardService.CreateJob:input_type -> wizard.CreateJobRequest
-	15, // 27: wizard.WizardService.GetJob:input_type -> wizard.GetJobRequest
-	16, // 28: wizard.WizardService.ListJobs:input_type -> wizard.ListJobsRequest
-	18, // 29: wizard.WizardService.UpdateJob:input_type -> wizard.UpdateJobRequest
-	19, // 30: wizard.WizardService.DeleteJob:input_type -> wizard.DeleteJobRequest
-	21, // 31: wizard.WizardService.AssignWizardToJob:input_type -> wizard.AssignWizardToJobRequest
-	22, // 32: wizard.WizardService.GetJobAssignments:input_type -> wizard.GetJobAssignmentsRequest
-	24, // 33: wizard.WizardService.CompleteJobAssignment:input_type -> wizard.CompleteJobAssignmentRequest
-	25, // 34: wizard.WizardService.CancelJobAssignment:input_type -> wizard.CancelJobAssignmentRequest
-	26, // 35: wizard.WizardService.UpdateJobProgress:input_type -> wizard.UpdateJobProgressRequest
-	27, // 36: wizard.WizardService.GetJobProgress:input_type -> wizard.GetJobProgressRequest
-	28, // 37: wizard.WizardService.GetActivities:input_type -> wizard.GetActivitiesRequest
-	31, // 38: wizard.WizardService.GetRealms:input_type -> wizard.GetRealmsRequest
-	34, // 39: wizard.WizardService.GetManaBalance:input_type -> wizard.GetManaBalanceRequest
-	36, // 40: wizard.WizardService.UpdateManaBalance:input_type -> wizard.UpdateManaBalanceRequest
-	38, // 41: wizard.WizardService.TransferMana:input_type -> wizard.TransferManaRequest
-	0,  // 42: wizard.WizardService.CreateWizard:output_type -> wizard.Wizard
-	0,  // 43: wizard.WizardService.GetWizard:output_type -> wizard.Wizard
-	0,  // 44: wizard.WizardService.UpdateWizard:output_type -> wizard.Wizard
-	6,  // 45: wizard.WizardService.ListWizards:output_type -> wizard.ListWizardsResponse
-	8,  // 46: wizard.WizardService.DeleteWizard:output_type -> wizard.DeleteWizardResponse
-	0,  // 47: wizard.WizardService.JoinGuild:output_type -> wizard.Wizard
-	0,  // 48: wizard.WizardService.LeaveGuild:output_type -> wizard.Wizard
-	11, // 49: wizard.WizardService.CreateJob:output_type -> wizard.Job
-	11, // 50: wizard.WizardService.GetJob:output_type -> wizard.Job
-	17, // 51: wizard.WizardService.ListJobs:output_type -> wizard.ListJobsResponse
-	11, // 52: wizard.WizardService.UpdateJob:output_type -> wizard.Job
-	20, // 53: wizard.WizardService.DeleteJob:output_type -> wizard.DeleteJobResponse
-	12, // 54: wizard.WizardService.AssignWizardToJob:output_type -> wizard.JobAssignment
-	23, // 55: wizard.WizardService.GetJobAssignments:output_type -> wizard.GetJobAssignmentsResponse
-	12, // 56: wizard.WizardService.CompleteJobAssignment:output_type -> wizard.JobAssignment
-	12, // 57: wizard.WizardService.CancelJobAssignment:output_type -> wizard.JobAssignment
-	13, // 58: wizard.WizardService.UpdateJobProgress:output_type -> wizard.JobProgress
-	13, // 59: wizard.WizardService.GetJobProgress:output_type -> wizard.JobProgress
-	29, // 60: wizard.WizardService.GetActivities:output_type -> wizard.GetActivitiesResponse
-	32, // 61: wizard.WizardService.GetRealms:output_type -> wizard.GetRealmsResponse
-	35, // 62: wizard.WizardService.GetManaBalance:output_type -> wizard.GetManaBalanceResponse
-	37, // 63: wizard.WizardService.UpdateManaBalance:output_type -> wizard.UpdateManaBalanceResponse
-	39, // 64: wizard.WizardService.TransferMana:output_type -> wizard.TransferManaResponse
-	42, // [42:65] is the sub-list for method output_type
-	19, // [19:42] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+
+type CreateJobTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Spec *JobTemplateSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
 }
 
-func init() { file_proto_wizard_wizard_proto_init() }
-func file_proto_wizard_wizard_proto_init() {
-	if File_proto_wizard_wizard_proto != nil {
-		return
+func (x *CreateJobTemplateRequest) Reset() {
+	*x = CreateJobTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_wizard_wizard_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*Wizard); i {
+}
+
+func (x *CreateJobTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJobTemplateRequest) ProtoMessage() {}
+
+func (x *CreateJobTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJobTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateJobTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateJobTemplateRequest) GetSpec() *JobTemplateSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type GetJobTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetJobTemplateRequest) Reset() {
+	*x = GetJobTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobTemplateRequest) ProtoMessage() {}
+
+func (x *GetJobTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetJobTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetJobTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListJobTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RealmId    int64 `protobuf:"varint,1,opt,name=realm_id,json=realmId,proto3" json:"realm_id,omitempty"` // Optional: templates posting in this realm, including every-realm ones
+	OnlyActive bool  `protobuf:"varint,2,opt,name=only_active,json=onlyActive,proto3" json:"only_active,omitempty"`
+}
+
+func (x *ListJobTemplatesRequest) Reset() {
+	*x = ListJobTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobTemplatesRequest) ProtoMessage() {}
+
+func (x *ListJobTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListJobTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListJobTemplatesRequest) GetRealmId() int64 {
+	if x != nil {
+		return x.RealmId
+	}
+	return 0
+}
+
+func (x *ListJobTemplatesRequest) GetOnlyActive() bool {
+	if x != nil {
+		return x.OnlyActive
+	}
+	return false
+}
+
+type ListJobTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Templates []*JobTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *ListJobTemplatesResponse) Reset() {
+	*x = ListJobTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobTemplatesResponse) ProtoMessage() {}
+
+func (x *ListJobTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListJobTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListJobTemplatesResponse) GetTemplates() []*JobTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// UpdateJobTemplateRequest replaces a template's spec and reschedules it. Jobs it
+// already posted are left as they are.
+type UpdateJobTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec     *JobTemplateSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	IsActive bool             `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+}
+
+func (x *UpdateJobTemplateRequest) Reset() {
+	*x = UpdateJobTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateJobTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateJobTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJobTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateJobTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateJobTemplateRequest) GetSpec() *JobTemplateSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *UpdateJobTemplateRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+// DeleteJobTemplateRequest deletes a template. Jobs it posted stay on the board
+// until they expire.
+type DeleteJobTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteJobTemplateRequest) Reset() {
+	*x = DeleteJobTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteJobTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteJobTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteJobTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteJobTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *DeleteJobTemplateResponse) Reset() {
+	*x = DeleteJobTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteJobTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteJobTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteJobTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetJobAssignmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WizardId   int64  `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	JobId      int64  `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	PageSize   int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageNumber int32  `protobuf:"varint,5,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+}
+
+func (x *GetJobAssignmentsRequest) Reset() {
+	*x = GetJobAssignmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobAssignmentsRequest) ProtoMessage() {}
+
+func (x *GetJobAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetJobAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetJobAssignmentsRequest) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+func (x *GetJobAssignmentsRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *GetJobAssignmentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetJobAssignmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetJobAssignmentsRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+type GetJobAssignmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments []*JobAssignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	TotalCount  int32            `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *GetJobAssignmentsResponse) Reset() {
+	*x = GetJobAssignmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobAssignmentsResponse) ProtoMessage() {}
+
+func (x *GetJobAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*GetJobAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetJobAssignmentsResponse) GetAssignments() []*JobAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *GetJobAssignmentsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetJobAssignmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *GetJobAssignmentRequest) Reset() {
+	*x = GetJobAssignmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobAssignmentRequest) ProtoMessage() {}
+
+func (x *GetJobAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*GetJobAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetJobAssignmentRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+type CompleteJobAssignmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *CompleteJobAssignmentRequest) Reset() {
+	*x = CompleteJobAssignmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteJobAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteJobAssignmentRequest) ProtoMessage() {}
+
+func (x *CompleteJobAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteJobAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*CompleteJobAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CompleteJobAssignmentRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+type CancelJobAssignmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Reason       string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CancelJobAssignmentRequest) Reset() {
+	*x = CancelJobAssignmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelJobAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobAssignmentRequest) ProtoMessage() {}
+
+func (x *CancelJobAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CancelJobAssignmentRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *CancelJobAssignmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PauseJobAssignmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *PauseJobAssignmentRequest) Reset() {
+	*x = PauseJobAssignmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseJobAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseJobAssignmentRequest) ProtoMessage() {}
+
+func (x *PauseJobAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseJobAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*PauseJobAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PauseJobAssignmentRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+type ResumeJobAssignmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *ResumeJobAssignmentRequest) Reset() {
+	*x = ResumeJobAssignmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeJobAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeJobAssignmentRequest) ProtoMessage() {}
+
+func (x *ResumeJobAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeJobAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*ResumeJobAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ResumeJobAssignmentRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+// Job progress requests
+type UpdateJobProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId       int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	ProgressPercentage int32 `protobuf:"varint,2,opt,name=progress_percentage,json=progressPercentage,proto3" json:"progress_percentage,omitempty"`
+	TimeWorkedMinutes  int32 `protobuf:"varint,3,opt,name=time_worked_minutes,json=timeWorkedMinutes,proto3" json:"time_worked_minutes,omitempty"`
+}
+
+func (x *UpdateJobProgressRequest) Reset() {
+	*x = UpdateJobProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateJobProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobProgressRequest) ProtoMessage() {}
+
+func (x *UpdateJobProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobProgressRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJobProgressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateJobProgressRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *UpdateJobProgressRequest) GetProgressPercentage() int32 {
+	if x != nil {
+		return x.ProgressPercentage
+	}
+	return 0
+}
+
+func (x *UpdateJobProgressRequest) GetTimeWorkedMinutes() int32 {
+	if x != nil {
+		return x.TimeWorkedMinutes
+	}
+	return 0
+}
+
+type GetJobProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *GetJobProgressRequest) Reset() {
+	*x = GetJobProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobProgressRequest) ProtoMessage() {}
+
+func (x *GetJobProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetJobProgressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetJobProgressRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+// Activity log requests
+type GetActivitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WizardId     int64  `protobuf:"varint,2,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	ActivityType string `protobuf:"bytes,3,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	PageSize     int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageNumber   int32  `protobuf:"varint,5,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+}
+
+func (x *GetActivitiesRequest) Reset() {
+	*x = GetActivitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActivitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivitiesRequest) ProtoMessage() {}
+
+func (x *GetActivitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetActivitiesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetActivitiesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetActivitiesRequest) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+func (x *GetActivitiesRequest) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (x *GetActivitiesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetActivitiesRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+type GetActivitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Activities []*ActivityLog `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
+	TotalCount int32          `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *GetActivitiesResponse) Reset() {
+	*x = GetActivitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActivitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivitiesResponse) ProtoMessage() {}
+
+func (x *GetActivitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetActivitiesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetActivitiesResponse) GetActivities() []*ActivityLog {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+func (x *GetActivitiesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ActivityLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId              int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WizardId            int64                  `protobuf:"varint,3,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	ActivityType        string                 `protobuf:"bytes,4,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	ActivityDescription string                 `protobuf:"bytes,5,opt,name=activity_description,json=activityDescription,proto3" json:"activity_description,omitempty"`
+	Metadata            string                 `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ActivityLog) Reset() {
+	*x = ActivityLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityLog) ProtoMessage() {}
+
+func (x *ActivityLog) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityLog.ProtoReflect.Descriptor instead.
+func (*ActivityLog) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ActivityLog) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivityLog) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ActivityLog) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+func (x *ActivityLog) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (x *ActivityLog) GetActivityDescription() string {
+	if x != nil {
+		return x.ActivityDescription
+	}
+	return ""
+}
+
+func (x *ActivityLog) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *ActivityLog) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Realm messages
+type GetRealmsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetRealmsRequest) Reset() {
+	*x = GetRealmsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRealmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRealmsRequest) ProtoMessage() {}
+
+func (x *GetRealmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRealmsRequest.ProtoReflect.Descriptor instead.
+func (*GetRealmsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{55}
+}
+
+type GetRealmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realms []*Realm `protobuf:"bytes,1,rep,name=realms,proto3" json:"realms,omitempty"`
+}
+
+func (x *GetRealmsResponse) Reset() {
+	*x = GetRealmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRealmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRealmsResponse) ProtoMessage() {}
+
+func (x *GetRealmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRealmsResponse.ProtoReflect.Descriptor instead.
+func (*GetRealmsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetRealmsResponse) GetRealms() []*Realm {
+	if x != nil {
+		return x.Realms
+	}
+	return nil
+}
+
+type Realm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *Realm) Reset() {
+	*x = Realm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Realm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Realm) ProtoMessage() {}
+
+func (x *Realm) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Realm.ProtoReflect.Descriptor instead.
+func (*Realm) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Realm) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Realm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Realm) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Mana management messages
+type GetManaBalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WizardId int64 `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+}
+
+func (x *GetManaBalanceRequest) Reset() {
+	*x = GetManaBalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetManaBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManaBalanceRequest) ProtoMessage() {}
+
+func (x *GetManaBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManaBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetManaBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetManaBalanceRequest) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+type GetManaBalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *GetManaBalanceResponse) Reset() {
+	*x = GetManaBalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetManaBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManaBalanceResponse) ProtoMessage() {}
+
+func (x *GetManaBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManaBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetManaBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetManaBalanceResponse) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type UpdateManaBalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WizardId        int64                    `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	Amount          int64                    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                                         // Can be positive (add) or negative (subtract)
+	Reason          string                   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                          // Optional reason for the update
+	Modifiers       []*AppliedRewardModifier `protobuf:"bytes,4,rep,name=modifiers,proto3" json:"modifiers,omitempty"`                                    // Optional modifiers that produced the amount, recorded in the activity log
+	CounterPostings []*LedgerPosting         `protobuf:"bytes,5,rep,name=counter_postings,json=counterPostings,proto3" json:"counter_postings,omitempty"` // Optional other side of the ledger entry, must sum to -amount. Defaults to the system account
+	Reference       string                   `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`                                    // Optional unique reference; an update whose reference was already recorded is not applied again
+	IdempotencyKey  string                   `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`    // Optional; retries with the same key replay the original response
+}
+
+func (x *UpdateManaBalanceRequest) Reset() {
+	*x = UpdateManaBalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateManaBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateManaBalanceRequest) ProtoMessage() {}
+
+func (x *UpdateManaBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateManaBalanceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateManaBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateManaBalanceRequest) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+func (x *UpdateManaBalanceRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateManaBalanceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateManaBalanceRequest) GetModifiers() []*AppliedRewardModifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *UpdateManaBalanceRequest) GetCounterPostings() []*LedgerPosting {
+	if x != nil {
+		return x.CounterPostings
+	}
+	return nil
+}
+
+func (x *UpdateManaBalanceRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *UpdateManaBalanceRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type UpdateManaBalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewBalance int64 `protobuf:"varint,1,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	Success    bool  `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Replayed   bool  `protobuf:"varint,3,opt,name=replayed,proto3" json:"replayed,omitempty"` // True when the reference had already been applied
+}
+
+func (x *UpdateManaBalanceResponse) Reset() {
+	*x = UpdateManaBalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateManaBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateManaBalanceResponse) ProtoMessage() {}
+
+func (x *UpdateManaBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateManaBalanceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateManaBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UpdateManaBalanceResponse) GetNewBalance() int64 {
+	if x != nil {
+		return x.NewBalance
+	}
+	return 0
+}
+
+func (x *UpdateManaBalanceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateManaBalanceResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+type TransferManaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromWizardId   int64  `protobuf:"varint,1,opt,name=from_wizard_id,json=fromWizardId,proto3" json:"from_wizard_id,omitempty"`
+	ToWizardId     int64  `protobuf:"varint,2,opt,name=to_wizard_id,json=toWizardId,proto3" json:"to_wizard_id,omitempty"`
+	Amount         int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason         string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                                       // Optional reason for the transfer
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // Optional; retries with the same key replay the original response
+}
+
+func (x *TransferManaRequest) Reset() {
+	*x = TransferManaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferManaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferManaRequest) ProtoMessage() {}
+
+func (x *TransferManaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferManaRequest.ProtoReflect.Descriptor instead.
+func (*TransferManaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *TransferManaRequest) GetFromWizardId() int64 {
+	if x != nil {
+		return x.FromWizardId
+	}
+	return 0
+}
+
+func (x *TransferManaRequest) GetToWizardId() int64 {
+	if x != nil {
+		return x.ToWizardId
+	}
+	return 0
+}
+
+func (x *TransferManaRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferManaRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TransferManaRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type TransferManaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *TransferManaResponse) Reset() {
+	*x = TransferManaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferManaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferManaResponse) ProtoMessage() {}
+
+func (x *TransferManaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferManaResponse.ProtoReflect.Descriptor instead.
+func (*TransferManaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *TransferManaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TransferManaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Progression messages
+type GetProgressionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WizardId int64 `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+}
+
+func (x *GetProgressionRequest) Reset() {
+	*x = GetProgressionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProgressionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProgressionRequest) ProtoMessage() {}
+
+func (x *GetProgressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProgressionRequest.ProtoReflect.Descriptor instead.
+func (*GetProgressionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetProgressionRequest) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+type LevelReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level         int32  `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	RewardType    string `protobuf:"bytes,2,opt,name=reward_type,json=rewardType,proto3" json:"reward_type,omitempty"`          // "mana_bonus", "job_tier" or "wizard_slot"
+	Amount        int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                                   // Mana for a bonus, slots for a wizard slot
+	JobDifficulty string `protobuf:"bytes,4,opt,name=job_difficulty,json=jobDifficulty,proto3" json:"job_difficulty,omitempty"` // Difficulty a job tier unlocks
+	Description   string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *LevelReward) Reset() {
+	*x = LevelReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LevelReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LevelReward) ProtoMessage() {}
+
+func (x *LevelReward) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LevelReward.ProtoReflect.Descriptor instead.
+func (*LevelReward) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LevelReward) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *LevelReward) GetRewardType() string {
+	if x != nil {
+		return x.RewardType
+	}
+	return ""
+}
+
+func (x *LevelReward) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LevelReward) GetJobDifficulty() string {
+	if x != nil {
+		return x.JobDifficulty
+	}
+	return ""
+}
+
+func (x *LevelReward) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type Progression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WizardId                int64          `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	Curve                   string         `protobuf:"bytes,2,opt,name=curve,proto3" json:"curve,omitempty"` // "linear", "quadratic" or "table"
+	Level                   int32          `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	MaxLevel                int32          `protobuf:"varint,4,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	ExperiencePoints        int32          `protobuf:"varint,5,opt,name=experience_points,json=experiencePoints,proto3" json:"experience_points,omitempty"`
+	LevelExp                int32          `protobuf:"varint,6,opt,name=level_exp,json=levelExp,proto3" json:"level_exp,omitempty"`               // Experience the current level starts at
+	NextLevelExp            int32          `protobuf:"varint,7,opt,name=next_level_exp,json=nextLevelExp,proto3" json:"next_level_exp,omitempty"` // Experience the next level starts at, 0 at the max level
+	ExpToNextLevel          int32          `protobuf:"varint,8,opt,name=exp_to_next_level,json=expToNextLevel,proto3" json:"exp_to_next_level,omitempty"`
+	UnlockedJobDifficulties []string       `protobuf:"bytes,9,rep,name=unlocked_job_difficulties,json=unlockedJobDifficulties,proto3" json:"unlocked_job_difficulties,omitempty"`
+	BonusWizardSlots        int32          `protobuf:"varint,10,opt,name=bonus_wizard_slots,json=bonusWizardSlots,proto3" json:"bonus_wizard_slots,omitempty"` // Extra wizards this wizard's level lets its owner create
+	UpcomingUnlocks         []*LevelReward `protobuf:"bytes,11,rep,name=upcoming_unlocks,json=upcomingUnlocks,proto3" json:"upcoming_unlocks,omitempty"`
+}
+
+func (x *Progression) Reset() {
+	*x = Progression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Progression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Progression) ProtoMessage() {}
+
+func (x *Progression) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Progression.ProtoReflect.Descriptor instead.
+func (*Progression) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Progression) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+func (x *Progression) GetCurve() string {
+	if x != nil {
+		return x.Curve
+	}
+	return ""
+}
+
+func (x *Progression) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Progression) GetMaxLevel() int32 {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return 0
+}
+
+func (x *Progression) GetExperiencePoints() int32 {
+	if x != nil {
+		return x.ExperiencePoints
+	}
+	return 0
+}
+
+func (x *Progression) GetLevelExp() int32 {
+	if x != nil {
+		return x.LevelExp
+	}
+	return 0
+}
+
+func (x *Progression) GetNextLevelExp() int32 {
+	if x != nil {
+		return x.NextLevelExp
+	}
+	return 0
+}
+
+func (x *Progression) GetExpToNextLevel() int32 {
+	if x != nil {
+		return x.ExpToNextLevel
+	}
+	return 0
+}
+
+func (x *Progression) GetUnlockedJobDifficulties() []string {
+	if x != nil {
+		return x.UnlockedJobDifficulties
+	}
+	return nil
+}
+
+func (x *Progression) GetBonusWizardSlots() int32 {
+	if x != nil {
+		return x.BonusWizardSlots
+	}
+	return 0
+}
+
+func (x *Progression) GetUpcomingUnlocks() []*LevelReward {
+	if x != nil {
+		return x.UpcomingUnlocks
+	}
+	return nil
+}
+
+// Reward modifier messages
+type RewardModifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source string  `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`   // e.g. "realm"
+	Factor float64 `protobuf:"fixed64,3,opt,name=factor,proto3" json:"factor,omitempty"` // Multiplier applied to the reward
+}
+
+func (x *RewardModifier) Reset() {
+	*x = RewardModifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardModifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardModifier) ProtoMessage() {}
+
+func (x *RewardModifier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardModifier.ProtoReflect.Descriptor instead.
+func (*RewardModifier) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RewardModifier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RewardModifier) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RewardModifier) GetFactor() float64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+type AppliedRewardModifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source       string  `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Factor       float64 `protobuf:"fixed64,3,opt,name=factor,proto3" json:"factor,omitempty"`
+	AmountBefore int64   `protobuf:"varint,4,opt,name=amount_before,json=amountBefore,proto3" json:"amount_before,omitempty"`
+	AmountAfter  int64   `protobuf:"varint,5,opt,name=amount_after,json=amountAfter,proto3" json:"amount_after,omitempty"`
+}
+
+func (x *AppliedRewardModifier) Reset() {
+	*x = AppliedRewardModifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppliedRewardModifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedRewardModifier) ProtoMessage() {}
+
+func (x *AppliedRewardModifier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedRewardModifier.ProtoReflect.Descriptor instead.
+func (*AppliedRewardModifier) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AppliedRewardModifier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppliedRewardModifier) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AppliedRewardModifier) GetFactor() float64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+func (x *AppliedRewardModifier) GetAmountBefore() int64 {
+	if x != nil {
+		return x.AmountBefore
+	}
+	return 0
+}
+
+func (x *AppliedRewardModifier) GetAmountAfter() int64 {
+	if x != nil {
+		return x.AmountAfter
+	}
+	return 0
+}
+
+type GetRewardModifiersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WizardId   int64  `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	RewardType string `protobuf:"bytes,2,opt,name=reward_type,json=rewardType,proto3" json:"reward_type,omitempty"` // "job" or "investment"
+	RealmId    int64  `protobuf:"varint,3,opt,name=realm_id,json=realmId,proto3" json:"realm_id,omitempty"`         // Optional: realm the reward is earned in, defaults to the wizard's home realm
+}
+
+func (x *GetRewardModifiersRequest) Reset() {
+	*x = GetRewardModifiersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRewardModifiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRewardModifiersRequest) ProtoMessage() {}
+
+func (x *GetRewardModifiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRewardModifiersRequest.ProtoReflect.Descriptor instead.
+func (*GetRewardModifiersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetRewardModifiersRequest) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+func (x *GetRewardModifiersRequest) GetRewardType() string {
+	if x != nil {
+		return x.RewardType
+	}
+	return ""
+}
+
+func (x *GetRewardModifiersRequest) GetRealmId() int64 {
+	if x != nil {
+		return x.RealmId
+	}
+	return 0
+}
+
+type GetRewardModifiersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Modifiers []*RewardModifier `protobuf:"bytes,1,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
+}
+
+func (x *GetRewardModifiersResponse) Reset() {
+	*x = GetRewardModifiersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRewardModifiersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRewardModifiersResponse) ProtoMessage() {}
+
+func (x *GetRewardModifiersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRewardModifiersResponse.ProtoReflect.Descriptor instead.
+func (*GetRewardModifiersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetRewardModifiersResponse) GetModifiers() []*RewardModifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+// Mana ledger messages
+type LedgerPosting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountType string `protobuf:"bytes,1,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"` // "wizard", "system", "escrow" or "investment"
+	WizardId    int64  `protobuf:"varint,2,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`         // Owner of the account, 0 for the system account
+	Amount      int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                             // Positive credits the account, negative debits it
+}
+
+func (x *LedgerPosting) Reset() {
+	*x = LedgerPosting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerPosting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerPosting) ProtoMessage() {}
+
+func (x *LedgerPosting) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerPosting.ProtoReflect.Descriptor instead.
+func (*LedgerPosting) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *LedgerPosting) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *LedgerPosting) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+func (x *LedgerPosting) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type LedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntryType   string                 `protobuf:"bytes,2,opt,name=entry_type,json=entryType,proto3" json:"entry_type,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Postings    []*LedgerPosting       `protobuf:"bytes,4,rep,name=postings,proto3" json:"postings,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *LedgerEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetEntryType() string {
+	if x != nil {
+		return x.EntryType
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetPostings() []*LedgerPosting {
+	if x != nil {
+		return x.Postings
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetLedgerEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WizardId   int64 `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	PageSize   int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageNumber int32 `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+}
+
+func (x *GetLedgerEntriesRequest) Reset() {
+	*x = GetLedgerEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerEntriesRequest) ProtoMessage() {}
+
+func (x *GetLedgerEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerEntriesRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetLedgerEntriesRequest) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+func (x *GetLedgerEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLedgerEntriesRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+type GetLedgerEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries    []*LedgerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	TotalCount int32          `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *GetLedgerEntriesResponse) Reset() {
+	*x = GetLedgerEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerEntriesResponse) ProtoMessage() {}
+
+func (x *GetLedgerEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerEntriesResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetLedgerEntriesResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetLedgerEntriesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type VerifyLedgerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VerifyLedgerRequest) Reset() {
+	*x = VerifyLedgerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyLedgerRequest) ProtoMessage() {}
+
+func (x *VerifyLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyLedgerRequest.ProtoReflect.Descriptor instead.
+func (*VerifyLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{75}
+}
+
+type LedgerMismatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WizardId      int64 `protobuf:"varint,1,opt,name=wizard_id,json=wizardId,proto3" json:"wizard_id,omitempty"`
+	CachedBalance int64 `protobuf:"varint,2,opt,name=cached_balance,json=cachedBalance,proto3" json:"cached_balance,omitempty"`
+	LedgerBalance int64 `protobuf:"varint,3,opt,name=ledger_balance,json=ledgerBalance,proto3" json:"ledger_balance,omitempty"`
+}
+
+func (x *LedgerMismatch) Reset() {
+	*x = LedgerMismatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerMismatch) ProtoMessage() {}
+
+func (x *LedgerMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerMismatch.ProtoReflect.Descriptor instead.
+func (*LedgerMismatch) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *LedgerMismatch) GetWizardId() int64 {
+	if x != nil {
+		return x.WizardId
+	}
+	return 0
+}
+
+func (x *LedgerMismatch) GetCachedBalance() int64 {
+	if x != nil {
+		return x.CachedBalance
+	}
+	return 0
+}
+
+func (x *LedgerMismatch) GetLedgerBalance() int64 {
+	if x != nil {
+		return x.LedgerBalance
+	}
+	return 0
+}
+
+type VerifyLedgerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balanced   bool              `protobuf:"varint,1,opt,name=balanced,proto3" json:"balanced,omitempty"`
+	Mismatches []*LedgerMismatch `protobuf:"bytes,2,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
+}
+
+func (x *VerifyLedgerResponse) Reset() {
+	*x = VerifyLedgerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyLedgerResponse) ProtoMessage() {}
+
+func (x *VerifyLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyLedgerResponse.ProtoReflect.Descriptor instead.
+func (*VerifyLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *VerifyLedgerResponse) GetBalanced() bool {
+	if x != nil {
+		return x.Balanced
+	}
+	return false
+}
+
+func (x *VerifyLedgerResponse) GetMismatches() []*LedgerMismatch {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+type GetLeaderStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetLeaderStatusRequest) Reset() {
+	*x = GetLeaderStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderStatusRequest) ProtoMessage() {}
+
+func (x *GetLeaderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{78}
+}
+
+type LeaderStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Election string `protobuf:"bytes,1,opt,name=election,proto3" json:"election,omitempty"`
+	// The replica that answered
+	InstanceId string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	IsLeader   bool   `protobuf:"varint,3,opt,name=is_leader,json=isLeader,proto3" json:"is_leader,omitempty"`
+	// The replica running the background workers, empty if none has been elected
+	LeaderInstanceId string                 `protobuf:"bytes,4,opt,name=leader_instance_id,json=leaderInstanceId,proto3" json:"leader_instance_id,omitempty"`
+	ElectedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=elected_at,json=electedAt,proto3" json:"elected_at,omitempty"`
+	HeartbeatAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=heartbeat_at,json=heartbeatAt,proto3" json:"heartbeat_at,omitempty"`
+}
+
+func (x *LeaderStatus) Reset() {
+	*x = LeaderStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_wizard_wizard_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderStatus) ProtoMessage() {}
+
+func (x *LeaderStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_wizard_wizard_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderStatus.ProtoReflect.Descriptor instead.
+func (*LeaderStatus) Descriptor() ([]byte, []int) {
+	return file_proto_wizard_wizard_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *LeaderStatus) GetElection() string {
+	if x != nil {
+		return x.Election
+	}
+	return ""
+}
+
+func (x *LeaderStatus) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *LeaderStatus) GetIsLeader() bool {
+	if x != nil {
+		return x.IsLeader
+	}
+	return false
+}
+
+func (x *LeaderStatus) GetLeaderInstanceId() string {
+	if x != nil {
+		return x.LeaderInstanceId
+	}
+	return ""
+}
+
+func (x *LeaderStatus) GetElectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ElectedAt
+	}
+	return nil
+}
+
+func (x *LeaderStatus) GetHeartbeatAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HeartbeatAt
+	}
+	return nil
+}
+
+var File_proto_wizard_wizard_proto protoreflect.FileDescriptor
+
+var file_proto_wizard_wizard_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2f, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf6, 0x02, 0x0a, 0x06, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x61, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x6e, 0x61, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x05, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x67,
+	0x75, 0x69, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x2b, 0x0a,
+	0x05, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x65, 0x61, 0x6c, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x22,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x69, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x81, 0x01,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x65, 0x61, 0x6c, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x22, 0x60, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x77, 0x69, 0x7a, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x77, 0x69, 0x7a, 0x61,
+	0x72, 0x64, 0x2e, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x07, 0x77, 0x69, 0x7a, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x4e, 0x0a, 0x10,
+	0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x11,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0xef,
+	0x07, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x45, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61,
+	0x6e, 0x61, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f,
+	0x75, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x6e, 0x61, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x2d, 0x0a, 0x13, 0x65,
+	0x78, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f,
+	0x75, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x57,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x6c, 0x79, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69,
+	0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x14,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x2f, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x2e, 0x4a, 0x6f, 0x62, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x08, 0x61, 0x66,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x2e, 0x4a, 0x6f, 0x62, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x99, 0x02, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x28, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x57,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc2, 0x02, 0x0a,
+	0x0e, 0x4a, 0x6f, 0x62, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72,
+	0x64, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xd9, 0x02, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x45, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x1a, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x18, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xcf, 0x01,
+	0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x69, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d,
+	0x69, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x1a,
+	0x3e, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x9f, 0x02, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x50, 0x61, 0x72, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06,
+	0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x37,
+	0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xcc, 0x04, 0x0a, 0x0d, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x7a, 0x61, 0x72,
+	0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x61, 0x5f,
+	0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61,
+	0x6e, 0x61, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x5f,
+	0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x03, 0x6a, 0x6f, 0x62, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x2f, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x65, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x22, 0xa2, 0x04, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x64, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x46, 0x0a,
+	0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0xaa, 0x05, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x61, 0x6c, 0x6d,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2f,
+	0x0a, 0x14, 0x6d, 0x61, 0x6e, 0x61, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61,
+	0x6e, 0x61, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12,
+	0x2d, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78,
+	0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69,
+	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f,
+	0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f,
+	0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x31, 0x0a, 0x14, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x57, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x69, 0x7a, 0x61,
+	0x72, 0x64, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x22, 0x1f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xdd, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69,
+	0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x6e, 0x6c, 0x79,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72,
+	0x64, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a,
+	0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x10, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x6e, 0x61, 0x5f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x2d, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x5f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50,
+	0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78,
+	0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x4e, 0x0a, 0x18, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x17, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x6f, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x73, 0x22, 0x2f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4a,
+	0x6f, 0x62, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x11, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x54, 0x6f, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x68, 0x0a, 0x1a,
+	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f,
+	0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x59, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f,
+	0x62, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x6e, 0x0a, 0x1b, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49,
+	0x64, 0x22, 0x6e, 0x0a, 0x1b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49,
+	0x64, 0x22, 0xe1, 0x05, 0x0a, 0x0f, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x45, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61,
+	0x6e, 0x61, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f,
+	0x75, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x6e, 0x61, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x2d, 0x0a, 0x13, 0x65,
+	0x78, 0x70, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f,
+	0x75, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x57,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66,
+	0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a,
+	0x14, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x72, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12,
+	0x38, 0x0a, 0x18, 0x70, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x16, 0x70, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0xf4, 0x02, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3a,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x47, 0x0a, 0x18,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e,
+	0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x55,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x6e, 0x6c, 0x79, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x4d, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x31, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f,
+	0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2b, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x2a, 0x0a, 0x18, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xa4, 0x01,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x22, 0x75, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e,
+	0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x1c, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x59, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x19, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x41, 0x0a,
+	0x1a, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0xa0, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x12, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
+	0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0xaf, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x6d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x4c, 0x6f, 0x67, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x82, 0x02, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c,
+	0x6f, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a,
+	0x14, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x22, 0x4d, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x6c, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e,
+	0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x22, 0xad, 0x02, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x09, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x22, 0x72, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x64, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x4d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x57, 0x69, 0x7a, 0x61,
+	0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x4a, 0x0a,
+	0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x34, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22,
+	0xa5, 0x01, 0x0a, 0x0b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x6f, 0x62, 0x44, 0x69, 0x66, 0x66, 0x69,
+	0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb8, 0x03, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x7a, 0x61, 0x72,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x77, 0x69, 0x7a, 0x61,
+	0x72, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2b, 0x0a,
+	0x11, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x45, 0x78, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x6e, 0x65, 0x78, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x45, 0x78, 0x70, 0x12, 0x29, 0x0a,
+	0x11, 0x65, 0x78, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x54, 0x6f, 0x4e,
+	0x65, 0x78, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3a, 0x0a, 0x19, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x75, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x10, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x53, 0x6c, 0x6f,
+	0x74, 0x73, 0x12, 0x3e, 0x0a, 0x10, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x75,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x0f, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x22, 0x54, 0x0a, 0x0e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xa3, 0x01, 0x0a, 0x15, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x74,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x67, 0x0a, 0x0d, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xcc, 0x01, 0x0a, 0x0b, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x74, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x6a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7b, 0x0a, 0x0e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x6a, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x6d, 0x69,
+	0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4d, 0x69,
+	0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x90, 0x02, 0x0a,
+	0x0c, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x3d, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x41, 0x74, 0x32,
+	0xf0, 0x18, 0x0a, 0x0d, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61, 0x72,
+	0x64, 0x12, 0x1b, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x12, 0x18, 0x2e,
+	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x2e, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x2e, 0x77, 0x69, 0x7a, 0x61,
+	0x72, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e,
+	0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1a, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x69, 0x7a, 0x61,
+	0x72, 0x64, 0x12, 0x1b, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x18, 0x2e, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e,
+	0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x19, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x57, 0x69, 0x7a, 0x61, 0x72,
+	0x64, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x12, 0x18, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x06, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x12, 0x15, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08, 0x4c, 0x69, 0x73,
+	0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x17, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x18, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0b, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x22, 0x00,
+	0x12, 0x42, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x18, 0x2e,
+	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x57, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x4a, 0x6f, 0x62, 0x12, 0x20, 0x2e, 0x77, 0x69, 0x7a, 0x61,
+	0x72, 0x64, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x54,
+	0x6f, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x77, 0x69, 0x7a, 0x61,
+	0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a,
+	0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x56,
+	0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e,
+	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x12, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x21, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4a,
+	0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x2e, 0x4a, 0x6f, 0x62, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00,
+	0x12, 0x47, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54,
+	0x6f, 0x4a, 0x6f, 0x62, 0x12, 0x1f, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x6f, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a,
+	0x6f, 0x62, 0x50, 0x61, 0x72, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x50, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1a, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72,
+	0x64, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f,
+	0x62, 0x50, 0x61, 0x72, 0x74, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x54, 0x6f, 0x4a, 0x6f, 0x62, 0x12, 0x19, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x22, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a,
+	0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x4a, 0x6f, 0x62, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x6f,
+	0x62, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x6f, 0x62, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x20, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22,
+	0x00, 0x12, 0x57, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x20, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e,
+	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x2e, 0x77, 0x69, 0x7a, 0x61,
+	0x72, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x4a, 0x6f, 0x62,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x69, 0x7a, 0x61,
+	0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x12, 0x18, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1d, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e,
+	0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5a, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72,
+	0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x12, 0x1b, 0x2e,
+	0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d,
+	0x61, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x77,
+	0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x21, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72,
+	0x64, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x69,
+	0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x2e,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x77, 0x69, 0x7a,
+	0x61, 0x72, 0x64, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x00, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x78, 0x2f, 0x6d, 0x79, 0x73, 0x74, 0x69, 0x63, 0x66, 0x75,
+	0x6e, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x69, 0x7a, 0x61, 0x72, 0x64,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_wizard_wizard_proto_rawDescOnce sync.Once
+	file_proto_wizard_wizard_proto_rawDescData = file_proto_wizard_wizard_proto_rawDesc
+)
+
+func file_proto_wizard_wizard_proto_rawDescGZIP() []byte {
+	file_proto_wizard_wizard_proto_rawDescOnce.Do(func() {
+		file_proto_wizard_wizard_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_wizard_wizard_proto_rawDescData)
+	})
+	return file_proto_wizard_wizard_proto_rawDescData
+}
+
+var file_proto_wizard_wizard_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
+var file_proto_wizard_wizard_proto_goTypes = []any{
+	(*Wizard)(nil),                       // 0: wizard.Wizard
+	(*Guild)(nil),                        // 1: wizard.Guild
+	(*CreateWizardRequest)(nil),          // 2: wizard.CreateWizardRequest
+	(*GetWizardRequest)(nil),             // 3: wizard.GetWizardRequest
+	(*UpdateWizardRequest)(nil),          // 4: wizard.UpdateWizardRequest
+	(*ListWizardsRequest)(nil),           // 5: wizard.ListWizardsRequest
+	(*ListWizardsResponse)(nil),          // 6: wizard.ListWizardsResponse
+	(*DeleteWizardRequest)(nil),          // 7: wizard.DeleteWizardRequest
+	(*DeleteWizardResponse)(nil),         // 8: wizard.DeleteWizardResponse
+	(*JoinGuildRequest)(nil),             // 9: wizard.JoinGuildRequest
+	(*LeaveGuildRequest)(nil),            // 10: wizard.LeaveGuildRequest
+	(*Job)(nil),                          // 11: wizard.Job
+	(*JobPosting)(nil),                   // 12: wizard.JobPosting
+	(*JobApplication)(nil),               // 13: wizard.JobApplication
+	(*JobAffinity)(nil),                  // 14: wizard.JobAffinity
+	(*PartyRule)(nil),                    // 15: wizard.PartyRule
+	(*JobParty)(nil),                     // 16: wizard.JobParty
+	(*JobAssignment)(nil),                // 17: wizard.JobAssignment
+	(*JobProgress)(nil),                  // 18: wizard.JobProgress
+	(*CreateJobRequest)(nil),             // 19: wizard.CreateJobRequest
+	(*GetJobRequest)(nil),                // 20: wizard.GetJobRequest
+	(*ListJobsRequest)(nil),              // 21: wizard.ListJobsRequest
+	(*ListJobsResponse)(nil),             // 22: wizard.ListJobsResponse
+	(*UpdateJobRequest)(nil),             // 23: wizard.UpdateJobRequest
+	(*DeleteJobRequest)(nil),             // 24: wizard.DeleteJobRequest
+	(*DeleteJobResponse)(nil),            // 25: wizard.DeleteJobResponse
+	(*AssignWizardToJobRequest)(nil),     // 26: wizard.AssignWizardToJobRequest
+	(*AssignPartyToJobRequest)(nil),      // 27: wizard.AssignPartyToJobRequest
+	(*GetJobPartyRequest)(nil),           // 28: wizard.GetJobPartyRequest
+	(*ApplyToJobRequest)(nil),            // 29: wizard.ApplyToJobRequest
+	(*ListJobApplicationsRequest)(nil),   // 30: wizard.ListJobApplicationsRequest
+	(*ListJobApplicationsResponse)(nil),  // 31: wizard.ListJobApplicationsResponse
+	(*AcceptJobApplicationRequest)(nil),  // 32: wizard.AcceptJobApplicationRequest
+	(*RejectJobApplicationRequest)(nil),  // 33: wizard.RejectJobApplicationRequest
+	(*JobTemplateSpec)(nil),              // 34: wizard.JobTemplateSpec
+	(*JobTemplate)(nil),                  // 35: wizard.JobTemplate
+	(*CreateJobTemplateRequest)(nil),     // 36: wizard.CreateJobTemplateRequest
+	(*GetJobTemplateRequest)(nil),        // 37: wizard.GetJobTemplateRequest
+	(*ListJobTemplatesRequest)(nil),      // 38: wizard.ListJobTemplatesRequest
+	(*ListJobTemplatesResponse)(nil),     // 39: wizard.ListJobTemplatesResponse
+	(*UpdateJobTemplateRequest)(nil),     // 40: wizard.UpdateJobTemplateRequest
+	(*DeleteJobTemplateRequest)(nil),     // 41: wizard.DeleteJobTemplateRequest
+	(*DeleteJobTemplateResponse)(nil),    // 42: wizard.DeleteJobTemplateResponse
+	(*GetJobAssignmentsRequest)(nil),     // 43: wizard.GetJobAssignmentsRequest
+	(*GetJobAssignmentsResponse)(nil),    // 44: wizard.GetJobAssignmentsResponse
+	(*GetJobAssignmentRequest)(nil),      // 45: wizard.GetJobAssignmentRequest
+	(*CompleteJobAssignmentRequest)(nil), // 46: wizard.CompleteJobAssignmentRequest
+	(*CancelJobAssignmentRequest)(nil),   // 47: wizard.CancelJobAssignmentRequest
+	(*PauseJobAssignmentRequest)(nil),    // 48: wizard.PauseJobAssignmentRequest
+	(*ResumeJobAssignmentRequest)(nil),   // 49: wizard.ResumeJobAssignmentRequest
+	(*UpdateJobProgressRequest)(nil),     // 50: wizard.UpdateJobProgressRequest
+	(*GetJobProgressRequest)(nil),        // 51: wizard.GetJobProgressRequest
+	(*GetActivitiesRequest)(nil),         // 52: wizard.GetActivitiesRequest
+	(*GetActivitiesResponse)(nil),        // 53: wizard.GetActivitiesResponse
+	(*ActivityLog)(nil),                  // 54: wizard.ActivityLog
+	(*GetRealmsRequest)(nil),             // 55: wizard.GetRealmsRequest
+	(*GetRealmsResponse)(nil),            // 56: wizard.GetRealmsResponse
+	(*Realm)(nil),                        // 57: wizard.Realm
+	(*GetManaBalanceRequest)(nil),        // 58: wizard.GetManaBalanceRequest
+	(*GetManaBalanceResponse)(nil),       // 59: wizard.GetManaBalanceResponse
+	(*UpdateManaBalanceRequest)(nil),     // 60: wizard.UpdateManaBalanceRequest
+	(*UpdateManaBalanceResponse)(nil),    // 61: wizard.UpdateManaBalanceResponse
+	(*TransferManaRequest)(nil),          // 62: wizard.TransferManaRequest
+	(*TransferManaResponse)(nil),         // 63: wizard.TransferManaResponse
+	(*GetProgressionRequest)(nil),        // 64: wizard.GetProgressionRequest
+	(*LevelReward)(nil),                  // 65: wizard.LevelReward
+	(*Progression)(nil),                  // 66: wizard.Progression
+	(*RewardModifier)(nil),               // 67: wizard.RewardModifier
+	(*AppliedRewardModifier)(nil),        // 68: wizard.AppliedRewardModifier
+	(*GetRewardModifiersRequest)(nil),    // 69: wizard.GetRewardModifiersRequest
+	(*GetRewardModifiersResponse)(nil),   // 70: wizard.GetRewardModifiersResponse
+	(*LedgerPosting)(nil),                // 71: wizard.LedgerPosting
+	(*LedgerEntry)(nil),                  // 72: wizard.LedgerEntry
+	(*GetLedgerEntriesRequest)(nil),      // 73: wizard.GetLedgerEntriesRequest
+	(*GetLedgerEntriesResponse)(nil),     // 74: wizard.GetLedgerEntriesResponse
+	(*VerifyLedgerRequest)(nil),          // 75: wizard.VerifyLedgerRequest
+	(*LedgerMismatch)(nil),               // 76: wizard.LedgerMismatch
+	(*VerifyLedgerResponse)(nil),         // 77: wizard.VerifyLedgerResponse
+	(*GetLeaderStatusRequest)(nil),       // 78: wizard.GetLeaderStatusRequest
+	(*LeaderStatus)(nil),                 // 79: wizard.LeaderStatus
+	nil,                                  // 80: wizard.PartyRule.CompositionEntry
+	(*timestamppb.Timestamp)(nil),        // 81: google.protobuf.Timestamp
+}
+var file_proto_wizard_wizard_proto_depIdxs = []int32{
+	1,  // 0: wizard.Wizard.guild:type_name -> wizard.Guild
+	81, // 1: wizard.Wizard.created_at:type_name -> google.protobuf.Timestamp
+	81, // 2: wizard.Wizard.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: wizard.ListWizardsResponse.wizards:type_name -> wizard.Wizard
+	81, // 4: wizard.Job.created_at:type_name -> google.protobuf.Timestamp
+	81, // 5: wizard.Job.updated_at:type_name -> google.protobuf.Timestamp
+	15, // 6: wizard.Job.party_rule:type_name -> wizard.PartyRule
+	14, // 7: wizard.Job.affinity:type_name -> wizard.JobAffinity
+	12, // 8: wizard.Job.posting:type_name -> wizard.JobPosting
+	81, // 9: wizard.Job.expires_at:type_name -> google.protobuf.Timestamp
+	81, // 10: wizard.JobPosting.expires_at:type_name -> google.protobuf.Timestamp
+	81, // 11: wizard.JobPosting.closed_at:type_name -> google.protobuf.Timestamp
+	81, // 12: wizard.JobApplication.created_at:type_name -> google.protobuf.Timestamp
+	81, // 13: wizard.JobApplication.decided_at:type_name -> google.protobuf.Timestamp
+	80, // 14: wizard.PartyRule.composition:type_name -> wizard.PartyRule.CompositionEntry
+	17, // 15: wizard.JobParty.assignments:type_name -> wizard.JobAssignment
+	81, // 16: wizard.JobParty.created_at:type_name -> google.protobuf.Timestamp
+	81, // 17: wizard.JobParty.completed_at:type_name -> google.protobuf.Timestamp
+	81, // 18: wizard.JobAssignment.assigned_at:type_name -> google.protobuf.Timestamp
+	81, // 19: wizard.JobAssignment.started_at:type_name -> google.protobuf.Timestamp
+	81, // 20: wizard.JobAssignment.completed_at:type_name -> google.protobuf.Timestamp
+	11, // 21: wizard.JobAssignment.job:type_name -> wizard.Job
+	18, // 22: wizard.JobAssignment.progress:type_name -> wizard.JobProgress
+	81, // 23: wizard.JobProgress.started_at:type_name -> google.protobuf.Timestamp
+	81, // 24: wizard.JobProgress.last_updated_at:type_name -> google.protobuf.Timestamp
+	81, // 25: wizard.JobProgress.created_at:type_name -> google.protobuf.Timestamp
+	81, // 26: wizard.JobProgress.expected_end_time:type_name -> google.protobuf.Timestamp
+	81, // 27: wizard.JobProgress.paused_at:type_name -> google.protobuf.Timestamp
+	15, // 28: wizard.CreateJobRequest.party_rule:type_name -> wizard.PartyRule
+	81, // 29: wizard.CreateJobRequest.expires_at:type_name -> google.protobuf.Timestamp
+	11, // 30: wizard.ListJobsResponse.jobs:type_name -> wizard.Job
+	13, // 31: wizard.ListJobApplicationsResponse.applications:type_name -> wizard.JobApplication
+	81, // 32: wizard.JobTemplateSpec.active_from:type_name -> google.protobuf.Timestamp
+	81, // 33: wizard.JobTemplateSpec.active_until:type_name -> google.protobuf.Timestamp
+	34, // 34: wizard.JobTemplate.spec:type_name -> wizard.JobTemplateSpec
+	81, // 35: wizard.JobTemplate.next_run_at:type_name -> google.protobuf.Timestamp
+	81, // 36: wizard.JobTemplate.last_run_at:type_name -> google.protobuf.Timestamp
+	81, // 37: wizard.JobTemplate.created_at:type_name -> google.protobuf.Timestamp
+	81, // 38: wizard.JobTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	34, // 39: wizard.CreateJobTemplateRequest.spec:type_name -> wizard.JobTemplateSpec
+	35, // 40: wizard.ListJobTemplatesResponse.templates:type_name -> wizard.JobTemplate
+	34, // 41: wizard.UpdateJobTemplateRequest.spec:type_name -> wizard.JobTemplateSpec
+	17, // 42: wizard.GetJobAssignmentsResponse.assignments:type_name -> wizard.JobAssignment
+	54, // 43: wizard.GetActivitiesResponse.activities:type_name -> wizard.ActivityLog
+	81, // 44: wizard.ActivityLog.created_at:type_name -> google.protobuf.Timestamp
+	57, // 45: wizard.GetRealmsResponse.realms:type_name -> wizard.Realm
+	68, // 46: wizard.UpdateManaBalanceRequest.modifiers:type_name -> wizard.AppliedRewardModifier
+	71, // 47: wizard.UpdateManaBalanceRequest.counter_postings:type_name -> wizard.LedgerPosting
+	65, // 48: wizard.Progression.upcoming_unlocks:type_name -> wizard.LevelReward
+	67, // 49: wizard.GetRewardModifiersResponse.modifiers:type_name -> wizard.RewardModifier
+	71, // 50: wizard.LedgerEntry.postings:type_name -> wizard.LedgerPosting
+	81, // 51: wizard.LedgerEntry.created_at:type_name -> google.protobuf.Timestamp
+	72, // 52: wizard.GetLedgerEntriesResponse.entries:type_name -> wizard.LedgerEntry
+	76, // 53: wizard.VerifyLedgerResponse.mismatches:type_name -> wizard.LedgerMismatch
+	81, // 54: wizard.LeaderStatus.elected_at:type_name -> google.protobuf.Timestamp
+	81, // 55: wizard.LeaderStatus.heartbeat_at:type_name -> google.protobuf.Timestamp
+	2,  // 56: wizard.WizardService.CreateWizard:input_type -> wizard.CreateWizardRequest
+	3,  // 57: wizard.WizardService.GetWizard:input_type -> wizard.GetWizardRequest
+	4,  // 58: wizard.WizardService.UpdateWizard:input_type -> wizard.UpdateWizardRequest
+	5,  // 59: wizard.WizardService.ListWizards:input_type -> wizard.ListWizardsRequest
+	7,  // 60: wizard.WizardService.DeleteWizard:input_type -> wizard.DeleteWizardRequest
+	9,  // 61: wizard.WizardService.JoinGuild:input_type -> wizard.JoinGuildRequest
+	10, // 62: wizard.WizardService.LeaveGuild:input_type -> wizard.LeaveGuildRequest
+	19, // 63: wizard.WizardService.CreateJob:input_type -> wizard.CreateJobRequest
+	20, // 64: wizard.WizardService.GetJob:input_type -> wizard.GetJobRequest
+	21, // 65: wizard.WizardService.ListJobs:input_type -> wizard.ListJobsRequest
+	23, // 66: wizard.WizardService.UpdateJob:input_type -> wizard.UpdateJobRequest
+	24, // 67: wizard.WizardService.DeleteJob:input_type -> wizard.DeleteJobRequest
+	26, // 68: wizard.WizardService.AssignWizardToJob:input_type -> wizard.AssignWizardToJobRequest
+	43, // 69: wizard.WizardService.GetJobAssignments:input_type -> wizard.GetJobAssignmentsRequest
+	45, // 70: wizard.WizardService.GetJobAssignment:input_type -> wizard.GetJobAssignmentRequest
+	46, // 71: wizard.WizardService.CompleteJobAssignment:input_type -> wizard.CompleteJobAssignmentRequest
+	47, // 72: wizard.WizardService.CancelJobAssignment:input_type -> wizard.CancelJobAssignmentRequest
+	48, // 73: wizard.WizardService.PauseJobAssignment:input_type -> wizard.PauseJobAssignmentRequest
+	49, // 74: wizard.WizardService.ResumeJobAssignment:input_type -> wizard.ResumeJobAssignmentRequest
+	27, // 75: wizard.WizardService.AssignPartyToJob:input_type -> wizard.AssignPartyToJobRequest
+	28, // 76: wizard.WizardService.GetJobParty:input_type -> wizard.GetJobPartyRequest
+	29, // 77: wizard.WizardService.ApplyToJob:input_type -> wizard.ApplyToJobRequest
+	30, // 78: wizard.WizardService.ListJobApplications:input_type -> wizard.ListJobApplicationsRequest
+	32, // 79: wizard.WizardService.AcceptJobApplication:input_type -> wizard.AcceptJobApplicationRequest
+	33, // 80: wizard.WizardService.RejectJobApplication:input_type -> wizard.RejectJobApplicationRequest
+	36, // 81: wizard.WizardService.CreateJobTemplate:input_type -> wizard.CreateJobTemplateRequest
+	37, // 82: wizard.WizardService.GetJobTemplate:input_type -> wizard.GetJobTemplateRequest
+	38, // 83: wizard.WizardService.ListJobTemplates:input_type -> wizard.ListJobTemplatesRequest
+	40, // 84: wizard.WizardService.UpdateJobTemplate:input_type -> wizard.UpdateJobTemplateRequest
+	41, // 85: wizard.WizardService.DeleteJobTemplate:input_type -> wizard.DeleteJobTemplateRequest
+	50, // 86: wizard.WizardService.UpdateJobProgress:input_type -> wizard.UpdateJobProgressRequest
+	51, // 87: wizard.WizardService.GetJobProgress:input_type -> wizard.GetJobProgressRequest
+	52, // 88: wizard.WizardService.GetActivities:input_type -> wizard.GetActivitiesRequest
+	55, // 89: wizard.WizardService.GetRealms:input_type -> wizard.GetRealmsRequest
+	58, // 90: wizard.WizardService.GetManaBalance:input_type -> wizard.GetManaBalanceRequest
+	60, // 91: wizard.WizardService.UpdateManaBalance:input_type -> wizard.UpdateManaBalanceRequest
+	62, // 92: wizard.WizardService.TransferMana:input_type -> wizard.TransferManaRequest
+	64, // 93: wizard.WizardService.GetProgression:input_type -> wizard.GetProgressionRequest
+	69, // 94: wizard.WizardService.GetRewardModifiers:input_type -> wizard.GetRewardModifiersRequest
+	73, // 95: wizard.WizardService.GetLedgerEntries:input_type -> wizard.GetLedgerEntriesRequest
+	75, // 96: wizard.WizardService.VerifyLedger:input_type -> wizard.VerifyLedgerRequest
+	78, // 97: wizard.WizardService.GetLeaderStatus:input_type -> wizard.GetLeaderStatusRequest
+	0,  // 98: wizard.WizardService.CreateWizard:output_type -> wizard.Wizard
+	0,  // 99: wizard.WizardService.GetWizard:output_type -> wizard.Wizard
+	0,  // 100: wizard.WizardService.UpdateWizard:output_type -> wizard.Wizard
+	6,  // 101: wizard.WizardService.ListWizards:output_type -> wizard.ListWizardsResponse
+	8,  // 102: wizard.WizardService.DeleteWizard:output_type -> wizard.DeleteWizardResponse
+	0,  // 103: wizard.WizardService.JoinGuild:output_type -> wizard.Wizard
+	0,  // 104: wizard.WizardService.LeaveGuild:output_type -> wizard.Wizard
+	11, // 105: wizard.WizardService.CreateJob:output_type -> wizard.Job
+	11, // 106: wizard.WizardService.GetJob:output_type -> wizard.Job
+	22, // 107: wizard.WizardService.ListJobs:output_type -> wizard.ListJobsResponse
+	11, // 108: wizard.WizardService.UpdateJob:output_type -> wizard.Job
+	25, // 109: wizard.WizardService.DeleteJob:output_type -> wizard.DeleteJobResponse
+	17, // 110: wizard.WizardService.AssignWizardToJob:output_type -> wizard.JobAssignment
+	44, // 111: wizard.WizardService.GetJobAssignments:output_type -> wizard.GetJobAssignmentsResponse
+	17, // 112: wizard.WizardService.GetJobAssignment:output_type -> wizard.JobAssignment
+	17, // 113: wizard.WizardService.CompleteJobAssignment:output_type -> wizard.JobAssignment
+	17, // 114: wizard.WizardService.CancelJobAssignment:output_type -> wizard.JobAssignment
+	17, // 115: wizard.WizardService.PauseJobAssignment:output_type -> wizard.JobAssignment
+	17, // 116: wizard.WizardService.ResumeJobAssignment:output_type -> wizard.JobAssignment
+	16, // 117: wizard.WizardService.AssignPartyToJob:output_type -> wizard.JobParty
+	16, // 118: wizard.WizardService.GetJobParty:output_type -> wizard.JobParty
+	13, // 119: wizard.WizardService.ApplyToJob:output_type -> wizard.JobApplication
+	31, // 120: wizard.WizardService.ListJobApplications:output_type -> wizard.ListJobApplicationsResponse
+	13, // 121: wizard.WizardService.AcceptJobApplication:output_type -> wizard.JobApplication
+	13, // 122: wizard.WizardService.RejectJobApplication:output_type -> wizard.JobApplication
+	35, // 123: wizard.WizardService.CreateJobTemplate:output_type -> wizard.JobTemplate
+	35, // 124: wizard.WizardService.GetJobTemplate:output_type -> wizard.JobTemplate
+	39, // 125: wizard.WizardService.ListJobTemplates:output_type -> wizard.ListJobTemplatesResponse
+	35, // 126: wizard.WizardService.UpdateJobTemplate:output_type -> wizard.JobTemplate
+	42, // 127: wizard.WizardService.DeleteJobTemplate:output_type -> wizard.DeleteJobTemplateResponse
+	18, // 128: wizard.WizardService.UpdateJobProgress:output_type -> wizard.JobProgress
+	18, // 129: wizard.WizardService.GetJobProgress:output_type -> wizard.JobProgress
+	53, // 130: wizard.WizardService.GetActivities:output_type -> wizard.GetActivitiesResponse
+	56, // 131: wizard.WizardService.GetRealms:output_type -> wizard.GetRealmsResponse
+	59, // 132: wizard.WizardService.GetManaBalance:output_type -> wizard.GetManaBalanceResponse
+	61, // 133: wizard.WizardService.UpdateManaBalance:output_type -> wizard.UpdateManaBalanceResponse
+	63, // 134: wizard.WizardService.TransferMana:output_type -> wizard.TransferManaResponse
+	66, // 135: wizard.WizardService.GetProgression:output_type -> wizard.Progression
+	70, // 136: wizard.WizardService.GetRewardModifiers:output_type -> wizard.GetRewardModifiersResponse
+	74, // 137: wizard.WizardService.GetLedgerEntries:output_type -> wizard.GetLedgerEntriesResponse
+	77, // 138: wizard.WizardService.VerifyLedger:output_type -> wizard.VerifyLedgerResponse
+	79, // 139: wizard.WizardService.GetLeaderStatus:output_type -> wizard.LeaderStatus
+	98, // [98:140] is the sub-list for method output_type
+	56, // [56:98] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
+}
+
+func init() { file_proto_wizard_wizard_proto_init() }
+func file_proto_wizard_wizard_proto_init() {
+	if File_proto_wizard_wizard_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_wizard_wizard_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Wizard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Guild); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateWizardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetWizardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateWizardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ListWizardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ListWizardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteWizardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteWizardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinGuildRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*LeaveGuildRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*Job); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*JobPosting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*JobApplication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*JobAffinity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*PartyRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*JobParty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*JobAssignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*JobProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*GetJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ListJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ListJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3456,8 +7245,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*Guild); i {
+		file_proto_wizard_wizard_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3468,8 +7257,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateWizardRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*AssignWizardToJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3480,8 +7269,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GetWizardRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*AssignPartyToJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3492,8 +7281,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateWizardRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*GetJobPartyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3504,8 +7293,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*ListWizardsRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ApplyToJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3516,8 +7305,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*ListWizardsResponse); i {
+		file_proto_wizard_wizard_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ListJobApplicationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3528,8 +7317,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteWizardRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*ListJobApplicationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3540,8 +7329,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteWizardResponse); i {
+		file_proto_wizard_wizard_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*AcceptJobApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3552,8 +7341,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*JoinGuildRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*RejectJobApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3564,8 +7353,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*LeaveGuildRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*JobTemplateSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3576,8 +7365,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*Job); i {
+		file_proto_wizard_wizard_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*JobTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3588,8 +7377,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*JobAssignment); i {
+		file_proto_wizard_wizard_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateJobTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3600,8 +7389,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*JobProgress); i {
+		file_proto_wizard_wizard_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*GetJobTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3612,8 +7401,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateJobRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ListJobTemplatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3624,8 +7413,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*GetJobRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*ListJobTemplatesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3636,8 +7425,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*ListJobsRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateJobTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3648,8 +7437,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*ListJobsResponse); i {
+		file_proto_wizard_wizard_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteJobTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3660,8 +7449,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateJobRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteJobTemplateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3672,8 +7461,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteJobRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*GetJobAssignmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3684,8 +7473,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteJobResponse); i {
+		file_proto_wizard_wizard_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*GetJobAssignmentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3696,8 +7485,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*AssignWizardToJobRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*GetJobAssignmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3708,8 +7497,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*GetJobAssignmentsRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*CompleteJobAssignmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3720,8 +7509,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*GetJobAssignmentsResponse); i {
+		file_proto_wizard_wizard_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelJobAssignmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3732,8 +7521,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*CompleteJobAssignmentRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*PauseJobAssignmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3744,8 +7533,8 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*CancelJobAssignmentRequest); i {
+		file_proto_wizard_wizard_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*ResumeJobAssignmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3756,7 +7545,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[26].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[50].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateJobProgressRequest); i {
 			case 0:
 				return &v.state
@@ -3768,7 +7557,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[27].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[51].Exporter = func(v any, i int) any {
 			switch v := v.(*GetJobProgressRequest); i {
 			case 0:
 				return &v.state
@@ -3780,7 +7569,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[28].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[52].Exporter = func(v any, i int) any {
 			switch v := v.(*GetActivitiesRequest); i {
 			case 0:
 				return &v.state
@@ -3792,7 +7581,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[29].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[53].Exporter = func(v any, i int) any {
 			switch v := v.(*GetActivitiesResponse); i {
 			case 0:
 				return &v.state
@@ -3804,7 +7593,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[30].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*ActivityLog); i {
 			case 0:
 				return &v.state
@@ -3816,7 +7605,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[31].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[55].Exporter = func(v any, i int) any {
 			switch v := v.(*GetRealmsRequest); i {
 			case 0:
 				return &v.state
@@ -3828,7 +7617,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[32].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[56].Exporter = func(v any, i int) any {
 			switch v := v.(*GetRealmsResponse); i {
 			case 0:
 				return &v.state
@@ -3840,7 +7629,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[33].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[57].Exporter = func(v any, i int) any {
 			switch v := v.(*Realm); i {
 			case 0:
 				return &v.state
@@ -3852,7 +7641,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[34].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[58].Exporter = func(v any, i int) any {
 			switch v := v.(*GetManaBalanceRequest); i {
 			case 0:
 				return &v.state
@@ -3864,7 +7653,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[35].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[59].Exporter = func(v any, i int) any {
 			switch v := v.(*GetManaBalanceResponse); i {
 			case 0:
 				return &v.state
@@ -3876,7 +7665,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[36].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[60].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateManaBalanceRequest); i {
 			case 0:
 				return &v.state
@@ -3888,7 +7677,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[37].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[61].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateManaBalanceResponse); i {
 			case 0:
 				return &v.state
@@ -3900,7 +7689,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[38].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[62].Exporter = func(v any, i int) any {
 			switch v := v.(*TransferManaRequest); i {
 			case 0:
 				return &v.state
@@ -3912,7 +7701,7 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
-		file_proto_wizard_wizard_proto_msgTypes[39].Exporter = func(v any, i int) any {
+		file_proto_wizard_wizard_proto_msgTypes[63].Exporter = func(v any, i int) any {
 			switch v := v.(*TransferManaResponse); i {
 			case 0:
 				return &v.state
@@ -3924,6 +7713,198 @@ func file_proto_wizard_wizard_proto_init() {
 				return nil
 			}
 		}
+		file_proto_wizard_wizard_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*GetProgressionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*LevelReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*Progression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*RewardModifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*AppliedRewardModifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRewardModifiersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRewardModifiersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerPosting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLedgerEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLedgerEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyLedgerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerMismatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyLedgerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLeaderStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_wizard_wizard_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*LeaderStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3931,7 +7912,7 @@ func file_proto_wizard_wizard_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_wizard_wizard_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   40,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,8 +33,22 @@ const (
 	WizardService_DeleteJob_FullMethodName             = "/wizard.WizardService/DeleteJob"
 	WizardService_AssignWizardToJob_FullMethodName     = "/wizard.WizardService/AssignWizardToJob"
 	WizardService_GetJobAssignments_FullMethodName     = "/wizard.WizardService/GetJobAssignments"
+	WizardService_GetJobAssignment_FullMethodName      = "/wizard.WizardService/GetJobAssignment"
 	WizardService_CompleteJobAssignment_FullMethodName = "/wizard.WizardService/CompleteJobAssignment"
 	WizardService_CancelJobAssignment_FullMethodName   = "/wizard.WizardService/CancelJobAssignment"
+	WizardService_PauseJobAssignment_FullMethodName    = "/wizard.WizardService/PauseJobAssignment"
+	WizardService_ResumeJobAssignment_FullMethodName   = "/wizard.WizardService/ResumeJobAssignment"
+	WizardService_AssignPartyToJob_FullMethodName      = "/wizard.WizardService/AssignPartyToJob"
+	WizardService_GetJobParty_FullMethodName           = "/wizard.WizardService/GetJobParty"
+	WizardService_ApplyToJob_FullMethodName            = "/wizard.WizardService/ApplyToJob"
+	WizardService_ListJobApplications_FullMethodName   = "/wizard.WizardService/ListJobApplications"
+	WizardService_AcceptJobApplication_FullMethodName  = "/wizard.WizardService/AcceptJobApplication"
+	WizardService_RejectJobApplication_FullMethodName  = "/wizard.WizardService/RejectJobApplication"
+	WizardService_CreateJobTemplate_FullMethodName     = "/wizard.WizardService/CreateJobTemplate"
+	WizardService_GetJobTemplate_FullMethodName        = "/wizard.WizardService/GetJobTemplate"
+	WizardService_ListJobTemplates_FullMethodName      = "/wizard.WizardService/ListJobTemplates"
+	WizardService_UpdateJobTemplate_FullMethodName     = "/wizard.WizardService/UpdateJobTemplate"
+	WizardService_DeleteJobTemplate_FullMethodName     = "/wizard.WizardService/DeleteJobTemplate"
 	WizardService_UpdateJobProgress_FullMethodName     = "/wizard.WizardService/UpdateJobProgress"
 	WizardService_GetJobProgress_FullMethodName        = "/wizard.WizardService/GetJobProgress"
 	WizardService_GetActivities_FullMethodName         = "/wizard.WizardService/GetActivities"
@@ -42,6 +56,11 @@ const (
 	WizardService_GetManaBalance_FullMethodName        = "/wizard.WizardService/GetManaBalance"
 	WizardService_UpdateManaBalance_FullMethodName     = "/wizard.WizardService/UpdateManaBalance"
 	WizardService_TransferMana_FullMethodName          = "/wizard.WizardService/TransferMana"
+	WizardService_GetProgression_FullMethodName        = "/wizard.WizardService/GetProgression"
+	WizardService_GetRewardModifiers_FullMethodName    = "/wizard.WizardService/GetRewardModifiers"
+	WizardService_GetLedgerEntries_FullMethodName      = "/wizard.WizardService/GetLedgerEntries"
+	WizardService_VerifyLedger_FullMethodName          = "/wizard.WizardService/VerifyLedger"
+	WizardService_GetLeaderStatus_FullMethodName       = "/wizard.WizardService/GetLeaderStatus"
 )
 
 // WizardServiceClient is the client API for WizardService service.
@@ -64,8 +83,25 @@ type WizardServiceClient interface {
 	// Job Assignments
 	AssignWizardToJob(ctx context.Context, in *AssignWizardToJobRequest, opts ...grpc.CallOption) (*JobAssignment, error)
 	GetJobAssignments(ctx context.Context, in *GetJobAssignmentsRequest, opts ...grpc.CallOption) (*GetJobAssignmentsResponse, error)
+	GetJobAssignment(ctx context.Context, in *GetJobAssignmentRequest, opts ...grpc.CallOption) (*JobAssignment, error)
 	CompleteJobAssignment(ctx context.Context, in *CompleteJobAssignmentRequest, opts ...grpc.CallOption) (*JobAssignment, error)
 	CancelJobAssignment(ctx context.Context, in *CancelJobAssignmentRequest, opts ...grpc.CallOption) (*JobAssignment, error)
+	PauseJobAssignment(ctx context.Context, in *PauseJobAssignmentRequest, opts ...grpc.CallOption) (*JobAssignment, error)
+	ResumeJobAssignment(ctx context.Context, in *ResumeJobAssignmentRequest, opts ...grpc.CallOption) (*JobAssignment, error)
+	// Party Jobs
+	AssignPartyToJob(ctx context.Context, in *AssignPartyToJobRequest, opts ...grpc.CallOption) (*JobParty, error)
+	GetJobParty(ctx context.Context, in *GetJobPartyRequest, opts ...grpc.CallOption) (*JobParty, error)
+	// Job Applications
+	ApplyToJob(ctx context.Context, in *ApplyToJobRequest, opts ...grpc.CallOption) (*JobApplication, error)
+	ListJobApplications(ctx context.Context, in *ListJobApplicationsRequest, opts ...grpc.CallOption) (*ListJobApplicationsResponse, error)
+	AcceptJobApplication(ctx context.Context, in *AcceptJobApplicationRequest, opts ...grpc.CallOption) (*JobApplication, error)
+	RejectJobApplication(ctx context.Context, in *RejectJobApplicationRequest, opts ...grpc.CallOption) (*JobApplication, error)
+	// Job Templates (admin)
+	CreateJobTemplate(ctx context.Context, in *CreateJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplate, error)
+	GetJobTemplate(ctx context.Context, in *GetJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplate, error)
+	ListJobTemplates(ctx context.Context, in *ListJobTemplatesRequest, opts ...grpc.CallOption) (*ListJobTemplatesResponse, error)
+	UpdateJobTemplate(ctx context.Context, in *UpdateJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplate, error)
+	DeleteJobTemplate(ctx context.Context, in *DeleteJobTemplateRequest, opts ...grpc.CallOption) (*DeleteJobTemplateResponse, error)
 	// Job Progress
 	UpdateJobProgress(ctx context.Context, in *UpdateJobProgressRequest, opts ...grpc.CallOption) (*JobProgress, error)
 	GetJobProgress(ctx context.Context, in *GetJobProgressRequest, opts ...grpc.CallOption) (*JobProgress, error)
@@ -77,6 +113,15 @@ type WizardServiceClient interface {
 	GetManaBalance(ctx context.Context, in *GetManaBalanceRequest, opts ...grpc.CallOption) (*GetManaBalanceResponse, error)
 	UpdateManaBalance(ctx context.Context, in *UpdateManaBalanceRequest, opts ...grpc.CallOption) (*UpdateManaBalanceResponse, error)
 	TransferMana(ctx context.Context, in *TransferManaRequest, opts ...grpc.CallOption) (*TransferManaResponse, error)
+	// Progression
+	GetProgression(ctx context.Context, in *GetProgressionRequest, opts ...grpc.CallOption) (*Progression, error)
+	// Reward Modifiers
+	GetRewardModifiers(ctx context.Context, in *GetRewardModifiersRequest, opts ...grpc.CallOption) (*GetRewardModifiersResponse, error)
+	// Mana Ledger
+	GetLedgerEntries(ctx context.Context, in *GetLedgerEntriesRequest, opts ...grpc.CallOption) (*GetLedgerEntriesResponse, error)
+	VerifyLedger(ctx context.Context, in *VerifyLedgerRequest, opts ...grpc.CallOption) (*VerifyLedgerResponse, error)
+	// Background Workers
+	GetLeaderStatus(ctx context.Context, in *GetLeaderStatusRequest, opts ...grpc.CallOption) (*LeaderStatus, error)
 }
 
 type wizardServiceClient struct {
@@ -227,6 +272,16 @@ func (c *wizardServiceClient) GetJobAssignments(ctx context.Context, in *GetJobA
 	return out, nil
 }
 
+func (c *wizardServiceClient) GetJobAssignment(ctx context.Context, in *GetJobAssignmentRequest, opts ...grpc.CallOption) (*JobAssignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobAssignment)
+	err := c.cc.Invoke(ctx, WizardService_GetJobAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *wizardServiceClient) CompleteJobAssignment(ctx context.Context, in *CompleteJobAssignmentRequest, opts ...grpc.CallOption) (*JobAssignment, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(JobAssignment)
@@ -247,6 +302,136 @@ func (c *wizardServiceClient) CancelJobAssignment(ctx context.Context, in *Cance
 	return out, nil
 }
 
+func (c *wizardServiceClient) PauseJobAssignment(ctx context.Context, in *PauseJobAssignmentRequest, opts ...grpc.CallOption) (*JobAssignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobAssignment)
+	err := c.cc.Invoke(ctx, WizardService_PauseJobAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) ResumeJobAssignment(ctx context.Context, in *ResumeJobAssignmentRequest, opts ...grpc.CallOption) (*JobAssignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobAssignment)
+	err := c.cc.Invoke(ctx, WizardService_ResumeJobAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) AssignPartyToJob(ctx context.Context, in *AssignPartyToJobRequest, opts ...grpc.CallOption) (*JobParty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobParty)
+	err := c.cc.Invoke(ctx, WizardService_AssignPartyToJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) GetJobParty(ctx context.Context, in *GetJobPartyRequest, opts ...grpc.CallOption) (*JobParty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobParty)
+	err := c.cc.Invoke(ctx, WizardService_GetJobParty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) ApplyToJob(ctx context.Context, in *ApplyToJobRequest, opts ...grpc.CallOption) (*JobApplication, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobApplication)
+	err := c.cc.Invoke(ctx, WizardService_ApplyToJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) ListJobApplications(ctx context.Context, in *ListJobApplicationsRequest, opts ...grpc.CallOption) (*ListJobApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobApplicationsResponse)
+	err := c.cc.Invoke(ctx, WizardService_ListJobApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) AcceptJobApplication(ctx context.Context, in *AcceptJobApplicationRequest, opts ...grpc.CallOption) (*JobApplication, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobApplication)
+	err := c.cc.Invoke(ctx, WizardService_AcceptJobApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) RejectJobApplication(ctx context.Context, in *RejectJobApplicationRequest, opts ...grpc.CallOption) (*JobApplication, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobApplication)
+	err := c.cc.Invoke(ctx, WizardService_RejectJobApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) CreateJobTemplate(ctx context.Context, in *CreateJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobTemplate)
+	err := c.cc.Invoke(ctx, WizardService_CreateJobTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) GetJobTemplate(ctx context.Context, in *GetJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobTemplate)
+	err := c.cc.Invoke(ctx, WizardService_GetJobTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) ListJobTemplates(ctx context.Context, in *ListJobTemplatesRequest, opts ...grpc.CallOption) (*ListJobTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobTemplatesResponse)
+	err := c.cc.Invoke(ctx, WizardService_ListJobTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) UpdateJobTemplate(ctx context.Context, in *UpdateJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobTemplate)
+	err := c.cc.Invoke(ctx, WizardService_UpdateJobTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) DeleteJobTemplate(ctx context.Context, in *DeleteJobTemplateRequest, opts ...grpc.CallOption) (*DeleteJobTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteJobTemplateResponse)
+	err := c.cc.Invoke(ctx, WizardService_DeleteJobTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *wizardServiceClient) UpdateJobProgress(ctx context.Context, in *UpdateJobProgressRequest, opts ...grpc.CallOption) (*JobProgress, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(JobProgress)
@@ -317,6 +502,56 @@ func (c *wizardServiceClient) TransferMana(ctx context.Context, in *TransferMana
 	return out, nil
 }
 
+func (c *wizardServiceClient) GetProgression(ctx context.Context, in *GetProgressionRequest, opts ...grpc.CallOption) (*Progression, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Progression)
+	err := c.cc.Invoke(ctx, WizardService_GetProgression_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) GetRewardModifiers(ctx context.Context, in *GetRewardModifiersRequest, opts ...grpc.CallOption) (*GetRewardModifiersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRewardModifiersResponse)
+	err := c.cc.Invoke(ctx, WizardService_GetRewardModifiers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) GetLedgerEntries(ctx context.Context, in *GetLedgerEntriesRequest, opts ...grpc.CallOption) (*GetLedgerEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLedgerEntriesResponse)
+	err := c.cc.Invoke(ctx, WizardService_GetLedgerEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) VerifyLedger(ctx context.Context, in *VerifyLedgerRequest, opts ...grpc.CallOption) (*VerifyLedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyLedgerResponse)
+	err := c.cc.Invoke(ctx, WizardService_VerifyLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wizardServiceClient) GetLeaderStatus(ctx context.Context, in *GetLeaderStatusRequest, opts ...grpc.CallOption) (*LeaderStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaderStatus)
+	err := c.cc.Invoke(ctx, WizardService_GetLeaderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WizardServiceServer is the server API for WizardService service.
 // All implementations must embed UnimplementedWizardServiceServer
 // for forward compatibility.
@@ -337,8 +572,25 @@ type WizardServiceServer interface {
 	// Job Assignments
 	AssignWizardToJob(context.Context, *AssignWizardToJobRequest) (*JobAssignment, error)
 	GetJobAssignments(context.Context, *GetJobAssignmentsRequest) (*GetJobAssignmentsResponse, error)
+	GetJobAssignment(context.Context, *GetJobAssignmentRequest) (*JobAssignment, error)
 	CompleteJobAssignment(context.Context, *CompleteJobAssignmentRequest) (*JobAssignment, error)
 	CancelJobAssignment(context.Context, *CancelJobAssignmentRequest) (*JobAssignment, error)
+	PauseJobAssignment(context.Context, *PauseJobAssignmentRequest) (*JobAssignment, error)
+	ResumeJobAssignment(context.Context, *ResumeJobAssignmentRequest) (*JobAssignment, error)
+	// Party Jobs
+	AssignPartyToJob(context.Context, *AssignPartyToJobRequest) (*JobParty, error)
+	GetJobParty(context.Context, *GetJobPartyRequest) (*JobParty, error)
+	// Job Applications
+	ApplyToJob(context.Context, *ApplyToJobRequest) (*JobApplication, error)
+	ListJobApplications(context.Context, *ListJobApplicationsRequest) (*ListJobApplicationsResponse, error)
+	AcceptJobApplication(context.Context, *AcceptJobApplicationRequest) (*JobApplication, error)
+	RejectJobApplication(context.Context, *RejectJobApplicationRequest) (*JobApplication, error)
+	// Job Templates (admin)
+	CreateJobTemplate(context.Context, *CreateJobTemplateRequest) (*JobTemplate, error)
+	GetJobTemplate(context.Context, *GetJobTemplateRequest) (*JobTemplate, error)
+	ListJobTemplates(context.Context, *ListJobTemplatesRequest) (*ListJobTemplatesResponse, error)
+	UpdateJobTemplate(context.Context, *UpdateJobTemplateRequest) (*JobTemplate, error)
+	DeleteJobTemplate(context.Context, *DeleteJobTemplateRequest) (*DeleteJobTemplateResponse, error)
 	// Job Progress
 	UpdateJobProgress(context.Context, *UpdateJobProgressRequest) (*JobProgress, error)
 	GetJobProgress(context.Context, *GetJobProgressRequest) (*JobProgress, error)
@@ -350,6 +602,15 @@ type WizardServiceServer interface {
 	GetManaBalance(context.Context, *GetManaBalanceRequest) (*GetManaBalanceResponse, error)
 	UpdateManaBalance(context.Context, *UpdateManaBalanceRequest) (*UpdateManaBalanceResponse, error)
 	TransferMana(context.Context, *TransferManaRequest) (*TransferManaResponse, error)
+	// Progression
+	GetProgression(context.Context, *GetProgressionRequest) (*Progression, error)
+	// Reward Modifiers
+	GetRewardModifiers(context.Context, *GetRewardModifiersRequest) (*GetRewardModifiersResponse, error)
+	// Mana Ledger
+	GetLedgerEntries(context.Context, *GetLedgerEntriesRequest) (*GetLedgerEntriesResponse, error)
+	VerifyLedger(context.Context, *VerifyLedgerRequest) (*VerifyLedgerResponse, error)
+	// Background Workers
+	GetLeaderStatus(context.Context, *GetLeaderStatusRequest) (*LeaderStatus, error)
 	mustEmbedUnimplementedWizardServiceServer()
 }
 
@@ -402,12 +663,54 @@ func (UnimplementedWizardServiceServer) AssignWizardToJob(context.Context, *Assi
 func (UnimplementedWizardServiceServer) GetJobAssignments(context.Context, *GetJobAssignmentsRequest) (*GetJobAssignmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJobAssignments not implemented")
 }
+func (UnimplementedWizardServiceServer) GetJobAssignment(context.Context, *GetJobAssignmentRequest) (*JobAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobAssignment not implemented")
+}
 func (UnimplementedWizardServiceServer) CompleteJobAssignment(context.Context, *CompleteJobAssignmentRequest) (*JobAssignment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CompleteJobAssignment not implemented")
 }
 func (UnimplementedWizardServiceServer) CancelJobAssignment(context.Context, *CancelJobAssignmentRequest) (*JobAssignment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelJobAssignment not implemented")
 }
+func (UnimplementedWizardServiceServer) PauseJobAssignment(context.Context, *PauseJobAssignmentRequest) (*JobAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseJobAssignment not implemented")
+}
+func (UnimplementedWizardServiceServer) ResumeJobAssignment(context.Context, *ResumeJobAssignmentRequest) (*JobAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJobAssignment not implemented")
+}
+func (UnimplementedWizardServiceServer) AssignPartyToJob(context.Context, *AssignPartyToJobRequest) (*JobParty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignPartyToJob not implemented")
+}
+func (UnimplementedWizardServiceServer) GetJobParty(context.Context, *GetJobPartyRequest) (*JobParty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobParty not implemented")
+}
+func (UnimplementedWizardServiceServer) ApplyToJob(context.Context, *ApplyToJobRequest) (*JobApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyToJob not implemented")
+}
+func (UnimplementedWizardServiceServer) ListJobApplications(context.Context, *ListJobApplicationsRequest) (*ListJobApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobApplications not implemented")
+}
+func (UnimplementedWizardServiceServer) AcceptJobApplication(context.Context, *AcceptJobApplicationRequest) (*JobApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptJobApplication not implemented")
+}
+func (UnimplementedWizardServiceServer) RejectJobApplication(context.Context, *RejectJobApplicationRequest) (*JobApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectJobApplication not implemented")
+}
+func (UnimplementedWizardServiceServer) CreateJobTemplate(context.Context, *CreateJobTemplateRequest) (*JobTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJobTemplate not implemented")
+}
+func (UnimplementedWizardServiceServer) GetJobTemplate(context.Context, *GetJobTemplateRequest) (*JobTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobTemplate not implemented")
+}
+func (UnimplementedWizardServiceServer) ListJobTemplates(context.Context, *ListJobTemplatesRequest) (*ListJobTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobTemplates not implemented")
+}
+func (UnimplementedWizardServiceServer) UpdateJobTemplate(context.Context, *UpdateJobTemplateRequest) (*JobTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobTemplate not implemented")
+}
+func (UnimplementedWizardServiceServer) DeleteJobTemplate(context.Context, *DeleteJobTemplateRequest) (*DeleteJobTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJobTemplate not implemented")
+}
 func (UnimplementedWizardServiceServer) UpdateJobProgress(context.Context, *UpdateJobProgressRequest) (*JobProgress, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobProgress not implemented")
 }
@@ -429,6 +732,21 @@ func (UnimplementedWizardServiceServer) UpdateManaBalance(context.Context, *Upda
 func (UnimplementedWizardServiceServer) TransferMana(context.Context, *TransferManaRequest) (*TransferManaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferMana not implemented")
 }
+func (UnimplementedWizardServiceServer) GetProgression(context.Context, *GetProgressionRequest) (*Progression, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProgression not implemented")
+}
+func (UnimplementedWizardServiceServer) GetRewardModifiers(context.Context, *GetRewardModifiersRequest) (*GetRewardModifiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRewardModifiers not implemented")
+}
+func (UnimplementedWizardServiceServer) GetLedgerEntries(context.Context, *GetLedgerEntriesRequest) (*GetLedgerEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLedgerEntries not implemented")
+}
+func (UnimplementedWizardServiceServer) VerifyLedger(context.Context, *VerifyLedgerRequest) (*VerifyLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyLedger not implemented")
+}
+func (UnimplementedWizardServiceServer) GetLeaderStatus(context.Context, *GetLeaderStatusRequest) (*LeaderStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderStatus not implemented")
+}
 func (UnimplementedWizardServiceServer) mustEmbedUnimplementedWizardServiceServer() {}
 func (UnimplementedWizardServiceServer) testEmbeddedByValue()                       {}
 
@@ -702,6 +1020,24 @@ func _WizardService_GetJobAssignments_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WizardService_GetJobAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).GetJobAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_GetJobAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).GetJobAssignment(ctx, req.(*GetJobAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WizardService_CompleteJobAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CompleteJobAssignmentRequest)
 	if err := dec(in); err != nil {
@@ -738,6 +1074,240 @@ func _WizardService_CancelJobAssignment_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WizardService_PauseJobAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseJobAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).PauseJobAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_PauseJobAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).PauseJobAssignment(ctx, req.(*PauseJobAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_ResumeJobAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeJobAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).ResumeJobAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_ResumeJobAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).ResumeJobAssignment(ctx, req.(*ResumeJobAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_AssignPartyToJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignPartyToJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).AssignPartyToJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_AssignPartyToJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).AssignPartyToJob(ctx, req.(*AssignPartyToJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_GetJobParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobPartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).GetJobParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_GetJobParty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).GetJobParty(ctx, req.(*GetJobPartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_ApplyToJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyToJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).ApplyToJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_ApplyToJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).ApplyToJob(ctx, req.(*ApplyToJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_ListJobApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).ListJobApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_ListJobApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).ListJobApplications(ctx, req.(*ListJobApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_AcceptJobApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptJobApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).AcceptJobApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_AcceptJobApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).AcceptJobApplication(ctx, req.(*AcceptJobApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_RejectJobApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectJobApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).RejectJobApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_RejectJobApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).RejectJobApplication(ctx, req.(*RejectJobApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_CreateJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJobTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).CreateJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_CreateJobTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).CreateJobTemplate(ctx, req.(*CreateJobTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_GetJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).GetJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_GetJobTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).GetJobTemplate(ctx, req.(*GetJobTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_ListJobTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).ListJobTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_ListJobTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).ListJobTemplates(ctx, req.(*ListJobTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_UpdateJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).UpdateJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_UpdateJobTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).UpdateJobTemplate(ctx, req.(*UpdateJobTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_DeleteJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJobTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).DeleteJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_DeleteJobTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).DeleteJobTemplate(ctx, req.(*DeleteJobTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WizardService_UpdateJobProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateJobProgressRequest)
 	if err := dec(in); err != nil {
@@ -864,6 +1434,96 @@ func _WizardService_TransferMana_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WizardService_GetProgression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProgressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).GetProgression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_GetProgression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).GetProgression(ctx, req.(*GetProgressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_GetRewardModifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRewardModifiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).GetRewardModifiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_GetRewardModifiers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).GetRewardModifiers(ctx, req.(*GetRewardModifiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_GetLedgerEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLedgerEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).GetLedgerEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_GetLedgerEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).GetLedgerEntries(ctx, req.(*GetLedgerEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_VerifyLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).VerifyLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_VerifyLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).VerifyLedger(ctx, req.(*VerifyLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WizardService_GetLeaderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WizardServiceServer).GetLeaderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WizardService_GetLeaderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WizardServiceServer).GetLeaderStatus(ctx, req.(*GetLeaderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WizardService_ServiceDesc is the grpc.ServiceDesc for WizardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -927,6 +1587,10 @@ var WizardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetJobAssignments",
 			Handler:    _WizardService_GetJobAssignments_Handler,
 		},
+		{
+			MethodName: "GetJobAssignment",
+			Handler:    _WizardService_GetJobAssignment_Handler,
+		},
 		{
 			MethodName: "CompleteJobAssignment",
 			Handler:    _WizardService_CompleteJobAssignment_Handler,
@@ -935,6 +1599,58 @@ var WizardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelJobAssignment",
 			Handler:    _WizardService_CancelJobAssignment_Handler,
 		},
+		{
+			MethodName: "PauseJobAssignment",
+			Handler:    _WizardService_PauseJobAssignment_Handler,
+		},
+		{
+			MethodName: "ResumeJobAssignment",
+			Handler:    _WizardService_ResumeJobAssignment_Handler,
+		},
+		{
+			MethodName: "AssignPartyToJob",
+			Handler:    _WizardService_AssignPartyToJob_Handler,
+		},
+		{
+			MethodName: "GetJobParty",
+			Handler:    _WizardService_GetJobParty_Handler,
+		},
+		{
+			MethodName: "ApplyToJob",
+			Handler:    _WizardService_ApplyToJob_Handler,
+		},
+		{
+			MethodName: "ListJobApplications",
+			Handler:    _WizardService_ListJobApplications_Handler,
+		},
+		{
+			MethodName: "AcceptJobApplication",
+			Handler:    _WizardService_AcceptJobApplication_Handler,
+		},
+		{
+			MethodName: "RejectJobApplication",
+			Handler:    _WizardService_RejectJobApplication_Handler,
+		},
+		{
+			MethodName: "CreateJobTemplate",
+			Handler:    _WizardService_CreateJobTemplate_Handler,
+		},
+		{
+			MethodName: "GetJobTemplate",
+			Handler:    _WizardService_GetJobTemplate_Handler,
+		},
+		{
+			MethodName: "ListJobTemplates",
+			Handler:    _WizardService_ListJobTemplates_Handler,
+		},
+		{
+			MethodName: "UpdateJobTemplate",
+			Handler:    _WizardService_UpdateJobTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteJobTemplate",
+			Handler:    _WizardService_DeleteJobTemplate_Handler,
+		},
 		{
 			MethodName: "UpdateJobProgress",
 			Handler:    _WizardService_UpdateJobProgress_Handler,
@@ -963,6 +1679,26 @@ var WizardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TransferMana",
 			Handler:    _WizardService_TransferMana_Handler,
 		},
+		{
+			MethodName: "GetProgression",
+			Handler:    _WizardService_GetProgression_Handler,
+		},
+		{
+			MethodName: "GetRewardModifiers",
+			Handler:    _WizardService_GetRewardModifiers_Handler,
+		},
+		{
+			MethodName: "GetLedgerEntries",
+			Handler:    _WizardService_GetLedgerEntries_Handler,
+		},
+		{
+			MethodName: "VerifyLedger",
+			Handler:    _WizardService_VerifyLedger_Handler,
+		},
+		{
+			MethodName: "GetLeaderStatus",
+			Handler:    _WizardService_GetLeaderStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/wizard/wizard.proto",
@@ -21,7 +21,7 @@ go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@latest
 $SCRIPT_DIR = $PSScriptRoot
 $PROJECT_ROOT = (Get-Item $SCRIPT_DIR).Parent.FullName
 
-$SERVICES = @("auth", "wizard", "mana", "spell", "realm")
+$SERVICES = @("auth", "wizard", "mana", "spell", "realm", "marketplace")
 
 foreach ($SERVICE in $SERVICES) {
     $PROTO_PATH = Join-Path $PROJECT_ROOT "proto\$SERVICE"
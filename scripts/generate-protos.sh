@@ -52,7 +52,7 @@ generate_proto() {
 }
 
 # Generate for all services
-SERVICES=("auth" "wizard" "mana" "spell" "realm")
+SERVICES=("auth" "wizard" "mana" "spell" "realm" "marketplace")
 
 echo "🚀 Starting proto generation..."
 
@@ -226,6 +226,10 @@ class ApiClient {
         return this.request(`/wizards/${id}`);
     }
 
+    async getWizardProgression(id) {
+        return this.request(`/wizards/${id}/progression`);
+    }
+
     async createWizard(name, realm, element) {
         return this.request('/wizards', {
             method: 'POST',
@@ -305,12 +309,13 @@ class ApiClient {
     }
 
     // Jobs API calls
-    async getJobs(realm = '', element = '', difficulty = '', pageSize = 20, pageNumber = 1) {
+    async getJobs(realm = '', element = '', difficulty = '', pageSize = 20, pageNumber = 1, wizardId = '') {
         let query = `page_size=${pageSize}&page_number=${pageNumber}&only_active=true`;
         const params = [];
         if (realm) params.push(`realm=${encodeURIComponent(realm)}`);
         if (element) params.push(`element=${encodeURIComponent(element)}`);
         if (difficulty) params.push(`difficulty=${encodeURIComponent(difficulty)}`);
+        if (wizardId) params.push(`wizard_id=${wizardId}`);
         
         if (params.length > 0) {
             query += '&' + params.join('&');
@@ -336,6 +341,53 @@ class ApiClient {
         });
     }
 
+    async assignPartyToJob(jobId, wizardIds) {
+        return this.request('/jobs/party', {
+            method: 'POST',
+            body: JSON.stringify({
+                job_id: jobId,
+                wizard_ids: wizardIds,
+            }),
+        });
+    }
+
+    async getJobParty(partyId) {
+        return this.request(`/jobs/parties/${partyId}`);
+    }
+
+    async applyToJob(jobId, wizardId, message = '') {
+        return this.request('/jobs/applications', {
+            method: 'POST',
+            body: JSON.stringify({
+                job_id: jobId,
+                wizard_id: wizardId,
+                message: message,
+            }),
+        });
+    }
+
+    async getJobApplications({ jobId = '', wizardId = '', status = '' } = {}) {
+        const params = [];
+        if (jobId) params.push(`job_id=${jobId}`);
+        if (wizardId) params.push(`wizard_id=${wizardId}`);
+        if (status) params.push(`status=${encodeURIComponent(status)}`);
+        return this.request(`/jobs/applications?${params.join('&')}`);
+    }
+
+    async acceptJobApplication(applicationId, posterWizardId) {
+        return this.request(`/jobs/applications/${applicationId}/accept`, {
+            method: 'POST',
+            body: JSON.stringify({ poster_wizard_id: posterWizardId }),
+        });
+    }
+
+    async rejectJobApplication(applicationId, posterWizardId) {
+        return this.request(`/jobs/applications/${applicationId}/reject`, {
+            method: 'POST',
+            body: JSON.stringify({ poster_wizard_id: posterWizardId }),
+        });
+    }
+
     async getJobAssignments(wizardId = '', status = '', pageSize = 20, pageNumber = 1) {
         let query = `page_size=${pageSize}&page_number=${pageNumber}`;
         const params = [];
@@ -368,6 +420,24 @@ class ApiClient {
         });
     }
 
+    async pauseJobAssignment(assignmentId) {
+        return this.request(`/jobs/assignments/${assignmentId}`, {
+            method: 'PUT',
+            body: JSON.stringify({
+                action: 'pause'
+            }),
+        });
+    }
+
+    async resumeJobAssignment(assignmentId) {
+        return this.request(`/jobs/assignments/${assignmentId}`, {
+            method: 'PUT',
+            body: JSON.stringify({
+                action: 'resume'
+            }),
+        });
+    }
+
     // Job Progress API calls
     async getJobProgress(assignmentId) {
         return this.request(`/jobs/progress/${assignmentId}`);